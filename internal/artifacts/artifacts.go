@@ -0,0 +1,356 @@
+package artifacts
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/glob"
+)
+
+type Format int
+
+const (
+	FormatTar Format = iota
+	FormatTarGzip
+	FormatZip
+)
+
+type SkipReason int
+
+const (
+	SkipReasonFileTooLarge SkipReason = iota + 1
+	SkipReasonTotalSizeExceeded
+	SkipReasonNotRegularFile
+	SkipReasonError
+)
+
+type Options struct {
+	// Glob patterns with "**" support, either absolute
+	// or relative to the base directory
+	Patterns []string
+
+	// Directory relative to which the relative patterns are resolved
+	// and the archive entry names are computed, defaults to the
+	// current working directory
+	BaseDir string
+
+	// Size limits, zero means no limit
+	MaxTotalSize uint64
+	MaxFileSize  uint64
+
+	Format Format
+}
+
+type Entry struct {
+	Path string
+	Name string
+	Size uint64
+}
+
+type Skipped struct {
+	Path    string
+	Size    uint64
+	Reason  SkipReason
+	Message string
+}
+
+type Manifest struct {
+	Included          []Entry
+	Skipped           []Skipped
+	UnmatchedPatterns []string
+	TotalSize         uint64
+}
+
+type candidate struct {
+	path     string
+	fileInfo fs.FileInfo
+}
+
+// Collect gathers the files matching the patterns into an archive
+// written to w and returns the manifest of what was matched,
+// what was skipped and why.
+func Collect(options Options, w io.Writer) (*Manifest, error) {
+	baseDir := options.BaseDir
+	if baseDir == "" {
+		var err error
+
+		baseDir, err = os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	baseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+
+	candidates, err := match(options.Patterns, baseDir, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := newArchiveWriter(options.Format, w)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		size := uint64(candidate.fileInfo.Size())
+
+		if !candidate.fileInfo.Mode().IsRegular() {
+			manifest.skip(candidate.path, 0, SkipReasonNotRegularFile, "not a regular file")
+
+			continue
+		}
+
+		if options.MaxFileSize != 0 && size > options.MaxFileSize {
+			manifest.skip(candidate.path, size, SkipReasonFileTooLarge,
+				fmt.Sprintf("file size exceeds the limit of %d bytes", options.MaxFileSize))
+
+			continue
+		}
+
+		if options.MaxTotalSize != 0 && manifest.TotalSize+size > options.MaxTotalSize {
+			manifest.skip(candidate.path, size, SkipReasonTotalSizeExceeded,
+				fmt.Sprintf("total size would exceed the limit of %d bytes", options.MaxTotalSize))
+
+			continue
+		}
+
+		file, err := os.Open(candidate.path)
+		if err != nil {
+			manifest.skip(candidate.path, size, SkipReasonError, err.Error())
+
+			continue
+		}
+
+		name := archiveName(baseDir, candidate.path)
+
+		err = archive.Add(name, candidate.fileInfo, file)
+		_ = file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to archive %s: %w", candidate.path, err)
+		}
+
+		manifest.Included = append(manifest.Included, Entry{
+			Path: candidate.path,
+			Name: name,
+			Size: size,
+		})
+		manifest.TotalSize += size
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (manifest *Manifest) skip(path string, size uint64, reason SkipReason, message string) {
+	manifest.Skipped = append(manifest.Skipped, Skipped{
+		Path:    path,
+		Size:    size,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// match expands the patterns into a sorted and de-duplicated list of paths.
+func match(patterns []string, baseDir string, manifest *Manifest) ([]candidate, error) {
+	seen := map[string]struct{}{}
+
+	var candidates []candidate
+
+	for _, pattern := range patterns {
+		absolutePattern := filepath.ToSlash(pattern)
+		if !filepath.IsAbs(pattern) {
+			absolutePattern = filepath.ToSlash(filepath.Join(baseDir, pattern))
+		}
+
+		if err := glob.Validate(absolutePattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+
+		matched := false
+
+		err := filepath.WalkDir(walkRoot(absolutePattern), func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+
+				if errors.Is(err, fs.ErrPermission) {
+					manifest.skip(path, 0, SkipReasonError, err.Error())
+
+					return nil
+				}
+
+				return err
+			}
+
+			if entry.IsDir() {
+				return nil
+			}
+
+			if ok, _ := glob.Match(absolutePattern, filepath.ToSlash(path)); !ok {
+				return nil
+			}
+
+			matched = true
+
+			if _, ok := seen[path]; ok {
+				return nil
+			}
+			seen[path] = struct{}{}
+
+			fileInfo, err := entry.Info()
+			if err != nil {
+				manifest.skip(path, 0, SkipReasonError, err.Error())
+
+				return nil
+			}
+
+			candidates = append(candidates, candidate{path: path, fileInfo: fileInfo})
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !matched {
+			manifest.UnmatchedPatterns = append(manifest.UnmatchedPatterns, pattern)
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return strings.Compare(a.path, b.path)
+	})
+
+	return candidates, nil
+}
+
+// walkRoot returns the longest leading part of the
+// pattern that doesn't contain any special characters.
+func walkRoot(pattern string) string {
+	segments := strings.Split(pattern, "/")
+
+	for i, segment := range segments {
+		if glob.HasMeta(segment) || segment == "**" {
+			return filepath.FromSlash(strings.Join(segments[:i], "/") + "/")
+		}
+	}
+
+	return filepath.FromSlash(pattern)
+}
+
+func archiveName(baseDir string, path string) string {
+	if relativePath, err := filepath.Rel(baseDir, path); err == nil && !strings.HasPrefix(relativePath, "..") {
+		return filepath.ToSlash(relativePath)
+	}
+
+	return strings.TrimPrefix(filepath.ToSlash(path), "/")
+}
+
+type archiveWriter interface {
+	Add(name string, fileInfo fs.FileInfo, r io.Reader) error
+	Close() error
+}
+
+func newArchiveWriter(format Format, w io.Writer) (archiveWriter, error) {
+	switch format {
+	case FormatTar:
+		return &tarWriter{writer: tar.NewWriter(w)}, nil
+	case FormatTarGzip:
+		gzipWriter := gzip.NewWriter(w)
+
+		return &tarWriter{writer: tar.NewWriter(gzipWriter), closer: gzipWriter}, nil
+	case FormatZip:
+		return &zipWriter{writer: zip.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive format %d", format)
+	}
+}
+
+type tarWriter struct {
+	writer *tar.Writer
+	closer io.Closer
+}
+
+func (tarWriter *tarWriter) Add(name string, fileInfo fs.FileInfo, r io.Reader) error {
+	header, err := tar.FileInfoHeader(fileInfo, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tarWriter.writer.WriteHeader(header); err != nil {
+		return err
+	}
+
+	// The file might've changed since we've stat'ed it, and since the
+	// header is already written, copy exactly the announced amount of
+	// bytes, padding with zeroes if the file has shrunk
+	_, err = io.CopyN(tarWriter.writer, io.MultiReader(r, zeroReader{}), header.Size)
+
+	return err
+}
+
+func (tarWriter *tarWriter) Close() error {
+	if err := tarWriter.writer.Close(); err != nil {
+		return err
+	}
+
+	if tarWriter.closer != nil {
+		return tarWriter.closer.Close()
+	}
+
+	return nil
+}
+
+type zipWriter struct {
+	writer *zip.Writer
+}
+
+func (zipWriter *zipWriter) Add(name string, fileInfo fs.FileInfo, r io.Reader) error {
+	header, err := zip.FileInfoHeader(fileInfo)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+
+	writer, err := zipWriter.writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(writer, r)
+
+	return err
+}
+
+func (zipWriter *zipWriter) Close() error {
+	return zipWriter.writer.Close()
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+
+	return len(p), nil
+}
@@ -0,0 +1,118 @@
+package artifacts
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollect(t *testing.T) {
+	baseDir := t.TempDir()
+
+	files := map[string]string{
+		"results/unit.xml":              "<testsuite/>",
+		"results/nested/ui.xml":         "<testsuite name=ui/>",
+		"logs/build.log":                "build log",
+		"logs/huge.log":                 "this log is way too large",
+		"crashes/app.ips":               "crash report",
+		"crashes/ignored/not-a-log.txt": "ignored",
+	}
+
+	for name, contents := range files {
+		path := filepath.Join(baseDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	}
+
+	// Absolute pattern that's outside of the base directory
+	otherDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(otherDir, "system.log"), []byte("system log"), 0o600))
+
+	buf := &bytes.Buffer{}
+
+	manifest, err := Collect(Options{
+		Patterns: []string{
+			"results/**/*.xml",
+			"logs/*.log",
+			"crashes/*.ips",
+			"**/unit.xml",
+			filepath.Join(otherDir, "*.log"),
+			"nonexistent/**",
+		},
+		BaseDir:     baseDir,
+		MaxFileSize: 20,
+	}, buf)
+	require.NoError(t, err)
+
+	var includedNames []string
+	for _, entry := range manifest.Included {
+		includedNames = append(includedNames, entry.Name)
+	}
+	require.ElementsMatch(t, []string{
+		"crashes/app.ips",
+		"logs/build.log",
+		"results/nested/ui.xml",
+		"results/unit.xml",
+		archiveName(baseDir, filepath.Join(otherDir, "system.log")),
+	}, includedNames)
+
+	require.Len(t, manifest.Skipped, 1)
+	require.Equal(t, filepath.Join(baseDir, "logs/huge.log"), manifest.Skipped[0].Path)
+	require.Equal(t, SkipReasonFileTooLarge, manifest.Skipped[0].Reason)
+
+	require.Equal(t, []string{"nonexistent/**"}, manifest.UnmatchedPatterns)
+
+	// Verify the archive contents
+	tarReader := tar.NewReader(buf)
+	archived := map[string]string{}
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		contents, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+
+		archived[header.Name] = string(contents)
+	}
+
+	require.Len(t, archived, 5)
+	require.Equal(t, files["results/nested/ui.xml"], archived["results/nested/ui.xml"])
+}
+
+func TestCollectTotalSizeZip(t *testing.T) {
+	baseDir := t.TempDir()
+
+	for _, name := range []string{"a.log", "b.log", "c.log"} {
+		require.NoError(t, os.WriteFile(filepath.Join(baseDir, name), []byte("0123456789"), 0o600))
+	}
+
+	buf := &bytes.Buffer{}
+
+	manifest, err := Collect(Options{
+		Patterns:     []string{"*.log"},
+		BaseDir:      baseDir,
+		MaxTotalSize: 25,
+		Format:       FormatZip,
+	}, buf)
+	require.NoError(t, err)
+	require.Len(t, manifest.Included, 2)
+	require.EqualValues(t, 20, manifest.TotalSize)
+	require.Len(t, manifest.Skipped, 1)
+	require.Equal(t, SkipReasonTotalSizeExceeded, manifest.Skipped[0].Reason)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zipReader.File, 2)
+	require.Equal(t, "a.log", zipReader.File[0].Name)
+}
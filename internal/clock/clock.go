@@ -0,0 +1,108 @@
+package clock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// DefaultStepThreshold is the offset above which PolicyAuto steps
+// the clock, smaller offsets are slewed, which takes about 2000
+// times the offset with the 500 PPM slew rate used by the kernels.
+const DefaultStepThreshold = 500 * time.Millisecond
+
+// maxSlew is the largest offset accepted by adjtime(3)
+// on Linux, we apply the same limit on macOS
+const maxSlew = 2145 * time.Second
+
+var ErrSlewTooLarge = fmt.Errorf("offset is too large to be slewed, the maximum is %s", maxSlew)
+
+type Policy int
+
+const (
+	// PolicyAuto steps the clock when the offset exceeds
+	// the step threshold and slews it otherwise
+	PolicyAuto Policy = iota
+	PolicyStep
+	PolicySlew
+)
+
+var (
+	// stepped is the total of the offsets stepped by Set, which
+	// allows the watchers to ignore the jumps caused by the agent
+	stepped time.Duration
+
+	// host is the host's time last passed to Set, which serves
+	// as an outside reference for detecting the suspends
+	host hostReference
+
+	// Guards the above, and is held while reading
+	// or stepping the clock, so that the watchers
+	// see both the step and its offset or neither
+	mtx sync.Mutex
+)
+
+type hostReference struct {
+	// Incremented with each Set, zero when there's none
+	seq uint64
+
+	time      time.Time
+	monotonic time.Duration
+}
+
+// Set sets the system clock to the target time, returning
+// the applied offset and whether the clock was stepped.
+func Set(target time.Time, policy Policy, stepThreshold time.Duration) (time.Duration, bool, error) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	// Record the host's time along with the clock that stops
+	// whenever the guest does, regardless of whether the
+	// clock is adjusted successfully
+	if monotonic, _, err := monotonicClocks(); err == nil {
+		host = hostReference{
+			seq:       host.seq + 1,
+			time:      target,
+			monotonic: monotonic,
+		}
+	}
+
+	offset := time.Until(target)
+
+	if !shouldStep(offset, policy, stepThreshold) {
+		if offset.Abs() > maxSlew {
+			return 0, false, ErrSlewTooLarge
+		}
+
+		if err := slew(offset); err != nil {
+			return 0, false, fmt.Errorf("failed to slew the clock: %w", err)
+		}
+
+		return offset, false, nil
+	}
+
+	// Re-calculate the offset right before stepping
+	offset = time.Until(target)
+	timeval := unix.NsecToTimeval(time.Now().Add(offset).UnixNano())
+
+	if err := unix.Settimeofday(&timeval); err != nil {
+		return 0, true, fmt.Errorf("failed to step the clock: %w", err)
+	}
+
+	stepped += offset
+
+	return offset, true, nil
+}
+
+func shouldStep(offset time.Duration, policy Policy, stepThreshold time.Duration) bool {
+	switch policy {
+	case PolicyStep:
+		return true
+	case PolicySlew:
+		return false
+	default:
+		return offset.Abs() > stepThreshold
+	}
+}
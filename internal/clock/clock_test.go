@@ -0,0 +1,116 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldStep(t *testing.T) {
+	require.True(t, shouldStep(-time.Second, PolicyAuto, DefaultStepThreshold))
+	require.False(t, shouldStep(100*time.Millisecond, PolicyAuto, DefaultStepThreshold))
+	require.True(t, shouldStep(time.Millisecond, PolicyStep, DefaultStepThreshold))
+	require.False(t, shouldStep(time.Hour, PolicySlew, DefaultStepThreshold))
+}
+
+func TestDetect(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	previous := reading{
+		wall:      base,
+		monotonic: 10 * time.Second,
+		boot:      10 * time.Second,
+	}
+
+	// Clocks advance in lockstep
+	_, ok := detect(previous, reading{
+		wall:      base.Add(time.Second),
+		monotonic: 11 * time.Second,
+		boot:      11 * time.Second,
+	}, DefaultJumpThreshold)
+	require.False(t, ok)
+
+	// Suspended for an hour, with the wall clock catching up
+	event, ok := detect(previous, reading{
+		wall:      base.Add(time.Hour + time.Second),
+		monotonic: 11 * time.Second,
+		boot:      time.Hour + 11*time.Second,
+	}, DefaultJumpThreshold)
+	require.True(t, ok)
+	require.Equal(t, Event{
+		Kind:      EventResumed,
+		Time:      base.Add(time.Hour + time.Second),
+		Jump:      time.Hour,
+		Suspended: time.Hour,
+	}, event)
+
+	// Wall clock set back by something else
+	event, ok = detect(previous, reading{
+		wall:      base.Add(-time.Minute),
+		monotonic: 11 * time.Second,
+		boot:      11 * time.Second,
+	}, DefaultJumpThreshold)
+	require.True(t, ok)
+	require.Equal(t, EventJumped, event.Kind)
+	require.Equal(t, -time.Minute-time.Second, event.Jump)
+
+	// Wall clock stepped by Set
+	_, ok = detect(previous, reading{
+		wall:      base.Add(time.Hour + time.Second),
+		monotonic: 11 * time.Second,
+		boot:      11 * time.Second,
+		stepped:   time.Hour,
+	}, DefaultJumpThreshold)
+	require.False(t, ok)
+}
+
+func TestDetectPaused(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	previous := reading{
+		wall:      base,
+		monotonic: 10 * time.Second,
+		boot:      10 * time.Second,
+		rtc:       base,
+	}
+
+	// Paused for an hour, all of the guest's clocks have stopped
+	current := reading{
+		wall:      base.Add(time.Second),
+		monotonic: 11 * time.Second,
+		boot:      11 * time.Second,
+		rtc:       base.Add(time.Hour + time.Second),
+	}
+
+	event, ok := detect(previous, current, DefaultJumpThreshold)
+	require.True(t, ok)
+	require.Equal(t, EventResumed, event.Kind)
+	require.Equal(t, time.Hour, event.Suspended)
+
+	// RTC ticking within its resolution
+	current.rtc = base.Add(2 * time.Second)
+
+	_, ok = detect(previous, current, DefaultJumpThreshold)
+	require.False(t, ok)
+
+	// No RTC, but the host's times passed to Set are an hour
+	// further apart than the guest's, and the clock was stepped
+	previous.rtc, current.rtc = time.Time{}, time.Time{}
+	current.wall = base.Add(time.Hour + time.Second)
+	previous.host = hostReference{seq: 1, time: base, monotonic: 5 * time.Second}
+	current.host = hostReference{seq: 2, time: base.Add(time.Hour + 5*time.Second),
+		monotonic: 10*time.Second + 500*time.Millisecond}
+	current.stepped = time.Hour
+
+	event, ok = detect(previous, current, DefaultJumpThreshold)
+	require.True(t, ok)
+	require.Equal(t, EventResumed, event.Kind)
+	require.Equal(t, time.Hour-500*time.Millisecond, event.Suspended)
+
+	// The first Set is not compared against anything
+	previous.host = hostReference{}
+
+	_, ok = detect(previous, current, DefaultJumpThreshold)
+	require.False(t, ok)
+}
@@ -0,0 +1,24 @@
+package clock
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// monotonicClocks returns the readings of the clock that stops
+// while the system is suspended and of the one that does not.
+func monotonicClocks() (time.Duration, time.Duration, error) {
+	var uptime, monotonic unix.Timespec
+
+	if err := unix.ClockGettime(unix.CLOCK_UPTIME_RAW, &uptime); err != nil {
+		return 0, 0, err
+	}
+
+	// Unlike on Linux, CLOCK_MONOTONIC keeps counting while asleep
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &monotonic); err != nil {
+		return 0, 0, err
+	}
+
+	return time.Duration(uptime.Nano()), time.Duration(monotonic.Nano()), nil
+}
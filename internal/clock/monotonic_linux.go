@@ -0,0 +1,23 @@
+package clock
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// monotonicClocks returns the readings of the clock that stops
+// while the system is suspended and of the one that does not.
+func monotonicClocks() (time.Duration, time.Duration, error) {
+	var monotonic, boot unix.Timespec
+
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &monotonic); err != nil {
+		return 0, 0, err
+	}
+
+	if err := unix.ClockGettime(unix.CLOCK_BOOTTIME, &boot); err != nil {
+		return 0, 0, err
+	}
+
+	return time.Duration(monotonic.Nano()), time.Duration(boot.Nano()), nil
+}
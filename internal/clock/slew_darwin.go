@@ -0,0 +1,13 @@
+package clock
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func slew(offset time.Duration) error {
+	delta := unix.NsecToTimeval(offset.Nanoseconds())
+
+	return unix.Adjtime(&delta, nil)
+}
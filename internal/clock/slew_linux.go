@@ -0,0 +1,20 @@
+package clock
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// ADJ_OFFSET_SINGLESHOT from <sys/timex.h>, which
+// provides the adjtime(3) semantics
+const adjOffsetSingleshot = 0x8001
+
+func slew(offset time.Duration) error {
+	_, err := unix.Adjtimex(&unix.Timex{
+		Modes:  adjOffsetSingleshot,
+		Offset: offset.Microseconds(),
+	})
+
+	return err
+}
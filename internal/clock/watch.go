@@ -0,0 +1,159 @@
+package clock
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	DefaultWatchInterval = time.Second
+	DefaultJumpThreshold = time.Second
+
+	// Each check reads the clocks and the RTC, which
+	// is cheap, but pointless to do too often
+	MinWatchInterval = 100 * time.Millisecond
+
+	// The RTC only counts whole seconds
+	rtcResolution = time.Second
+)
+
+type EventKind int
+
+const (
+	// EventResumed means that the system was suspended, which is
+	// detected by the clock that keeps counting while suspended
+	// advancing further than the one that does not, or, when the
+	// whole VM was paused from the outside (e.g. "tart suspend")
+	// and all of the guest's clocks have stopped, by an outside
+	// reference advancing further than the guest's clocks: the
+	// RTC, which keeps the host's time, or the host's times
+	// passed to the consecutive Set calls
+	EventResumed EventKind = iota
+
+	// EventJumped means that the wall clock has moved relative
+	// to the monotonic clock by other means than Set, e.g. by
+	// an NTP daemon catching up after the system has resumed
+	EventJumped
+)
+
+type Event struct {
+	Kind EventKind
+	Time time.Time
+
+	// How far the wall clock has moved relative to the monotonic
+	// clock, excluding the offsets stepped by Set
+	Jump time.Duration
+
+	// How long the system was suspended for
+	Suspended time.Duration
+}
+
+type reading struct {
+	wall      time.Time
+	monotonic time.Duration
+	boot      time.Duration
+	stepped   time.Duration
+
+	// Zero when the RTC is not available
+	rtc time.Time
+
+	host hostReference
+}
+
+func read() (reading, error) {
+	rtc, _ := readRTC()
+
+	// Prevent Set from stepping the clock in-between
+	// reading the wall clock and the stepped offsets
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	monotonic, boot, err := monotonicClocks()
+	if err != nil {
+		return reading{}, err
+	}
+
+	return reading{
+		// Strip the monotonic clock reading
+		// to compare the wall clock readings
+		wall:      time.Now().Round(0),
+		monotonic: monotonic,
+		boot:      boot,
+		stepped:   stepped,
+		rtc:       rtc,
+		host:      host,
+	}, nil
+}
+
+func detect(previous reading, current reading, threshold time.Duration) (Event, bool) {
+	monotonicElapsed := current.monotonic - previous.monotonic
+
+	event := Event{
+		Time: current.wall,
+		Jump: current.wall.Sub(previous.wall) - monotonicElapsed -
+			(current.stepped - previous.stepped),
+		Suspended: current.boot - previous.boot - monotonicElapsed,
+	}
+
+	// The VM was paused from the outside, so the guest's clocks
+	// have all stopped together, but the outside references haven't
+	if !previous.rtc.IsZero() && !current.rtc.IsZero() {
+		paused := current.rtc.Sub(previous.rtc) - monotonicElapsed
+
+		if paused > threshold+rtcResolution {
+			event.Suspended = max(event.Suspended, paused)
+		}
+	}
+
+	if previous.host.seq != 0 && current.host.seq != previous.host.seq {
+		paused := current.host.time.Sub(previous.host.time) -
+			(current.host.monotonic - previous.host.monotonic)
+
+		event.Suspended = max(event.Suspended, paused)
+	}
+
+	switch {
+	case event.Suspended > threshold:
+		event.Kind = EventResumed
+	case event.Jump.Abs() > threshold:
+		event.Kind = EventJumped
+	default:
+		return Event{}, false
+	}
+
+	return event, true
+}
+
+// Watch compares the clocks every interval and calls the callback
+// when a suspend or a wall clock jump larger than the threshold
+// is detected, until the context is done or the callback fails.
+func Watch(ctx context.Context, interval time.Duration, threshold time.Duration, callback func(Event) error) error {
+	previous, err := read()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		current, err := read()
+		if err != nil {
+			return err
+		}
+
+		if event, ok := detect(previous, current, threshold); ok {
+			if err := callback(event); err != nil {
+				return err
+			}
+		}
+
+		previous = current
+	}
+}
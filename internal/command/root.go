@@ -10,12 +10,17 @@ import (
 
 	"github.com/cenkalti/backoff/v5"
 	"github.com/cirruslabs/tart-guest-agent/internal/diskresizer"
+	"github.com/cirruslabs/tart-guest-agent/internal/health"
+	"github.com/cirruslabs/tart-guest-agent/internal/identity"
 	"github.com/cirruslabs/tart-guest-agent/internal/logginglevel"
+	"github.com/cirruslabs/tart-guest-agent/internal/power"
 	"github.com/cirruslabs/tart-guest-agent/internal/rpc"
 	"github.com/cirruslabs/tart-guest-agent/internal/spice/vdagent"
 	"github.com/cirruslabs/tart-guest-agent/internal/tart"
+	"github.com/cirruslabs/tart-guest-agent/internal/transfer"
 	"github.com/cirruslabs/tart-guest-agent/internal/version"
 	"github.com/cirruslabs/tart-guest-agent/internal/vsock"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -24,12 +29,20 @@ import (
 )
 
 var resizeDisk bool
+var resetIdentity bool
 var runVdagent bool
 var runRPC bool
 
 var runDaemon bool
 var runAgent bool
 
+var transferStagingDir string
+var transferTTL time.Duration
+var shutdownHooksDir string
+
+var identityStatePath string
+var hostnameTemplate string
+
 var debug bool
 
 const componentFailedTimeout = time.Second
@@ -53,6 +66,8 @@ func NewRootCommand() *cobra.Command {
 
 	// Individual components
 	cmd.Flags().BoolVar(&resizeDisk, "resize-disk", false, "resize disk")
+	cmd.Flags().BoolVar(&resetIdentity, "reset-identity", false, "regenerate the machine ID, "+
+		"SSH host keys and hostname once per cloned VM")
 	cmd.Flags().BoolVar(&runVdagent, "run-vdagent", false, "run vdagent")
 	cmd.Flags().BoolVar(&runRPC, "run-rpc", false, "run RPC service (currently required "+
 		"to support \"tart exec\" functionality)")
@@ -63,6 +78,23 @@ func NewRootCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&runAgent, "run-agent", false, "identical to running the agent "+
 		"with \"--run-vdagent\" and \"--run-rpc\" command-line arguments")
 
+	// Identity reset settings
+	cmd.Flags().StringVar(&identityStatePath, "identity-state-path", identity.DefaultStatePath(),
+		"file in which to record the identity reset to only perform it once per cloned VM")
+	cmd.Flags().StringVar(&hostnameTemplate, "hostname-template", "",
+		"hostname to set when resetting the identity, with \"{id}\" replaced with a stable "+
+			"per-VM identifier and \"{random}\" replaced with random characters, "+
+			"the hostname is left as is when empty")
+
+	// RPC service settings
+	cmd.Flags().StringVar(&transferStagingDir, "transfer-staging-dir", transfer.DefaultStagingDir(),
+		"directory in which to keep the partial data of resumable uploads")
+	cmd.Flags().DurationVar(&transferTTL, "transfer-ttl", transfer.DefaultTTL,
+		"how long to keep the partial data of resumable uploads that are no longer written to")
+	cmd.Flags().StringVar(&shutdownHooksDir, "shutdown-hooks-dir", power.DefaultHooksDir,
+		"directory with the executables to run before shutting down, rebooting or logging out "+
+			"via the RPC service, which are run in lexical order with the mode as an argument")
+
 	cmd.Flags().BoolVar(&debug, "debug", false, "enable debug logging")
 
 	return cmd
@@ -90,6 +122,34 @@ func run(cmd *cobra.Command, args []string) error {
 		zap.S().Infof("running on Tart %s, proceeding...", version.String())
 	}
 
+	// Track the components' health, which is
+	// exposed via the RPC server, if enabled
+	components := enabledComponents()
+	healthReporter := health.NewReporter(healthComponents(components)...)
+
+	// Reset the identity first, so that the other components
+	// and the services started later use the new identifiers
+	if resetIdentity {
+		zap.S().Info("attempting to reset identity...")
+
+		result, err := identity.Reset(identityStatePath, identity.Options{
+			HostnameTemplate: hostnameTemplate,
+		}, false)
+		if err != nil {
+			if errors.Is(err, identity.ErrAlreadyReset) {
+				zap.S().Infof("skipping identity reset: %v", err)
+
+				healthReporter.SetServing(health.ComponentIdentityReset, true)
+			} else {
+				zap.S().Warnf("failed to reset identity: %v", err)
+			}
+		} else {
+			zap.S().Infof("successfully reset identity, hostname is %s", result.Hostname)
+
+			healthReporter.SetServing(health.ComponentIdentityReset, true)
+		}
+	}
+
 	// Perform disk resizing
 	if resizeDisk {
 		zap.S().Info("attempting to resize disk...")
@@ -112,7 +172,7 @@ func run(cmd *cobra.Command, args []string) error {
 			exponentialBackoff := backoff.NewExponentialBackOff()
 
 			for {
-				if err := runVdagentOnce(ctx); err != nil {
+				if err := runVdagentOnce(ctx, healthReporter); err != nil {
 					return err
 				}
 
@@ -127,9 +187,30 @@ func run(cmd *cobra.Command, args []string) error {
 	}
 
 	if runRPC {
+		rpcOpts := []rpc.Option{
+			rpc.WithComponents(components...),
+			rpc.WithHealthReporter(healthReporter),
+			rpc.WithShutdownHooksDir(shutdownHooksDir),
+			rpc.WithIdentityStatePath(identityStatePath),
+			rpc.WithHostnameTemplate(hostnameTemplate),
+		}
+
+		// Only the resumable uploads depend on the staging directory,
+		// so keep the rest of the RPC service running without it
+		transferManager, err := transfer.NewManager(transferStagingDir, transferTTL)
+		if err != nil {
+			zap.S().Warnf("disabling resumable uploads: %v", err)
+		} else {
+			rpcOpts = append(rpcOpts, rpc.WithTransferManager(transferManager))
+
+			group.Go(func() error {
+				return transferManager.Run(ctx)
+			})
+		}
+
 		group.Go(func() error {
 			for {
-				if err := runRPCOnce(ctx); err != nil {
+				if err := runRPCOnce(ctx, healthReporter, rpcOpts...); err != nil {
 					return err
 				}
 
@@ -155,7 +236,38 @@ func run(cmd *cobra.Command, args []string) error {
 	return group.Wait()
 }
 
-func runVdagentOnce(ctx context.Context) error {
+func enabledComponents() []string {
+	var result []string
+
+	if resizeDisk {
+		result = append(result, health.ComponentDiskResizer)
+	}
+
+	if resetIdentity {
+		result = append(result, health.ComponentIdentityReset)
+	}
+
+	if runVdagent {
+		result = append(result, health.ComponentVdagent)
+	}
+
+	if runRPC {
+		result = append(result, health.ComponentRPC)
+	}
+
+	return result
+}
+
+// healthComponents returns the components whose health is tracked.
+//
+// Disk resizing is excluded: it's performed by the root daemon on
+// macOS, which doesn't serve RPC, so the serving process would never
+// see it finish, and it's unsupported on Linux anyway.
+func healthComponents(components []string) []string {
+	return lo.Without(components, health.ComponentDiskResizer)
+}
+
+func runVdagentOnce(ctx context.Context, healthReporter *health.Reporter) error {
 	zap.S().Infof("initializing vdagent...")
 
 	vdAgent, err := vdagent.New()
@@ -168,7 +280,11 @@ func runVdagentOnce(ctx context.Context) error {
 
 	zap.S().Infof("running vdagent...")
 
-	if err := vdAgent.Run(ctx); err != nil {
+	defer healthReporter.SetServing(health.ComponentVdagent, false)
+
+	if err := vdAgent.Run(ctx, func() {
+		healthReporter.SetServing(health.ComponentVdagent, true)
+	}); err != nil {
 		zap.S().Errorf("failed to run vdagent: %v", err)
 
 		return nil
@@ -177,7 +293,7 @@ func runVdagentOnce(ctx context.Context) error {
 	return nil
 }
 
-func runRPCOnce(ctx context.Context) error {
+func runRPCOnce(ctx context.Context, healthReporter *health.Reporter, opts ...rpc.Option) error {
 	zap.S().Infof("initializing RPC server...")
 
 	listener, err := vsock.Listen(8080)
@@ -188,7 +304,7 @@ func runRPCOnce(ctx context.Context) error {
 	}
 	defer listener.Close()
 
-	rpcServer, err := rpc.New(listener)
+	rpcServer, err := rpc.New(listener, opts...)
 	if err != nil {
 		zap.S().Errorf("failed to initialize RPC server: %v", err)
 
@@ -197,6 +313,9 @@ func runRPCOnce(ctx context.Context) error {
 
 	zap.S().Info("running RPC server on AF_VSOCK port 8080...")
 
+	healthReporter.SetServing(health.ComponentRPC, true)
+	defer healthReporter.SetServing(health.ComponentRPC, false)
+
 	if err := rpcServer.Run(ctx); err != nil {
 		zap.S().Errorf("failed to run RPC server: %v", err)
 
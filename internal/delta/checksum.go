@@ -0,0 +1,33 @@
+package delta
+
+// rollingChecksum is the rsync weak checksum that can be
+// cheaply updated when the window slides by one byte.
+type rollingChecksum struct {
+	a      uint32
+	b      uint32
+	length uint32
+}
+
+func newRollingChecksum(window []byte) rollingChecksum {
+	checksum := rollingChecksum{
+		length: uint32(len(window)),
+	}
+
+	for i, value := range window {
+		checksum.a += uint32(value)
+		checksum.b += uint32(len(window)-i) * uint32(value)
+	}
+
+	return checksum
+}
+
+func (checksum *rollingChecksum) Sum() uint32 {
+	return (checksum.a & 0xffff) | (checksum.b << 16)
+}
+
+// WeakChecksum calculates the rolling checksum of a single block.
+func WeakChecksum(block []byte) uint32 {
+	checksum := newRollingChecksum(block)
+
+	return checksum.Sum()
+}
@@ -0,0 +1,58 @@
+package delta
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+)
+
+const (
+	DefaultBlockSize = 64 * 1024
+	MaxBlockSize     = 4 * 1024 * 1024
+
+	readChunkSize = 256 * 1024
+)
+
+type BlockSignature struct {
+	Weak   uint32
+	Strong [sha256.Size]byte
+
+	// Size is the size of the block, which is only
+	// different from the block size for the last block
+	Size int
+}
+
+// Op is a single instruction to reconstruct the file: either a literal
+// data to write, or a run of blocks to copy from the existing file.
+type Op struct {
+	Literal    []byte
+	BlockIndex uint64
+	BlockCount uint64
+}
+
+// Signatures splits the reader's contents into blocks
+// and calculates weak and strong checksums of each block.
+func Signatures(r io.Reader, blockSize int, callback func(BlockSignature) error) error {
+	buf := make([]byte, blockSize)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if err := callback(BlockSignature{
+				Weak:   WeakChecksum(buf[:n]),
+				Strong: sha256.Sum256(buf[:n]),
+				Size:   n,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
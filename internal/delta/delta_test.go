@@ -0,0 +1,313 @@
+package delta
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	const blockSize = 1024
+
+	random := rand.New(rand.NewPCG(1, 2))
+
+	base := make([]byte, 64*blockSize+123)
+	for i := range base {
+		base[i] = byte(random.UintN(256))
+	}
+
+	// Insert some data in the beginning, delete some in the middle and append to the end
+	target := concat(
+		[]byte("prepended data"),
+		base[:10*blockSize],
+		base[20*blockSize+17:],
+		[]byte("appended data"),
+	)
+
+	path := filepath.Join(t.TempDir(), "file.bin")
+	require.NoError(t, os.WriteFile(path, base, 0o600))
+
+	patcher, err := NewPatcher(path, blockSize, 0)
+	require.NoError(t, err)
+	defer patcher.Close()
+
+	var signatures []BlockSignature
+
+	require.NoError(t, patcher.Signatures(func(signature BlockSignature) error {
+		signatures = append(signatures, signature)
+
+		return nil
+	}))
+	require.Len(t, signatures, 65)
+
+	var literalBytes int
+
+	require.NoError(t, compute(signatures, blockSize, bytes.NewReader(target), func(op Op) error {
+		literalBytes += len(op.Literal)
+
+		return patcher.Apply(op)
+	}))
+
+	// Most of the data should've been copied from the existing file
+	require.Less(t, literalBytes, 2*blockSize)
+
+	targetSHA256 := sha256.Sum256(target)
+
+	size, err := patcher.Commit(targetSHA256[:])
+	require.NoError(t, err)
+	require.EqualValues(t, len(target), size)
+
+	actual, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, target, actual)
+
+	// Permissions of the original file should be preserved
+	fileInfo, err := os.Stat(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 0o600, fileInfo.Mode().Perm())
+
+	// No temporary files should be left
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	target := []byte("Hello, World!\n")
+
+	patcher, err := NewPatcher(path, DefaultBlockSize, 0)
+	require.NoError(t, err)
+	defer patcher.Close()
+
+	require.NoError(t, compute(nil, DefaultBlockSize, bytes.NewReader(target), patcher.Apply))
+
+	_, err = patcher.Commit([]byte("bogus checksum"))
+	require.Error(t, err)
+
+	targetSHA256 := sha256.Sum256(target)
+
+	_, err = patcher.Commit(targetSHA256[:])
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, target, actual)
+}
+
+func TestApplyOutOfRange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, bytes.Repeat([]byte("a"), 2*1024+1), 0o600))
+
+	patcher, err := NewPatcher(path, 1024, 0)
+	require.NoError(t, err)
+	defer patcher.Close()
+
+	// The last, partial block can be copied
+	require.NoError(t, patcher.Apply(Op{BlockIndex: 2, BlockCount: 1}))
+
+	require.ErrorIs(t, patcher.Apply(Op{BlockIndex: 3, BlockCount: 1}), ErrInvalidOp)
+	require.ErrorIs(t, patcher.Apply(Op{BlockIndex: 1, BlockCount: 3}), ErrInvalidOp)
+	require.ErrorIs(t, patcher.Apply(Op{BlockIndex: 1 << 62, BlockCount: 1 << 62}), ErrInvalidOp)
+	require.ErrorIs(t, patcher.Apply(Op{BlockIndex: 0, BlockCount: ^uint64(0)}), ErrInvalidOp)
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+// maxLiteralSize limits the amount of literal data
+// accumulated by compute() before emitting it
+const maxLiteralSize = 1024 * 1024
+
+// compute produces the instructions necessary to turn the file described
+// by the signatures into the reader's contents. This is what the host
+// does after receiving the signatures from the agent.
+func compute(signatures []BlockSignature, blockSize int, r io.Reader, emit func(Op) error) error {
+	weakToIndices := map[uint32][]int{}
+
+	for index, signature := range signatures {
+		weakToIndices[signature.Weak] = append(weakToIndices[signature.Weak], index)
+	}
+
+	var buf, literal []byte
+	var pos int
+	var eof bool
+	var pendingCopy *Op
+
+	flushLiteral := func() error {
+		if len(literal) == 0 {
+			return nil
+		}
+
+		op := Op{Literal: literal}
+		literal = nil
+
+		return emit(op)
+	}
+
+	flushCopy := func() error {
+		if pendingCopy == nil {
+			return nil
+		}
+
+		op := *pendingCopy
+		pendingCopy = nil
+
+		return emit(op)
+	}
+
+	fill := func() error {
+		for !eof && len(buf)-pos <= blockSize {
+			// Discard the data we've already processed
+			if pos > readChunkSize {
+				buf = append(buf[:0], buf[pos:]...)
+				pos = 0
+			}
+
+			chunk := make([]byte, readChunkSize)
+
+			n, err := r.Read(chunk)
+			buf = append(buf, chunk[:n]...)
+
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					eof = true
+
+					return nil
+				}
+
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var checksum rollingChecksum
+	var checksumValid bool
+
+	for {
+		if err := fill(); err != nil {
+			return err
+		}
+
+		window := buf[pos:min(pos+blockSize, len(buf))]
+		if len(window) == 0 {
+			break
+		}
+
+		if !checksumValid {
+			checksum = newRollingChecksum(window)
+			checksumValid = true
+		}
+
+		matchedIndex := -1
+
+		if indices, ok := weakToIndices[checksum.Sum()]; ok {
+			strong := sha256.Sum256(window)
+
+			for _, index := range indices {
+				if signatures[index].Size == len(window) && signatures[index].Strong == strong {
+					matchedIndex = index
+
+					break
+				}
+			}
+		}
+
+		if matchedIndex != -1 {
+			if err := flushLiteral(); err != nil {
+				return err
+			}
+
+			// Coalesce consecutive blocks into a single instruction
+			if pendingCopy != nil && pendingCopy.BlockIndex+pendingCopy.BlockCount == uint64(matchedIndex) {
+				pendingCopy.BlockCount++
+			} else {
+				if err := flushCopy(); err != nil {
+					return err
+				}
+
+				pendingCopy = &Op{BlockIndex: uint64(matchedIndex), BlockCount: 1}
+			}
+
+			pos += len(window)
+			checksumValid = false
+
+			continue
+		}
+
+		if err := flushCopy(); err != nil {
+			return err
+		}
+
+		literal = append(literal, buf[pos])
+
+		if len(literal) >= maxLiteralSize {
+			if err := flushLiteral(); err != nil {
+				return err
+			}
+		}
+
+		// Slide the window by one byte, the checksum needs to be
+		// re-calculated when we're at the tail of the data
+		if pos+len(window) < len(buf) && len(window) == blockSize {
+			checksum.Roll(buf[pos], buf[pos+len(window)])
+		} else {
+			checksumValid = false
+		}
+
+		pos++
+	}
+
+	if err := flushCopy(); err != nil {
+		return err
+	}
+
+	return flushLiteral()
+}
+
+func (checksum *rollingChecksum) Roll(out byte, in byte) {
+	checksum.a = checksum.a - uint32(out) + uint32(in)
+	checksum.b = checksum.b - checksum.length*uint32(out) + checksum.a
+}
+
+func TestPatcherRefusesSymlinks(t *testing.T) {
+	dir := t.TempDir()
+
+	target := filepath.Join(dir, "target")
+	require.NoError(t, os.WriteFile(target, []byte("target"), 0o600))
+
+	link := filepath.Join(dir, "link")
+	require.NoError(t, os.Symlink(target, link))
+
+	_, err := NewPatcher(link, DefaultBlockSize, 0)
+	require.ErrorContains(t, err, "is a symbolic link")
+
+	// The destination is replaced with a symbolic link after the patcher was created
+	path := filepath.Join(dir, "file")
+
+	patcher, err := NewPatcher(path, DefaultBlockSize, 0)
+	require.NoError(t, err)
+	defer patcher.Close()
+
+	require.NoError(t, patcher.Apply(Op{Literal: []byte("data")}))
+	require.NoError(t, os.Symlink(target, path))
+
+	dataSHA256 := sha256.Sum256([]byte("data"))
+
+	_, err = patcher.Commit(dataSHA256[:])
+	require.ErrorContains(t, err, "is a symbolic link")
+
+	content, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "target", string(content))
+}
@@ -0,0 +1,222 @@
+package delta
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+const defaultMode = 0o644
+
+var ErrInvalidOp = errors.New("invalid operation")
+
+// Patcher reconstructs a file from the blocks of its existing
+// version and literal data into a temporary file, which then
+// atomically replaces the original file on Commit().
+type Patcher struct {
+	path      string
+	blockSize int
+	mode      fs.FileMode
+
+	base     *os.File
+	baseInfo fs.FileInfo
+
+	temp       *os.File
+	tempWriter *bufio.Writer
+	hash       hash.Hash
+	size       uint64
+}
+
+func NewPatcher(path string, blockSize int, mode fs.FileMode) (*Patcher, error) {
+	if blockSize <= 0 || blockSize > MaxBlockSize {
+		return nil, fmt.Errorf("block size should be in range (0, %d], got %d", MaxBlockSize, blockSize)
+	}
+
+	if mode == 0 {
+		mode = defaultMode
+	}
+
+	patcher := &Patcher{
+		path:      path,
+		blockSize: blockSize,
+		mode:      mode,
+		hash:      sha256.New(),
+	}
+
+	// Refuse to follow symbolic links to avoid reading
+	// and replacing files outside of the requested path
+	base, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		if errors.Is(err, syscall.ELOOP) {
+			return nil, fmt.Errorf("%s is a symbolic link", path)
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		base = nil
+	}
+
+	if base != nil {
+		baseInfo, err := base.Stat()
+		if err != nil {
+			_ = base.Close()
+
+			return nil, err
+		}
+
+		if !baseInfo.Mode().IsRegular() {
+			_ = base.Close()
+
+			return nil, fmt.Errorf("%s is not a regular file", path)
+		}
+
+		patcher.base = base
+		patcher.baseInfo = baseInfo
+	}
+
+	// Create the temporary file in the same directory
+	// to be able to atomically rename it later
+	temp, err := os.CreateTemp(filepath.Dir(path), fmt.Sprintf(".%s.*.partial", filepath.Base(path)))
+	if err != nil {
+		patcher.Close()
+
+		return nil, err
+	}
+
+	patcher.temp = temp
+	patcher.tempWriter = bufio.NewWriterSize(temp, readChunkSize)
+
+	return patcher, nil
+}
+
+// BaseSize returns the size of the existing file or 0 if it doesn't exist.
+func (patcher *Patcher) BaseSize() int64 {
+	if patcher.baseInfo == nil {
+		return 0
+	}
+
+	return patcher.baseInfo.Size()
+}
+
+// Signatures calculates the signatures of the existing file's blocks.
+func (patcher *Patcher) Signatures(callback func(BlockSignature) error) error {
+	if patcher.base == nil {
+		return nil
+	}
+
+	return Signatures(io.NewSectionReader(patcher.base, 0, patcher.baseInfo.Size()),
+		patcher.blockSize, callback)
+}
+
+func (patcher *Patcher) Apply(op Op) error {
+	if len(op.Literal) != 0 {
+		return patcher.write(op.Literal)
+	}
+
+	if op.BlockCount == 0 {
+		return nil
+	}
+
+	if patcher.base == nil {
+		return fmt.Errorf("%w: cannot copy blocks from %s as it doesn't exist", ErrInvalidOp, patcher.path)
+	}
+
+	// Validate the blocks against the existing file before calculating
+	// the offsets, which would otherwise overflow for bogus values
+	blockSize := uint64(patcher.blockSize)
+	baseBlocks := (uint64(patcher.baseInfo.Size()) + blockSize - 1) / blockSize
+
+	if op.BlockIndex >= baseBlocks || op.BlockCount > baseBlocks-op.BlockIndex {
+		return fmt.Errorf("%w: blocks [%d, %d+%d) are out of range, %s has %d blocks", ErrInvalidOp,
+			op.BlockIndex, op.BlockIndex, op.BlockCount, patcher.path, baseBlocks)
+	}
+
+	offset := int64(op.BlockIndex * blockSize)
+	length := int64(op.BlockCount * blockSize)
+
+	hashedWriter := io.MultiWriter(patcher.tempWriter, patcher.hash)
+
+	n, err := io.Copy(hashedWriter, io.NewSectionReader(patcher.base, offset, length))
+	patcher.size += uint64(n)
+
+	return err
+}
+
+// Commit verifies the reconstructed file's SHA-256 checksum
+// and atomically replaces the original file with it.
+func (patcher *Patcher) Commit(expectedSHA256 []byte) (uint64, error) {
+	if err := patcher.tempWriter.Flush(); err != nil {
+		return 0, err
+	}
+
+	if actualSHA256 := patcher.hash.Sum(nil); string(actualSHA256) != string(expectedSHA256) {
+		return 0, fmt.Errorf("checksum mismatch for the reconstructed file: expected %x, got %x",
+			expectedSHA256, actualSHA256)
+	}
+
+	// Preserve the original file's permissions and ownership
+	mode := patcher.mode
+
+	if patcher.baseInfo != nil {
+		mode = patcher.baseInfo.Mode().Perm()
+
+		if stat, ok := patcher.baseInfo.Sys().(*syscall.Stat_t); ok {
+			_ = patcher.temp.Chown(int(stat.Uid), int(stat.Gid))
+		}
+	}
+
+	if err := patcher.temp.Chmod(mode); err != nil {
+		return 0, err
+	}
+
+	if err := patcher.temp.Sync(); err != nil {
+		return 0, err
+	}
+
+	// The destination might have been replaced while synchronizing
+	if info, err := os.Lstat(patcher.path); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+		return 0, fmt.Errorf("%s is a symbolic link", patcher.path)
+	}
+
+	if err := os.Rename(patcher.temp.Name(), patcher.path); err != nil {
+		return 0, err
+	}
+
+	_ = patcher.temp.Close()
+	patcher.temp = nil
+
+	return patcher.size, nil
+}
+
+// Close releases the resources and removes the temporary
+// file, unless the changes were already committed.
+func (patcher *Patcher) Close() {
+	if patcher.base != nil {
+		_ = patcher.base.Close()
+	}
+
+	if patcher.temp != nil {
+		_ = patcher.temp.Close()
+		_ = os.Remove(patcher.temp.Name())
+	}
+}
+
+func (patcher *Patcher) write(data []byte) error {
+	if _, err := patcher.tempWriter.Write(data); err != nil {
+		return err
+	}
+
+	patcher.hash.Write(data)
+	patcher.size += uint64(len(data))
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package fileattr
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Matches the ACL entries in "ls -le" output, e.g. " 0: group:everyone deny delete"
+var lsACLEntryRegex = regexp.MustCompile(`^\s*\d+: (.+)$`)
+
+// GetACL returns the extended ACL of the file in the format accepted
+// by chmod(1), e.g. "user:admin allow read,write". There are no default
+// ACLs on macOS, inheritance is expressed by the entries themselves.
+func GetACL(path string, isDefault bool) ([]string, error) {
+	if isDefault {
+		return nil, fmt.Errorf("default ACLs are not supported on macOS, use inheritance flags instead")
+	}
+
+	output, err := runCommand("ls", "-led", path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+
+	for _, line := range strings.Split(output, "\n") {
+		matches := lsACLEntryRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		result = append(result, matches[1])
+	}
+
+	return result, nil
+}
+
+// SetACL replaces the extended ACL of the file with the
+// specified entries, preserving their order.
+func SetACL(path string, isDefault bool, entries []string) error {
+	if isDefault {
+		return fmt.Errorf("default ACLs are not supported on macOS, use inheritance flags instead")
+	}
+
+	if _, err := runCommand("chmod", "-N", path); err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		if _, err := runCommand("chmod", "+a#", strconv.Itoa(i), entry, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runCommand(name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+
+	stderrBuf := &bytes.Buffer{}
+	cmd.Stderr = stderrBuf
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%q failed: %w: %s", strings.Join(append([]string{name}, args...), " "),
+			err, strings.TrimSpace(stderrBuf.String()))
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,259 @@
+package fileattr
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os/user"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// POSIX ACLs are stored by the kernel in the extended attributes
+// below, see posix_acl_xattr.h for the binary format description
+const (
+	aclAccessXattr  = "system.posix_acl_access"
+	aclDefaultXattr = "system.posix_acl_default"
+
+	aclVersion = 2
+
+	aclUserObj  = 0x01
+	aclUser     = 0x02
+	aclGroupObj = 0x04
+	aclGroup    = 0x08
+	aclMask     = 0x10
+	aclOther    = 0x20
+
+	aclUndefinedID = 0xffffffff
+)
+
+type aclHeader struct {
+	Version uint32
+}
+
+type aclEntry struct {
+	Tag  uint16
+	Perm uint16
+	ID   uint32
+}
+
+// GetACL returns the access (or default, for directories) ACL of the file
+// in the short text form of getfacl(1), e.g. "user:1000:rw-".
+func GetACL(path string, isDefault bool) ([]string, error) {
+	value, err := GetXattr(path, aclXattrName(isDefault), false)
+	if err != nil {
+		if errors.Is(err, ErrNoAttribute) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return decodeACL(value)
+}
+
+// SetACL replaces the access (or default, for directories) ACL of the file,
+// an empty list of entries removes the ACL.
+func SetACL(path string, isDefault bool, entries []string) error {
+	if len(entries) == 0 {
+		err := RemoveXattr(path, aclXattrName(isDefault), false)
+		if errors.Is(err, ErrNoAttribute) {
+			return nil
+		}
+
+		return err
+	}
+
+	value, err := encodeACL(entries)
+	if err != nil {
+		return err
+	}
+
+	return SetXattr(path, aclXattrName(isDefault), value, false)
+}
+
+func aclXattrName(isDefault bool) string {
+	if isDefault {
+		return aclDefaultXattr
+	}
+
+	return aclAccessXattr
+}
+
+func decodeACL(value []byte) ([]string, error) {
+	reader := bytes.NewReader(value)
+
+	var header aclHeader
+
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode ACL header: %w", err)
+	}
+
+	if header.Version != aclVersion {
+		return nil, fmt.Errorf("unsupported ACL version %d", header.Version)
+	}
+
+	var result []string
+
+	for reader.Len() != 0 {
+		var entry aclEntry
+
+		if err := binary.Read(reader, binary.LittleEndian, &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode ACL entry: %w", err)
+		}
+
+		var tag, qualifier string
+
+		switch entry.Tag {
+		case aclUserObj:
+			tag = "user"
+		case aclUser:
+			tag = "user"
+			qualifier = strconv.FormatUint(uint64(entry.ID), 10)
+		case aclGroupObj:
+			tag = "group"
+		case aclGroup:
+			tag = "group"
+			qualifier = strconv.FormatUint(uint64(entry.ID), 10)
+		case aclMask:
+			tag = "mask"
+		case aclOther:
+			tag = "other"
+		default:
+			return nil, fmt.Errorf("unsupported ACL entry tag %d", entry.Tag)
+		}
+
+		result = append(result, fmt.Sprintf("%s:%s:%s", tag, qualifier, formatPerm(entry.Perm)))
+	}
+
+	return result, nil
+}
+
+func encodeACL(entries []string) ([]byte, error) {
+	var aclEntries []aclEntry
+
+	for _, entryRaw := range entries {
+		parts := strings.Split(entryRaw, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid ACL entry %q: should be in the \"tag:qualifier:perms\" format",
+				entryRaw)
+		}
+
+		tag, qualifier, permRaw := parts[0], parts[1], parts[2]
+
+		perm, err := parsePerm(permRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ACL entry %q: %w", entryRaw, err)
+		}
+
+		entry := aclEntry{Perm: perm, ID: aclUndefinedID}
+
+		switch {
+		case (tag == "user" || tag == "u") && qualifier == "":
+			entry.Tag = aclUserObj
+		case tag == "user" || tag == "u":
+			entry.Tag = aclUser
+			entry.ID, err = lookupID(qualifier, false)
+		case (tag == "group" || tag == "g") && qualifier == "":
+			entry.Tag = aclGroupObj
+		case tag == "group" || tag == "g":
+			entry.Tag = aclGroup
+			entry.ID, err = lookupID(qualifier, true)
+		case (tag == "mask" || tag == "m") && qualifier == "":
+			entry.Tag = aclMask
+		case (tag == "other" || tag == "o") && qualifier == "":
+			entry.Tag = aclOther
+		default:
+			return nil, fmt.Errorf("invalid ACL entry %q: unsupported tag %q", entryRaw, tag)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid ACL entry %q: %w", entryRaw, err)
+		}
+
+		aclEntries = append(aclEntries, entry)
+	}
+
+	// Kernel only accepts entries sorted by tag and then by ID
+	slices.SortStableFunc(aclEntries, func(a, b aclEntry) int {
+		return cmp.Or(cmp.Compare(a.Tag, b.Tag), cmp.Compare(a.ID, b.ID))
+	})
+
+	buf := &bytes.Buffer{}
+
+	if err := binary.Write(buf, binary.LittleEndian, aclHeader{Version: aclVersion}); err != nil {
+		return nil, err
+	}
+
+	if err := binary.Write(buf, binary.LittleEndian, aclEntries); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func formatPerm(perm uint16) string {
+	result := []byte("---")
+
+	for i, char := range []byte("rwx") {
+		if perm&(0o4>>i) != 0 {
+			result[i] = char
+		}
+	}
+
+	return string(result)
+}
+
+func parsePerm(permRaw string) (uint16, error) {
+	var perm uint16
+
+	for _, char := range permRaw {
+		switch char {
+		case 'r':
+			perm |= 0o4
+		case 'w':
+			perm |= 0o2
+		case 'x':
+			perm |= 0o1
+		case '-':
+			// nothing to do
+		default:
+			return 0, fmt.Errorf("invalid permission %q", char)
+		}
+	}
+
+	return perm, nil
+}
+
+func lookupID(qualifier string, isGroup bool) (uint32, error) {
+	if id, err := strconv.ParseUint(qualifier, 10, 32); err == nil {
+		return uint32(id), nil
+	}
+
+	var idRaw string
+
+	if isGroup {
+		group, err := user.LookupGroup(qualifier)
+		if err != nil {
+			return 0, err
+		}
+
+		idRaw = group.Gid
+	} else {
+		usr, err := user.Lookup(qualifier)
+		if err != nil {
+			return 0, err
+		}
+
+		idRaw = usr.Uid
+	}
+
+	id, err := strconv.ParseUint(idRaw, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(id), nil
+}
@@ -0,0 +1,33 @@
+package fileattr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestACLRoundTrip(t *testing.T) {
+	entries := []string{
+		"user::rwx",
+		"user:1000:r-x",
+		"group::r--",
+		"group:20:-w-",
+		"mask::rwx",
+		"other::---",
+	}
+
+	value, err := encodeACL(entries)
+	require.NoError(t, err)
+	require.Len(t, value, 4+len(entries)*8)
+
+	decoded, err := decodeACL(value)
+	require.NoError(t, err)
+	require.Equal(t, entries, decoded)
+}
+
+func TestACLInvalid(t *testing.T) {
+	for _, entry := range []string{"user:rwx", "other:1000:r--", "user::rwz", "bogus::r--"} {
+		_, err := encodeACL([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
@@ -0,0 +1,73 @@
+package fileattr
+
+import (
+	"fmt"
+	"slices"
+)
+
+// GetFlags returns the file flags both in their raw form and
+// as names, see chflags(1) on macOS and chattr(1) on Linux.
+func GetFlags(path string) (uint32, []string, error) {
+	flags, err := getFlags(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return flags, flagsToNames(flags), nil
+}
+
+// ModifyFlags sets and clears the named file flags,
+// keeping the flags that are not mentioned intact.
+func ModifyFlags(path string, set []string, clear []string) (uint32, error) {
+	flags, err := getFlags(path)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, name := range set {
+		flag, err := nameToFlag(name)
+		if err != nil {
+			return 0, err
+		}
+
+		flags |= flag
+	}
+
+	for _, name := range clear {
+		flag, err := nameToFlag(name)
+		if err != nil {
+			return 0, err
+		}
+
+		flags &^= flag
+	}
+
+	if err := setFlags(path, flags); err != nil {
+		return 0, err
+	}
+
+	return flags, nil
+}
+
+func flagsToNames(flags uint32) []string {
+	var names []string
+
+	for name, flag := range flagNames {
+		if flags&flag != 0 {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+func nameToFlag(name string) (uint32, error) {
+	flag, ok := flagNames[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported file flag %q", name)
+	}
+
+	return flag, nil
+}
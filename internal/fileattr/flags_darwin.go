@@ -0,0 +1,34 @@
+package fileattr
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// File flags from sys/stat.h, named as in chflags(1)
+var flagNames = map[string]uint32{
+	"nodump":     unix.UF_NODUMP,
+	"uchg":       unix.UF_IMMUTABLE,
+	"uappnd":     unix.UF_APPEND,
+	"opaque":     unix.UF_OPAQUE,
+	"compressed": unix.UF_COMPRESSED,
+	"hidden":     unix.UF_HIDDEN,
+	"arch":       unix.SF_ARCHIVED,
+	"schg":       unix.SF_IMMUTABLE,
+	"sappnd":     unix.SF_APPEND,
+	"restricted": unix.SF_RESTRICTED,
+	"sunlnk":     unix.SF_NOUNLINK,
+}
+
+func getFlags(path string) (uint32, error) {
+	var stat unix.Stat_t
+
+	if err := unix.Stat(path, &stat); err != nil {
+		return 0, err
+	}
+
+	return stat.Flags, nil
+}
+
+func setFlags(path string, flags uint32) error {
+	return unix.Chflags(path, int(flags))
+}
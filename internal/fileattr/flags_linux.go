@@ -0,0 +1,45 @@
+package fileattr
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// Inode flags from linux/fs.h, named after their chattr(1) descriptions
+var flagNames = map[string]uint32{
+	"secrm":       0x00000001,
+	"unrm":        0x00000002,
+	"compressed":  0x00000004,
+	"sync":        0x00000008,
+	"immutable":   0x00000010,
+	"append":      0x00000020,
+	"nodump":      0x00000040,
+	"noatime":     0x00000080,
+	"journal":     0x00004000,
+	"notail":      0x00008000,
+	"dirsync":     0x00010000,
+	"topdir":      0x00020000,
+	"extents":     0x00080000,
+	"nocow":       0x00800000,
+	"projinherit": 0x20000000,
+	"casefold":    0x40000000,
+}
+
+func getFlags(path string) (uint32, error) {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(fd)
+
+	return unix.IoctlGetUint32(fd, unix.FS_IOC_GETFLAGS)
+}
+
+func setFlags(path string, flags uint32) error {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	return unix.IoctlSetPointerInt(fd, unix.FS_IOC_SETFLAGS, int(flags))
+}
@@ -0,0 +1,152 @@
+package fileattr
+
+import (
+	"bytes"
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// ErrNoAttribute is returned when the requested extended attribute doesn't exist.
+var ErrNoAttribute = errors.New("no such extended attribute")
+
+type Xattr struct {
+	Name  string
+	Value []byte
+}
+
+func ListXattrs(path string, noFollow bool) ([]string, error) {
+	listxattr := unix.Listxattr
+	if noFollow {
+		listxattr = unix.Llistxattr
+	}
+
+	buf, err := readSized(func(dest []byte) (int, error) {
+		return listxattr(path, dest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, name := range bytes.Split(buf, []byte{0}) {
+		if len(name) == 0 {
+			continue
+		}
+
+		names = append(names, string(name))
+	}
+
+	return names, nil
+}
+
+func GetXattr(path string, name string, noFollow bool) ([]byte, error) {
+	getxattr := unix.Getxattr
+	if noFollow {
+		getxattr = unix.Lgetxattr
+	}
+
+	value, err := readSized(func(dest []byte) (int, error) {
+		return getxattr(path, name, dest)
+	})
+	if err != nil {
+		return nil, wrapNoAttribute(err)
+	}
+
+	return value, nil
+}
+
+// GetXattrs retrieves all extended attributes of the file along with their values.
+func GetXattrs(path string, noFollow bool) ([]Xattr, error) {
+	names, err := ListXattrs(path, noFollow)
+	if err != nil {
+		return nil, err
+	}
+
+	var xattrs []Xattr
+
+	for _, name := range names {
+		value, err := GetXattr(path, name, noFollow)
+		if err != nil {
+			// Attribute might've been removed since we've listed them
+			if errors.Is(err, ErrNoAttribute) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		xattrs = append(xattrs, Xattr{Name: name, Value: value})
+	}
+
+	return xattrs, nil
+}
+
+func SetXattr(path string, name string, value []byte, noFollow bool) error {
+	if noFollow {
+		return unix.Lsetxattr(path, name, value, 0)
+	}
+
+	return unix.Setxattr(path, name, value, 0)
+}
+
+// SetXattrs sets the extended attributes of the file, keeping
+// the attributes that are not mentioned intact.
+func SetXattrs(path string, xattrs []Xattr, noFollow bool) error {
+	for _, xattr := range xattrs {
+		if err := SetXattr(path, xattr.Name, xattr.Value, noFollow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RemoveXattr(path string, name string, noFollow bool) error {
+	var err error
+
+	if noFollow {
+		err = unix.Lremovexattr(path, name)
+	} else {
+		err = unix.Removexattr(path, name)
+	}
+
+	return wrapNoAttribute(err)
+}
+
+// readSized calls the syscall first to determine the buffer size, and then
+// to actually fill the buffer, retrying if the data has grown in-between.
+func readSized(syscall func(dest []byte) (int, error)) ([]byte, error) {
+	for {
+		size, err := syscall(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if size == 0 {
+			return []byte{}, nil
+		}
+
+		buf := make([]byte, size)
+
+		n, err := syscall(buf)
+		if err != nil {
+			if errors.Is(err, unix.ERANGE) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		return buf[:n], nil
+	}
+}
+
+func wrapNoAttribute(err error) error {
+	if errors.Is(err, errNoAttributeErrno) {
+		return errors.Join(ErrNoAttribute, err)
+	}
+
+	return err
+}
@@ -0,0 +1,5 @@
+package fileattr
+
+import "golang.org/x/sys/unix"
+
+const errNoAttributeErrno = unix.ENOATTR
@@ -0,0 +1,118 @@
+package forward
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+)
+
+const bufferSize = 32 * 1024
+
+// Stream is a message-oriented transport (e.g. a gRPC stream)
+// over which the connection's data is carried.
+//
+// An empty message is an EOF marker: it signals that no more
+// data will be sent in this direction.
+type Stream interface {
+	// Recv returns the next chunk of data, or io.EOF
+	// when the remote end has closed the stream
+	Recv() ([]byte, error)
+
+	Send(data []byte) error
+}
+
+type halfCloser interface {
+	CloseWrite() error
+}
+
+// Pipe copies the data between the connection and the stream in both
+// directions until both directions reach EOF, one of them fails or
+// the context is cancelled. The connection is closed on return.
+//
+// Pipe does not return until it has stopped sending to the stream,
+// because gRPC forbids sending after the handler has returned.
+func Pipe(ctx context.Context, conn net.Conn, stream Stream) error {
+	fromStreamErrCh := make(chan error, 1)
+	toStreamErrCh := make(chan error, 1)
+
+	go func() {
+		fromStreamErrCh <- copyFromStream(conn, stream)
+	}()
+
+	go func() {
+		toStreamErrCh <- copyToStream(stream, conn)
+	}()
+
+	var err error
+
+	fromStreamDone, toStreamDone := false, false
+
+	for err == nil && !(fromStreamDone && toStreamDone) {
+		select {
+		case err = <-fromStreamErrCh:
+			fromStreamDone = true
+		case err = <-toStreamErrCh:
+			toStreamDone = true
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+
+	// Closing the connection unblocks the reads from it
+	_ = conn.Close()
+
+	if !toStreamDone {
+		<-toStreamErrCh
+	}
+
+	return err
+}
+
+func copyFromStream(conn net.Conn, stream Stream) error {
+	for {
+		// Closing the stream implies EOF
+		data, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if len(data) != 0 {
+			if _, err := conn.Write(data); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// Propagate EOF, keeping the connection open for reading
+		if closer, ok := conn.(halfCloser); ok {
+			if err := closer.CloseWrite(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func copyToStream(stream Stream, conn net.Conn) error {
+	buf := make([]byte, bufferSize)
+
+	for {
+		n, err := conn.Read(buf)
+		if n != 0 {
+			if err := stream.Send(buf[:n]); err != nil {
+				return err
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return stream.Send(nil)
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package forward
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type chanStream struct {
+	in  chan []byte
+	out chan []byte
+}
+
+func (stream *chanStream) Recv() ([]byte, error) {
+	data, ok := <-stream.in
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return data, nil
+}
+
+func (stream *chanStream) Send(data []byte) error {
+	// The buffer is reused by the caller
+	stream.out <- bytes.Clone(data)
+
+	return nil
+}
+
+func TestPipeHalfClose(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// Server that replies only after reading the whole request
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		request, err := io.ReadAll(conn)
+		if err != nil {
+			return
+		}
+
+		_, _ = conn.Write(append([]byte("got "), request...))
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+
+	stream := &chanStream{
+		in:  make(chan []byte, 3),
+		out: make(chan []byte, 16),
+	}
+	stream.in <- []byte("hello, ")
+	stream.in <- []byte("world")
+	stream.in <- []byte{}
+
+	require.NoError(t, Pipe(context.Background(), conn, stream))
+	close(stream.out)
+
+	var response []byte
+	var sawEOF bool
+
+	for data := range stream.out {
+		require.False(t, sawEOF, "received data after EOF")
+
+		if len(data) == 0 {
+			sawEOF = true
+		}
+
+		response = append(response, data...)
+	}
+
+	require.True(t, sawEOF)
+	require.Equal(t, "got hello, world", string(response))
+}
+
+func TestPipeContextCancellation(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+
+	stream := &chanStream{
+		in:  make(chan []byte),
+		out: make(chan []byte, 16),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, Pipe(ctx, conn, stream), context.Canceled)
+}
+
+type returnedStream struct {
+	returned        atomic.Bool
+	sentAfterReturn atomic.Bool
+}
+
+func (stream *returnedStream) Recv() ([]byte, error) {
+	select {}
+}
+
+func (stream *returnedStream) Send(_ []byte) error {
+	if stream.returned.Load() {
+		stream.sentAfterReturn.Store(true)
+	}
+
+	return nil
+}
+
+func TestPipeDoesNotSendAfterReturn(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// Server that keeps sending data
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			if _, err := conn.Write([]byte("data")); err != nil {
+				return
+			}
+		}
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+
+	stream := &returnedStream{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, Pipe(ctx, conn, stream), context.DeadlineExceeded)
+	stream.returned.Store(true)
+
+	time.Sleep(100 * time.Millisecond)
+	require.False(t, stream.sentAfterReturn.Load())
+}
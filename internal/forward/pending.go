@@ -0,0 +1,60 @@
+package forward
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultPendingTimeout is how long an accepted connection
+// waits for the host to pick it up before being closed.
+const DefaultPendingTimeout = 30 * time.Second
+
+// Pending keeps track of the connections that were accepted inside
+// the guest, but not yet picked up by the host.
+type Pending[T io.Closer] struct {
+	timeout time.Duration
+
+	conns map[string]T
+	mtx   sync.Mutex
+}
+
+func NewPending[T io.Closer](timeout time.Duration) *Pending[T] {
+	return &Pending[T]{
+		timeout: timeout,
+		conns:   map[string]T{},
+	}
+}
+
+// Add registers the connection and returns an ID with which
+// it can be picked up. Connections that are not picked up
+// within the timeout are closed.
+func (pending *Pending[T]) Add(conn T) string {
+	id := rand.Text()
+
+	pending.mtx.Lock()
+	pending.conns[id] = conn
+	pending.mtx.Unlock()
+
+	time.AfterFunc(pending.timeout, func() {
+		if conn, ok := pending.Take(id); ok {
+			_ = conn.Close()
+		}
+	})
+
+	return id
+}
+
+// Take picks up the connection with the given ID.
+func (pending *Pending[T]) Take(id string) (T, bool) {
+	pending.mtx.Lock()
+	defer pending.mtx.Unlock()
+
+	conn, ok := pending.conns[id]
+	if ok {
+		delete(pending.conns, id)
+	}
+
+	return conn, ok
+}
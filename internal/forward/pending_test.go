@@ -0,0 +1,41 @@
+package forward
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPending(t *testing.T) {
+	pending := NewPending[net.Conn](time.Minute)
+
+	conn, _ := net.Pipe()
+	id := pending.Add(conn)
+
+	_, ok := pending.Take("nonexistent")
+	require.False(t, ok)
+
+	takenConn, ok := pending.Take(id)
+	require.True(t, ok)
+	require.Equal(t, conn, takenConn)
+
+	// Connections can only be taken once
+	_, ok = pending.Take(id)
+	require.False(t, ok)
+}
+
+func TestPendingTimeout(t *testing.T) {
+	pending := NewPending[net.Conn](10 * time.Millisecond)
+
+	conn, peer := net.Pipe()
+	id := pending.Add(conn)
+
+	// Reading from the peer fails once the connection is closed
+	_, err := peer.Read(make([]byte, 1))
+	require.Error(t, err)
+
+	_, ok := pending.Take(id)
+	require.False(t, ok)
+}
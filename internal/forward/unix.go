@@ -0,0 +1,97 @@
+package forward
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// ListenUnix listens on a unix domain socket at path and sets the socket
+// file's permissions to mode, unless it's zero. A stale socket file left
+// behind by a previous listener is replaced, but a socket that is still
+// in use is not. The socket file is removed when the listener is closed.
+func ListenUnix(path string, mode fs.FileMode) (net.Listener, error) {
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	if mode == 0 {
+		return net.Listen("unix", path)
+	}
+
+	// Create the socket in a private directory first and only move it
+	// in place once its permissions are set, so that it's never exposed
+	// with the permissions derived from the umask
+	privateDir, err := os.MkdirTemp(filepath.Dir(path), ".sock")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(privateDir)
+
+	privatePath := filepath.Join(privateDir, "s")
+
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: privatePath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+
+	// The socket file is moved, so remove it ourselves
+	listener.SetUnlinkOnClose(false)
+
+	if err := os.Chmod(privatePath, mode); err != nil {
+		_ = listener.Close()
+
+		return nil, err
+	}
+
+	if err := os.Rename(privatePath, path); err != nil {
+		_ = listener.Close()
+
+		return nil, err
+	}
+
+	return &unixListener{UnixListener: listener, path: path}, nil
+}
+
+type unixListener struct {
+	*net.UnixListener
+
+	path string
+}
+
+func (listener *unixListener) Close() error {
+	err := listener.UnixListener.Close()
+
+	if removeErr := os.Remove(listener.path); removeErr != nil && !errors.Is(removeErr, fs.ErrNotExist) {
+		return errors.Join(err, removeErr)
+	}
+
+	return err
+}
+
+func removeStaleSocket(path string) error {
+	fileInfo, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if fileInfo.Mode().Type() != fs.ModeSocket {
+		return fmt.Errorf("%s already exists and is not a socket", path)
+	}
+
+	conn, err := net.Dial("unix", path)
+	if err == nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("%s is already in use", path)
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,83 @@
+package forward
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent.sock")
+
+	listener, err := ListenUnix(path, 0600)
+	require.NoError(t, err)
+
+	fileInfo, err := os.Stat(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 0600, fileInfo.Mode().Perm())
+
+	// The socket is still in use
+	_, err = ListenUnix(path, 0600)
+	require.ErrorContains(t, err, "already in use")
+
+	require.NoError(t, listener.Close())
+	require.NoFileExists(t, path)
+}
+
+func TestListenUnixStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent.sock")
+
+	// Leave a socket file behind
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	listener.SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+	require.FileExists(t, path)
+
+	newListener, err := ListenUnix(path, 0)
+	require.NoError(t, err)
+	require.NoError(t, newListener.Close())
+}
+
+func TestListenUnixRegularFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent.sock")
+	require.NoError(t, os.WriteFile(path, []byte("important"), 0600))
+
+	_, err := ListenUnix(path, 0)
+	require.ErrorContains(t, err, "not a socket")
+}
+
+func TestListenUnixAccepts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent.sock")
+
+	listener, err := ListenUnix(path, 0600)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		_, _ = conn.Write([]byte("hello"))
+		_ = conn.Close()
+	}()
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	data, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(data))
+
+	// No temporary directories are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,81 @@
+package glob
+
+import (
+	"path"
+	"strings"
+)
+
+const doubleStar = "**"
+
+// Match reports whether the slash-separated name matches the pattern.
+//
+// The pattern syntax is the one of path.Match, with the addition
+// of the "**" path segment which matches zero or more path segments.
+func Match(pattern string, name string) (bool, error) {
+	// Make sure that the pattern is well-formed before matching,
+	// otherwise malformed patterns are only detected when
+	// the matching reaches the bad segment
+	if err := Validate(pattern); err != nil {
+		return false, err
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/")), nil
+}
+
+// Validate returns path.ErrBadPattern if the pattern is malformed.
+func Validate(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if segment == doubleStar {
+			continue
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasMeta reports whether the pattern contains any of the special characters.
+func HasMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[\`)
+}
+
+func matchSegments(patternSegments []string, nameSegments []string) bool {
+	for len(patternSegments) != 0 {
+		if patternSegments[0] == doubleStar {
+			// Collapse consecutive "**" segments
+			for len(patternSegments) != 0 && patternSegments[0] == doubleStar {
+				patternSegments = patternSegments[1:]
+			}
+
+			// Trailing "**" matches everything that's left
+			if len(patternSegments) == 0 {
+				return true
+			}
+
+			for i := range len(nameSegments) + 1 {
+				if matchSegments(patternSegments, nameSegments[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(nameSegments) == 0 {
+			return false
+		}
+
+		// Errors are already ruled out by Validate()
+		if ok, _ := path.Match(patternSegments[0], nameSegments[0]); !ok {
+			return false
+		}
+
+		patternSegments = patternSegments[1:]
+		nameSegments = nameSegments[1:]
+	}
+
+	return len(nameSegments) == 0
+}
@@ -0,0 +1,42 @@
+package glob
+
+import (
+	"github.com/stretchr/testify/require"
+	"path"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Name     string
+		Expected bool
+	}{
+		{"*.log", "build.log", true},
+		{"*.log", "logs/build.log", false},
+		{"**/*.log", "build.log", true},
+		{"**/*.log", "logs/build.log", true},
+		{"**/*.log", "logs/nested/build.log", true},
+		{"logs/**", "logs", true},
+		{"logs/**", "logs/nested/build.log", true},
+		{"logs/**/build.log", "logs/build.log", true},
+		{"logs/**/build.log", "logs/a/b/c/build.log", true},
+		{"logs/**/build.log", "other/a/build.log", false},
+		{"**/**/*.xcresult", "a/b/Test.xcresult", true},
+		{"a/?/c", "a/b/c", true},
+		{"a/?/c", "a/bb/c", false},
+		{"report-[0-9].xml", "report-7.xml", true},
+	}
+
+	for _, testCase := range testCases {
+		matched, err := Match(testCase.Pattern, testCase.Name)
+		require.NoError(t, err)
+		require.Equal(t, testCase.Expected, matched, "pattern %q, name %q",
+			testCase.Pattern, testCase.Name)
+	}
+}
+
+func TestMatchBadPattern(t *testing.T) {
+	_, err := Match("**/[", "anything")
+	require.ErrorIs(t, err, path.ErrBadPattern)
+}
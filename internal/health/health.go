@@ -0,0 +1,87 @@
+package health
+
+import (
+	"sync"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Names of the agent components, which are also used as service
+// names when checking their health, except for ComponentDiskResizer.
+const (
+	ComponentDiskResizer   = "disk-resizer"
+	ComponentIdentityReset = "identity-reset"
+	ComponentVdagent       = "vdagent"
+	ComponentRPC           = "rpc"
+)
+
+// Reporter keeps track of the agent components' health and exposes
+// it via the standard gRPC health checking service (grpc.health.v1),
+// with a service name per component and an empty service name for
+// the agent as a whole, which is serving when all components are.
+type Reporter struct {
+	server *grpchealth.Server
+
+	serving map[string]bool
+	mtx     sync.Mutex
+}
+
+// NewReporter creates a reporter for the specified components,
+// all of which are initially considered to be not serving.
+func NewReporter(components ...string) *Reporter {
+	reporter := &Reporter{
+		server:  grpchealth.NewServer(),
+		serving: map[string]bool{},
+	}
+
+	for _, component := range components {
+		reporter.serving[component] = false
+		reporter.server.SetServingStatus(component, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	reporter.updateOverall()
+
+	return reporter
+}
+
+// Server returns the gRPC health checking service, which outlives
+// the RPC server restarts because it's owned by the reporter.
+func (reporter *Reporter) Server() healthpb.HealthServer {
+	return reporter.server
+}
+
+// SetServing updates the health of a component.
+func (reporter *Reporter) SetServing(component string, serving bool) {
+	reporter.mtx.Lock()
+	defer reporter.mtx.Unlock()
+
+	if current, ok := reporter.serving[component]; ok && current == serving {
+		return
+	}
+
+	reporter.serving[component] = serving
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	reporter.server.SetServingStatus(component, status)
+
+	reporter.updateOverall()
+}
+
+func (reporter *Reporter) updateOverall() {
+	status := healthpb.HealthCheckResponse_SERVING
+
+	for _, serving := range reporter.serving {
+		if !serving {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+
+			break
+		}
+	}
+
+	reporter.server.SetServingStatus("", status)
+}
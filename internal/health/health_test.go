@@ -0,0 +1,45 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestReporter(t *testing.T) {
+	reporter := NewReporter(ComponentVdagent, ComponentRPC)
+
+	requireStatus := func(service string, expected healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+
+		response, err := reporter.Server().Check(context.Background(), &healthpb.HealthCheckRequest{
+			Service: service,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, response.Status)
+	}
+
+	requireStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus(ComponentVdagent, healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus(ComponentRPC, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	reporter.SetServing(ComponentRPC, true)
+	requireStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus(ComponentRPC, healthpb.HealthCheckResponse_SERVING)
+
+	reporter.SetServing(ComponentVdagent, true)
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+	requireStatus(ComponentVdagent, healthpb.HealthCheckResponse_SERVING)
+
+	reporter.SetServing(ComponentVdagent, false)
+	requireStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus(ComponentVdagent, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	// Components that are not enabled are unknown
+	_, err := reporter.Server().Check(context.Background(), &healthpb.HealthCheckRequest{
+		Service: ComponentDiskResizer,
+	})
+	require.Error(t, err)
+}
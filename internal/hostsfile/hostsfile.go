@@ -0,0 +1,227 @@
+package hostsfile
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+const (
+	DefaultPath = "/etc/hosts"
+
+	beginMarker = "# BEGIN tart-guest-agent"
+	endMarker   = "# END tart-guest-agent"
+)
+
+var ErrMalformedBlock = errors.New("malformed tart-guest-agent block")
+
+// Serializes the read-modify-write cycles of SetEntries, otherwise
+// the concurrent callers would overwrite each other's changes
+var mtx sync.Mutex
+
+type Entry struct {
+	IP        netip.Addr
+	Hostnames []string
+}
+
+func (entry Entry) Validate() error {
+	if !entry.IP.IsValid() {
+		return fmt.Errorf("invalid IP address")
+	}
+
+	if len(entry.Hostnames) == 0 {
+		return fmt.Errorf("entry for %s has no hostnames", entry.IP)
+	}
+
+	for _, hostname := range entry.Hostnames {
+		if hostname == "" || strings.ContainsAny(hostname, " \t\r\n#") {
+			return fmt.Errorf("invalid hostname %q", hostname)
+		}
+	}
+
+	return nil
+}
+
+// Entries returns the agent-owned entries of the hosts file,
+// i.e. the ones between the marker comments.
+func Entries(path string) ([]Entry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, _, _, err := split(content)
+
+	return entries, err
+}
+
+// SetEntries replaces the agent-owned entries of the hosts file, leaving
+// the rest of the file intact. The marker comments are removed along with
+// the entries when there are none.
+func SetEntries(path string, entries []Entry) error {
+	for _, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	_, before, after, err := split(content)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	buf.Write(before)
+
+	if len(entries) != 0 {
+		if buf.Len() != 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteString("\n")
+		}
+
+		buf.WriteString(beginMarker + "\n")
+
+		for _, entry := range entries {
+			fmt.Fprintf(&buf, "%s\t%s\n", entry.IP, strings.Join(entry.Hostnames, " "))
+		}
+
+		buf.WriteString(endMarker + "\n")
+	}
+
+	buf.Write(after)
+
+	if bytes.Equal(buf.Bytes(), content) {
+		return nil
+	}
+
+	return writeFile(path, buf.Bytes())
+}
+
+// split parses the agent-owned entries and returns
+// the contents of the file before and after them.
+func split(content []byte) ([]Entry, []byte, []byte, error) {
+	var entries []Entry
+	var before, after bytes.Buffer
+
+	current := &before
+	inBlock, seenBlock := false, false
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch strings.TrimSpace(line) {
+		case beginMarker:
+			if inBlock || seenBlock {
+				return nil, nil, nil, fmt.Errorf("%w: unexpected %q", ErrMalformedBlock, beginMarker)
+			}
+
+			inBlock = true
+
+			continue
+		case endMarker:
+			if !inBlock {
+				return nil, nil, nil, fmt.Errorf("%w: unexpected %q", ErrMalformedBlock, endMarker)
+			}
+
+			inBlock, seenBlock = false, true
+			current = &after
+
+			continue
+		}
+
+		if !inBlock {
+			current.WriteString(line + "\n")
+
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		ip, err := netip.ParseAddr(fields[0])
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: %v", ErrMalformedBlock, err)
+		}
+
+		entries = append(entries, Entry{
+			IP:        ip,
+			Hostnames: fields[1:],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if inBlock {
+		return nil, nil, nil, fmt.Errorf("%w: missing %q", ErrMalformedBlock, endMarker)
+	}
+
+	// Preserve the lack of a trailing newline in the user's part of the file
+	if !seenBlock && len(content) != 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		before.Truncate(before.Len() - 1)
+	}
+
+	return entries, before.Bytes(), after.Bytes(), nil
+}
+
+// writeFile atomically replaces the file's contents, preserving its permissions
+// and ownership. Falls back to writing in-place when the file cannot be replaced
+// (e.g. when it's bind-mounted into a container).
+func writeFile(path string, content []byte) error {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return os.WriteFile(path, content, fileInfo.Mode().Perm())
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Chmod(fileInfo.Mode().Perm()); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if stat, ok := fileInfo.Sys().(*syscall.Stat_t); ok {
+		_ = tmpFile.Chown(int(stat.Uid), int(stat.Gid))
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return os.WriteFile(path, content, fileInfo.Mode().Perm())
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package hostsfile
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const systemHosts = `127.0.0.1	localhost
+::1	localhost ip6-localhost ip6-loopback
+`
+
+func TestSetEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts")
+	require.NoError(t, os.WriteFile(path, []byte(systemHosts), 0644))
+
+	entries := []Entry{
+		{IP: netip.MustParseAddr("192.168.64.1"), Hostnames: []string{"api.example.test", "api"}},
+		{IP: netip.MustParseAddr("fd00::1"), Hostnames: []string{"db.example.test"}},
+	}
+
+	require.NoError(t, SetEntries(path, entries))
+	requireContent(t, path, systemHosts+`# BEGIN tart-guest-agent
+192.168.64.1	api.example.test api
+fd00::1	db.example.test
+# END tart-guest-agent
+`)
+
+	actualEntries, err := Entries(path)
+	require.NoError(t, err)
+	require.Equal(t, entries, actualEntries)
+
+	// Setting the same entries again is a no-op
+	require.NoError(t, SetEntries(path, entries))
+	requireContent(t, path, systemHosts+`# BEGIN tart-guest-agent
+192.168.64.1	api.example.test api
+fd00::1	db.example.test
+# END tart-guest-agent
+`)
+
+	// Entries are replaced as a set, and the lines
+	// added after the block by the user are preserved
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString("10.0.0.1\tuser.example.test\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	require.NoError(t, SetEntries(path, entries[1:]))
+	requireContent(t, path, systemHosts+`# BEGIN tart-guest-agent
+fd00::1	db.example.test
+# END tart-guest-agent
+10.0.0.1	user.example.test
+`)
+
+	// Setting no entries removes the block
+	require.NoError(t, SetEntries(path, nil))
+	requireContent(t, path, systemHosts+"10.0.0.1\tuser.example.test\n")
+
+	actualEntries, err = Entries(path)
+	require.NoError(t, err)
+	require.Empty(t, actualEntries)
+
+	fileInfo, err := os.Stat(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 0644, fileInfo.Mode().Perm())
+}
+
+func TestSetEntriesNoTrailingNewline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts")
+	require.NoError(t, os.WriteFile(path, []byte("127.0.0.1 localhost"), 0644))
+
+	entry := Entry{IP: netip.MustParseAddr("192.168.64.1"), Hostnames: []string{"api.example.test"}}
+
+	require.NoError(t, SetEntries(path, []Entry{entry}))
+	requireContent(t, path, `127.0.0.1 localhost
+# BEGIN tart-guest-agent
+192.168.64.1	api.example.test
+# END tart-guest-agent
+`)
+
+	// Nothing changes if there's nothing to remove
+	require.NoError(t, os.WriteFile(path, []byte("127.0.0.1 localhost"), 0644))
+	require.NoError(t, SetEntries(path, nil))
+	requireContent(t, path, "127.0.0.1 localhost")
+}
+
+func TestMalformedBlock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts")
+	require.NoError(t, os.WriteFile(path, []byte(systemHosts+"# BEGIN tart-guest-agent\n"), 0644))
+
+	_, err := Entries(path)
+	require.ErrorIs(t, err, ErrMalformedBlock)
+
+	require.ErrorIs(t, SetEntries(path, nil), ErrMalformedBlock)
+}
+
+func TestInvalidEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts")
+	require.NoError(t, os.WriteFile(path, []byte(systemHosts), 0644))
+
+	require.Error(t, SetEntries(path, []Entry{{IP: netip.MustParseAddr("192.168.64.1")}}))
+	require.Error(t, SetEntries(path, []Entry{{
+		IP:        netip.MustParseAddr("192.168.64.1"),
+		Hostnames: []string{"api.example.test\n0.0.0.0 example.com"},
+	}}))
+
+	requireContent(t, path, systemHosts)
+}
+
+func requireContent(t *testing.T, path string, expected string) {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(content))
+}
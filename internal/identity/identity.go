@@ -0,0 +1,265 @@
+package identity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrAlreadyReset    = errors.New("identity was already reset for this VM")
+	ErrInvalidHostname = errors.New("invalid hostname")
+)
+
+// Options specify the hostname to set, either verbatim or derived from a template
+// with the "{id}" placeholder, which is replaced with a stable per-VM identifier,
+// and the "{random}" placeholder, which is replaced with random characters. The
+// hostname is left as is when neither is specified.
+type Options struct {
+	Hostname         string
+	HostnameTemplate string
+}
+
+type Result struct {
+	Hostname  string
+	MachineID string
+}
+
+// mtx serializes the resets performed on
+// startup and the ones requested via RPC
+var mtx sync.Mutex
+
+// rootDir and fingerprintFunc are only overridden in tests,
+// which must not touch the system's identifiers and keys
+var (
+	rootDir         = "/"
+	fingerprintFunc = hardwareFingerprint
+)
+
+// state is recorded after the reset to make it idempotent. Because it is
+// cloned along with the rest of the disk, it holds a fingerprint of the VM's
+// hardware identity, which differs between the clones, instead of a flag.
+type state struct {
+	Fingerprint string    `json:"fingerprint"`
+	ResetAt     time.Time `json:"reset_at"`
+}
+
+// Reset regenerates the machine identifiers that are shared by the VMs
+// cloned from the same image, unless it was already done for this VM.
+func Reset(statePath string, options Options, force bool) (*Result, error) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	fingerprint, err := fingerprintFunc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the VM's hardware fingerprint: %w", err)
+	}
+
+	hostname := options.Hostname
+
+	if hostname == "" && options.HostnameTemplate != "" {
+		hostname, err = expandTemplate(options.HostnameTemplate, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate the hostname before changing anything
+	if hostname != "" {
+		if err := validateHostname(hostname); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidHostname, err)
+		}
+	}
+
+	if !force {
+		previousState, err := readState(statePath)
+		if err != nil {
+			return nil, err
+		}
+
+		if previousState != nil && previousState.Fingerprint == fingerprint {
+			return nil, ErrAlreadyReset
+		}
+	}
+
+	result := &Result{}
+
+	if result.MachineID, err = resetMachineID(); err != nil {
+		return nil, fmt.Errorf("failed to reset machine ID: %w", err)
+	}
+
+	if err := resetSSHHostKeys(); err != nil {
+		return nil, fmt.Errorf("failed to regenerate SSH host keys: %w", err)
+	}
+
+	if hostname != "" {
+		zap.S().Infof("setting hostname to %s...", hostname)
+
+		if err := setHostname(hostname); err != nil {
+			return nil, fmt.Errorf("failed to set hostname: %w", err)
+		}
+	}
+
+	if result.Hostname, err = os.Hostname(); err != nil {
+		return nil, err
+	}
+
+	if err := writeState(statePath, &state{
+		Fingerprint: fingerprint,
+		ResetAt:     time.Now(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record the identity reset: %w", err)
+	}
+
+	return result, nil
+}
+
+// SetHostname only sets the hostname, which is useful when the identity
+// was already reset and regenerating the keys once more is undesired.
+func SetHostname(hostname string) (string, error) {
+	if err := validateHostname(hostname); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidHostname, err)
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	zap.S().Infof("setting hostname to %s...", hostname)
+
+	if err := setHostname(hostname); err != nil {
+		return "", fmt.Errorf("failed to set hostname: %w", err)
+	}
+
+	return os.Hostname()
+}
+
+func readState(path string) (*state, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var result state
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		// Treat the corrupted state as missing
+		zap.S().Warnf("ignoring the malformed identity reset state in %s: %v", path, err)
+
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
+func writeState(path string, state *state) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return writeFile(path, data, 0644)
+}
+
+// writeFile atomically replaces the file's contents, so that
+// a crash in the middle does not leave it truncated.
+func writeFile(path string, content []byte, perm os.FileMode) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Chmod(perm); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Sync(); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+// hostPath resolves the path relative to rootDir.
+func hostPath(path string) string {
+	return filepath.Join(rootDir, path)
+}
+
+func expandTemplate(template string, fingerprint string) (string, error) {
+	sum := sha256.Sum256([]byte(fingerprint))
+
+	var random [3]byte
+
+	if _, err := rand.Read(random[:]); err != nil {
+		return "", err
+	}
+
+	return strings.NewReplacer(
+		"{id}", hex.EncodeToString(sum[:4]),
+		"{random}", hex.EncodeToString(random[:]),
+	).Replace(template), nil
+}
+
+// validateHostname accepts the hostnames that consist of
+// letters, digits and hyphens, which are also valid as
+// the macOS LocalHostName, see RFC 1123.
+func validateHostname(hostname string) error {
+	if len(hostname) > 63 {
+		return fmt.Errorf("hostname %q is longer than 63 characters", hostname)
+	}
+
+	if strings.HasPrefix(hostname, "-") || strings.HasSuffix(hostname, "-") {
+		return fmt.Errorf("hostname %q starts or ends with a hyphen", hostname)
+	}
+
+	for _, r := range hostname {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return fmt.Errorf("hostname %q contains an invalid character %q", hostname, r)
+		}
+	}
+
+	return nil
+}
+
+func run(name string, args ...string) error {
+	output, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%q failed: %w: %s", strings.Join(append([]string{name}, args...), " "),
+			err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
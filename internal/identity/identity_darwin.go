@@ -0,0 +1,40 @@
+package identity
+
+import (
+	"os/exec"
+)
+
+func DefaultStatePath() string {
+	return "/var/db/tart-guest-agent/identity.json"
+}
+
+// hardwareFingerprint returns the platform UUID,
+// which "tart clone" regenerates.
+func hardwareFingerprint() (string, error) {
+	output, err := exec.Command("ioreg", "-r", "-d", "1", "-c", "IOPlatformExpertDevice", "-a").Output()
+	if err != nil {
+		return "", err
+	}
+
+	return parsePlatformUUID(output)
+}
+
+// resetMachineID does nothing because macOS has no
+// machine ID, the platform UUID is unique to each clone.
+func resetMachineID() (string, error) {
+	return "", nil
+}
+
+func setHostname(hostname string) error {
+	for _, name := range []string{"ComputerName", "LocalHostName", "HostName"} {
+		if err := run("scutil", "--set", name, hostname); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restartSSHServer does nothing because launchd starts sshd(8)
+// on demand, which loads the host keys for each connection.
+func restartSSHServer() {}
@@ -0,0 +1,211 @@
+package identity
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"unsafe"
+
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	machineIDPath     = "/etc/machine-id"
+	dbusMachineIDPath = "/var/lib/dbus/machine-id"
+	hostnamePath      = "/etc/hostname"
+
+	// addrAssignTypePermanent is NET_ADDR_PERM, see linux/netdevice.h
+	addrAssignTypePermanent = "0"
+)
+
+func DefaultStatePath() string {
+	return "/var/lib/tart-guest-agent/identity.json"
+}
+
+// hardwareFingerprint returns the permanent MAC addresses of the network
+// interfaces backed by a device, which "tart clone" regenerates. The current
+// addresses can't be used because they may be randomized or overridden by
+// NetworkManager, systemd-networkd or the agent's own network configuration.
+func hardwareFingerprint() (string, error) {
+	devices, err := filepath.Glob(hostPath("/sys/class/net/*/device"))
+	if err != nil {
+		return "", err
+	}
+
+	var addresses []string
+
+	for _, device := range devices {
+		address, ok, err := permanentAddress(filepath.Dir(device))
+		if err != nil {
+			return "", err
+		}
+
+		if !ok {
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	if len(addresses) == 0 {
+		return "", errors.New("no network interfaces with a permanent MAC address found")
+	}
+
+	slices.Sort(addresses)
+
+	return strings.Join(addresses, ","), nil
+}
+
+// permanentAddress returns the interface's permanent MAC address as reported by
+// the driver, see "ethtool --show-permaddr". When the driver doesn't report one,
+// the current address is used instead, but only if it was not changed since the
+// interface was registered, see addr_assign_type in sysfs-class-net(5).
+func permanentAddress(interfaceDir string) (string, bool, error) {
+	if address, err := ethtoolPermanentAddress(filepath.Base(interfaceDir)); err == nil && address != "" {
+		return address, true, nil
+	}
+
+	assignType, err := os.ReadFile(filepath.Join(interfaceDir, "addr_assign_type"))
+	if err != nil {
+		return "", false, err
+	}
+
+	if strings.TrimSpace(string(assignType)) != addrAssignTypePermanent {
+		zap.S().Debugf("skipping %s for the hardware fingerprint: its MAC address was changed",
+			filepath.Base(interfaceDir))
+
+		return "", false, nil
+	}
+
+	address, err := os.ReadFile(filepath.Join(interfaceDir, "address"))
+	if err != nil {
+		return "", false, err
+	}
+
+	return strings.TrimSpace(string(address)), true, nil
+}
+
+// ethtoolPermAddr mirrors the kernel's struct ethtool_perm_addr
+// followed by the space for the address, see linux/ethtool.h.
+type ethtoolPermAddr struct {
+	cmd  uint32
+	size uint32
+	data [32]byte
+}
+
+// ifreqData mirrors the kernel's struct ifreq with the ifr_data member.
+type ifreqData struct {
+	name [unix.IFNAMSIZ]byte
+	data unsafe.Pointer
+	_    [24 - unsafe.Sizeof(unsafe.Pointer(nil))]byte
+}
+
+// ethtoolPermanentAddress returns an empty string
+// if the driver reports no permanent address.
+func ethtoolPermanentAddress(name string) (string, error) {
+	if len(name) >= unix.IFNAMSIZ {
+		return "", fmt.Errorf("interface name %q is too long", name)
+	}
+
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return "", err
+	}
+	defer unix.Close(fd)
+
+	permAddr := ethtoolPermAddr{
+		cmd:  unix.ETHTOOL_GPERMADDR,
+		size: uint32(len(ethtoolPermAddr{}.data)),
+	}
+
+	request := ifreqData{data: unsafe.Pointer(&permAddr)}
+	copy(request.name[:], name)
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), unix.SIOCETHTOOL, uintptr(unsafe.Pointer(&request)))
+	runtime.KeepAlive(&permAddr)
+	if errno != 0 {
+		return "", errno
+	}
+
+	address := permAddr.data[:min(int(permAddr.size), len(permAddr.data))]
+
+	if len(address) == 0 || bytes.Count(address, []byte{0}) == len(address) {
+		return "", nil
+	}
+
+	return net.HardwareAddr(address).String(), nil
+}
+
+func resetMachineID() (string, error) {
+	machineID, err := newMachineID()
+	if err != nil {
+		return "", err
+	}
+
+	zap.S().Infof("setting machine ID to %s...", machineID)
+
+	if err := writeFile(hostPath(machineIDPath), []byte(machineID+"\n"), 0444); err != nil {
+		return "", err
+	}
+
+	// Older distributions keep a copy for D-Bus instead of a symbolic link
+	if info, err := os.Lstat(hostPath(dbusMachineIDPath)); err == nil && info.Mode().IsRegular() {
+		if err := writeFile(hostPath(dbusMachineIDPath), []byte(machineID+"\n"), 0444); err != nil {
+			return "", err
+		}
+	}
+
+	return machineID, nil
+}
+
+// newMachineID generates a random machine ID
+// the same way as systemd, see machine-id(5).
+func newMachineID() (string, error) {
+	var id [16]byte
+
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+
+	// Mark the ID as a UUID version 4, variant 1
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	return hex.EncodeToString(id[:]), nil
+}
+
+func setHostname(hostname string) error {
+	if _, err := exec.LookPath("hostnamectl"); err == nil {
+		return run("hostnamectl", "set-hostname", hostname)
+	}
+
+	if err := writeFile(hostPath(hostnamePath), []byte(hostname+"\n"), 0644); err != nil {
+		return err
+	}
+
+	return unix.Sethostname([]byte(hostname))
+}
+
+func restartSSHServer() {
+	if _, err := exec.LookPath("systemctl"); err != nil {
+		return
+	}
+
+	// The unit is named differently across the distributions,
+	// and "try-restart" does nothing if it is not running
+	for _, unit := range []string{"ssh.service", "sshd.service"} {
+		if err := run("systemctl", "try-restart", unit); err != nil {
+			zap.S().Debugf("failed to restart %s: %v", unit, err)
+		}
+	}
+}
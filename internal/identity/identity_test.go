@@ -0,0 +1,171 @@
+package identity
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	hostname, err := expandTemplate("ci-{id}", "52:54:00:12:34:56")
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^ci-[0-9a-f]{8}$`), hostname)
+
+	// The identifier is stable for the same VM
+	sameHostname, err := expandTemplate("ci-{id}", "52:54:00:12:34:56")
+	require.NoError(t, err)
+	require.Equal(t, hostname, sameHostname)
+
+	hostname, err = expandTemplate("ci-{random}", "52:54:00:12:34:56")
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^ci-[0-9a-f]{6}$`), hostname)
+}
+
+func TestValidateHostname(t *testing.T) {
+	require.NoError(t, validateHostname("ci-runner-1"))
+	require.Error(t, validateHostname("-runner"))
+	require.Error(t, validateHostname("runner.local"))
+	require.Error(t, validateHostname("runner 1"))
+	require.Error(t, validateHostname(string(make([]byte, 64))))
+}
+
+func TestState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "identity.json")
+
+	missingState, err := readState(path)
+	require.NoError(t, err)
+	require.Nil(t, missingState)
+
+	expectedState := &state{
+		Fingerprint: "52:54:00:12:34:56",
+		ResetAt:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, writeState(path, expectedState))
+
+	actualState, err := readState(path)
+	require.NoError(t, err)
+	require.Equal(t, expectedState, actualState)
+}
+
+func TestParsePlatformUUID(t *testing.T) {
+	platformUUID, err := parsePlatformUUID([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<array>
+	<dict>
+		<key>IOPlatformSerialNumber</key>
+		<string>ZV6G9QXWL1</string>
+		<key>IOPlatformUUID</key>
+		<string>3F2504E0-4F89-11D3-9A0C-0305E82C3301</string>
+	</dict>
+</array>
+</plist>
+`))
+	require.NoError(t, err)
+	require.Equal(t, "3F2504E0-4F89-11D3-9A0C-0305E82C3301", platformUUID)
+}
+
+func TestReset(t *testing.T) {
+	fingerprint := "52:54:00:12:34:56"
+	fakeSystem(t, &fingerprint)
+
+	statePath := filepath.Join(rootDir, "identity.json")
+
+	result, err := Reset(statePath, Options{}, false)
+	require.NoError(t, err)
+	requireMachineID(t, result.MachineID)
+
+	// The reset is performed once per fingerprint...
+	_, err = Reset(statePath, Options{}, false)
+	require.ErrorIs(t, err, ErrAlreadyReset)
+
+	// ...unless forced
+	forcedResult, err := Reset(statePath, Options{}, true)
+	require.NoError(t, err)
+	requireMachineID(t, forcedResult.MachineID)
+
+	// ...or unless the VM was cloned
+	fingerprint = "52:54:00:65:43:21"
+
+	clonedResult, err := Reset(statePath, Options{}, false)
+	require.NoError(t, err)
+	requireMachineID(t, clonedResult.MachineID)
+
+	if runtime.GOOS == "linux" {
+		require.NotEqual(t, result.MachineID, clonedResult.MachineID)
+	}
+
+	_, err = Reset(statePath, Options{}, false)
+	require.ErrorIs(t, err, ErrAlreadyReset)
+}
+
+func TestResetSSHHostKeys(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+
+	fakeSystem(t, nil)
+
+	sshDir := filepath.Join(rootDir, "etc", "ssh")
+	require.NoError(t, os.MkdirAll(sshDir, 0755))
+
+	// An old key of a type that is still generated
+	// and the one of a type that is no longer generated
+	oldKeyPath := filepath.Join(sshDir, "ssh_host_ed25519_key")
+	obsoleteKeyPath := filepath.Join(sshDir, "ssh_host_dsa_key")
+	require.NoError(t, os.WriteFile(oldKeyPath, []byte("old"), 0600))
+	require.NoError(t, os.WriteFile(obsoleteKeyPath, []byte("old"), 0600))
+
+	require.NoError(t, resetSSHHostKeys())
+
+	newKey, err := os.ReadFile(oldKeyPath)
+	require.NoError(t, err)
+	require.NotEqual(t, "old", string(newKey))
+	require.FileExists(t, oldKeyPath+".pub")
+	require.NoFileExists(t, obsoleteKeyPath)
+
+	// The temporary directory is cleaned up
+	leftovers, err := filepath.Glob(filepath.Join(sshDir, ".tart-guest-agent-*"))
+	require.NoError(t, err)
+	require.Empty(t, leftovers)
+}
+
+// fakeSystem points the package at a temporary root
+// and, if specified, at a fingerprint to use instead
+// of the hardware one.
+func fakeSystem(t *testing.T, fingerprint *string) {
+	oldRootDir, oldFingerprintFunc := rootDir, fingerprintFunc
+
+	t.Cleanup(func() {
+		rootDir, fingerprintFunc = oldRootDir, oldFingerprintFunc
+	})
+
+	rootDir = t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(rootDir, "etc"), 0755))
+
+	if fingerprint != nil {
+		fingerprintFunc = func() (string, error) {
+			return *fingerprint, nil
+		}
+	}
+}
+
+func requireMachineID(t *testing.T, machineID string) {
+	if runtime.GOOS != "linux" {
+		require.Empty(t, machineID)
+
+		return
+	}
+
+	require.Regexp(t, regexp.MustCompile(`^[0-9a-f]{32}$`), machineID)
+
+	data, err := os.ReadFile(filepath.Join(rootDir, "etc", "machine-id"))
+	require.NoError(t, err)
+	require.Equal(t, machineID+"\n", string(data))
+}
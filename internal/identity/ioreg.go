@@ -0,0 +1,27 @@
+package identity
+
+import (
+	"errors"
+
+	"howett.net/plist"
+)
+
+type platformExpertDevice struct {
+	IOPlatformUUID string `plist:"IOPlatformUUID"`
+}
+
+func parsePlatformUUID(data []byte) (string, error) {
+	var devices []platformExpertDevice
+
+	if _, err := plist.Unmarshal(data, &devices); err != nil {
+		return "", err
+	}
+
+	for _, device := range devices {
+		if device.IOPlatformUUID != "" {
+			return device.IOPlatformUUID, nil
+		}
+	}
+
+	return "", errors.New("no platform UUID found")
+}
@@ -0,0 +1,99 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+func resetSSHHostKeys() error {
+	sshDir := hostPath("/etc/ssh")
+
+	if _, err := os.Stat(sshDir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			zap.S().Infof("skipping SSH host keys regeneration: %s does not exist", sshDir)
+
+			return nil
+		}
+
+		return err
+	}
+
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		zap.S().Infof("skipping SSH host keys regeneration: %v", err)
+
+		return nil
+	}
+
+	zap.S().Infof("regenerating SSH host keys...")
+
+	// Generate the new keys next to the old ones first, so that
+	// a failure to generate them leaves the old keys intact
+	tmpDir, err := os.MkdirTemp(sshDir, ".tart-guest-agent-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// ssh-keygen(1) creates the keys in the "etc/ssh" directory under the prefix
+	if err := os.MkdirAll(filepath.Join(tmpDir, "etc", "ssh"), 0755); err != nil {
+		return err
+	}
+
+	output, err := exec.Command("ssh-keygen", "-A", "-f", tmpDir).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("ssh-keygen failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	newKeys, err := filepath.Glob(filepath.Join(tmpDir, "etc", "ssh", "ssh_host_*"))
+	if err != nil {
+		return err
+	}
+
+	if len(newKeys) == 0 {
+		return errors.New("ssh-keygen has not generated any keys")
+	}
+
+	// Rename the new keys over the old ones, which atomically replaces each
+	// of them, so that sshd(8) never observes a missing key of these types
+	regenerated := map[string]struct{}{}
+
+	for _, newKey := range newKeys {
+		name := filepath.Base(newKey)
+
+		if err := os.Rename(newKey, filepath.Join(sshDir, name)); err != nil {
+			return err
+		}
+
+		regenerated[name] = struct{}{}
+	}
+
+	// Only then remove the old keys of the types
+	// that were not regenerated, if there are any
+	oldKeys, err := filepath.Glob(filepath.Join(sshDir, "ssh_host_*"))
+	if err != nil {
+		return err
+	}
+
+	for _, oldKey := range oldKeys {
+		if _, ok := regenerated[filepath.Base(oldKey)]; ok {
+			continue
+		}
+
+		if err := os.Remove(oldKey); err != nil {
+			return err
+		}
+	}
+
+	// The SSH server only uses the keys under the actual root
+	if rootDir == "/" {
+		restartSSHServer()
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+//go:build cgo
+
+package metrics
+
+/*
+#include <mach/mach.h>
+
+// cpuLoad copies the per-CPU ticks spent in each of the CPU_STATE_* states
+// into buf, returning the number of CPUs or -1 if the information is not available
+static int cpuLoad(unsigned int *buf, int maxCPUs) {
+	natural_t cpuCount;
+	processor_info_array_t info;
+	mach_msg_type_number_t infoCount;
+
+	if (host_processor_info(mach_host_self(), PROCESSOR_CPU_LOAD_INFO, &cpuCount,
+			&info, &infoCount) != KERN_SUCCESS) {
+		return -1;
+	}
+
+	processor_cpu_load_info_t load = (processor_cpu_load_info_t) info;
+	int n = cpuCount < (natural_t) maxCPUs ? (int) cpuCount : maxCPUs;
+
+	for (int i = 0; i < n; i++) {
+		for (int j = 0; j < CPU_STATE_MAX; j++) {
+			buf[i * CPU_STATE_MAX + j] = load[i].cpu_ticks[j];
+		}
+	}
+
+	vm_deallocate(mach_task_self(), (vm_address_t) info, infoCount * sizeof(integer_t));
+
+	return n;
+}
+*/
+import "C"
+
+import "errors"
+
+const maxCPUs = 1024
+
+func readCPUTicks() (cpuTicks, []cpuTicks, error) {
+	buf := make([]C.uint, maxCPUs*C.CPU_STATE_MAX)
+
+	n := int(C.cpuLoad(&buf[0], maxCPUs))
+	if n < 0 {
+		return cpuTicks{}, nil, errors.New("failed to retrieve the CPU load using host_processor_info()")
+	}
+
+	var total cpuTicks
+	var perCore []cpuTicks
+
+	for i := range n {
+		ticks := buf[i*C.CPU_STATE_MAX : (i+1)*C.CPU_STATE_MAX]
+
+		busy := uint64(ticks[C.CPU_STATE_USER]) + uint64(ticks[C.CPU_STATE_SYSTEM]) +
+			uint64(ticks[C.CPU_STATE_NICE])
+
+		core := cpuTicks{
+			Busy:  busy,
+			Total: busy + uint64(ticks[C.CPU_STATE_IDLE]),
+		}
+
+		total.Busy += core.Busy
+		total.Total += core.Total
+
+		perCore = append(perCore, core)
+	}
+
+	return total, perCore, nil
+}
@@ -0,0 +1,9 @@
+//go:build !cgo
+
+package metrics
+
+// The CPU load is only available via host_processor_info(),
+// so report no CPU usage when the agent is built without cgo.
+func readCPUTicks() (cpuTicks, []cpuTicks, error) {
+	return cpuTicks{}, nil, nil
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"fmt"
+	"regexp"
+
+	"howett.net/plist"
+)
+
+type ioregEntry struct {
+	BSDName    string           `plist:"BSD Name"`
+	Statistics *ioregStatistics `plist:"Statistics"`
+	Children   []ioregEntry     `plist:"IORegistryEntryChildren"`
+}
+
+type ioregStatistics struct {
+	BytesRead    uint64 `plist:"Bytes (Read)"`
+	BytesWritten uint64 `plist:"Bytes (Write)"`
+}
+
+// parseIoreg returns the bytes read and written by each of the disks from
+// the output of "ioreg -r -c IOBlockStorageDriver -a", where the statistics
+// are kept by the storage drivers and their child media have the BSD names.
+func parseIoreg(output []byte) (map[string]ioCounters, error) {
+	var drivers []ioregEntry
+
+	if _, err := plist.Unmarshal(output, &drivers); err != nil {
+		return nil, fmt.Errorf("failed to parse ioreg output: %w", err)
+	}
+
+	result := map[string]ioCounters{}
+
+	for _, driver := range drivers {
+		if driver.Statistics == nil {
+			continue
+		}
+
+		name := bsdName(driver.Children)
+		if name == "" {
+			continue
+		}
+
+		result[name] = ioCounters{
+			In:  driver.Statistics.BytesRead,
+			Out: driver.Statistics.BytesWritten,
+		}
+	}
+
+	return result, nil
+}
+
+func bsdName(entries []ioregEntry) string {
+	for _, entry := range entries {
+		if entry.BSDName != "" {
+			return entry.BSDName
+		}
+
+		if name := bsdName(entry.Children); name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+type diskutilInfo struct {
+	ParentWholeDisk    string `plist:"ParentWholeDisk"`
+	APFSPhysicalStores []struct {
+		APFSPhysicalStore string `plist:"APFSPhysicalStore"`
+	} `plist:"APFSPhysicalStores"`
+}
+
+var partitionSuffixRegexp = regexp.MustCompile(`s[0-9]+$`)
+
+// parseDiskutilInfo returns the whole disk backing the volume from the
+// output of "diskutil info -plist", which is the first physical store's
+// disk for the APFS volumes, e.g. "disk0" for the "disk0s2" store.
+func parseDiskutilInfo(output []byte) string {
+	var info diskutilInfo
+
+	if _, err := plist.Unmarshal(output, &info); err != nil {
+		return ""
+	}
+
+	for _, store := range info.APFSPhysicalStores {
+		if store.APFSPhysicalStore != "" {
+			return partitionSuffixRegexp.ReplaceAllString(store.APFSPhysicalStore, "")
+		}
+	}
+
+	return info.ParentWholeDisk
+}
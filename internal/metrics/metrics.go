@@ -0,0 +1,341 @@
+package metrics
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/process"
+)
+
+// Sample is a snapshot of the guest's resource usage,
+// the rates are calculated since the previous sample.
+type Sample struct {
+	Time time.Time
+
+	CPU         CPU
+	LoadAverage LoadAverage
+	Memory      Memory
+
+	Volumes    []Volume
+	Disks      []Disk
+	Interfaces []Interface
+
+	TopProcessesByCPU    []Process
+	TopProcessesByMemory []Process
+}
+
+// CPU usage in percent.
+type CPU struct {
+	Usage   float64
+	PerCore []float64
+}
+
+type LoadAverage struct {
+	One     float64
+	Five    float64
+	Fifteen float64
+}
+
+// Memory usage in bytes.
+type Memory struct {
+	Total     uint64
+	Used      uint64
+	Available uint64
+
+	SwapTotal uint64
+	SwapUsed  uint64
+
+	// Percentage, the higher the worse: on Linux, the share of the last
+	// 10 seconds some of the tasks were stalled on memory (PSI), and on
+	// macOS, the inverse of the kern.memorystatus_level
+	Pressure float64
+}
+
+// Volume is a mounted file system, the sizes are in bytes.
+type Volume struct {
+	MountPoint string
+	Device     string
+	FileSystem string
+
+	// Name of the disk the volume's IO is accounted to, the whole
+	// disk on macOS, where APFS volumes share their container's
+	// physical store, empty when it cannot be determined
+	Disk string
+
+	Total     uint64
+	Used      uint64
+	Available uint64
+}
+
+// Disk is a block device's throughput.
+type Disk struct {
+	Name string
+
+	ReadBytesPerSecond  float64
+	WriteBytesPerSecond float64
+}
+
+// Interface is a network interface's throughput.
+type Interface struct {
+	Name string
+
+	ReceiveBytesPerSecond  float64
+	TransmitBytesPerSecond float64
+}
+
+// Process is a process' resource usage.
+type Process struct {
+	PID  int
+	Name string
+	User string
+
+	// In percent of a single core, so it
+	// can exceed 100% for multi-threaded processes
+	CPUUsage float64
+
+	// Resident set size in bytes
+	RSS uint64
+}
+
+type cpuTicks struct {
+	Busy  uint64
+	Total uint64
+}
+
+// ioCounters are cumulative bytes read and written
+// by a disk or received and transmitted by an interface.
+type ioCounters struct {
+	In  uint64
+	Out uint64
+}
+
+// processKey identifies a process in a way
+// that is resistant to the PID reuse.
+type processKey struct {
+	PID       int
+	StartTime time.Time
+}
+
+type counters struct {
+	Time time.Time
+
+	CPU     cpuTicks
+	PerCore []cpuTicks
+
+	Disks      map[string]ioCounters
+	Interfaces map[string]ioCounters
+	Processes  map[processKey]time.Duration
+}
+
+// Sampler samples the guest's resource usage,
+// keeping the cumulative counters between samples.
+type Sampler struct {
+	topProcesses int
+	previous     *counters
+
+	// Volumes' devices don't change their disks, so the
+	// relatively expensive lookups are only done once
+	volumeDisks map[string]string
+}
+
+// NewSampler creates a sampler that reports up to topProcesses
+// processes by CPU and memory usage. The counters are read
+// right away, so that the first sample already has the rates.
+func NewSampler(topProcesses int) (*Sampler, error) {
+	sampler := &Sampler{
+		topProcesses: topProcesses,
+		volumeDisks:  map[string]string{},
+	}
+
+	previous, _, err := readCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	sampler.previous = previous
+
+	return sampler, nil
+}
+
+// Sample takes a new sample.
+func (sampler *Sampler) Sample() (*Sample, error) {
+	current, processes, err := readCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	previous := sampler.previous
+	elapsed := current.Time.Sub(previous.Time).Seconds()
+
+	sample := &Sample{
+		Time: current.Time,
+		CPU: CPU{
+			Usage: cpuUsage(previous.CPU, current.CPU),
+		},
+	}
+
+	for i, core := range current.PerCore {
+		var previousCore cpuTicks
+
+		if i < len(previous.PerCore) {
+			previousCore = previous.PerCore[i]
+		}
+
+		sample.CPU.PerCore = append(sample.CPU.PerCore, cpuUsage(previousCore, core))
+	}
+
+	sample.LoadAverage, err = readLoadAverage()
+	if err != nil {
+		return nil, err
+	}
+
+	sample.Memory, err = readMemory()
+	if err != nil {
+		return nil, err
+	}
+
+	sample.Volumes, err = readVolumes()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, volume := range sample.Volumes {
+		disk, ok := sampler.volumeDisks[volume.Device]
+		if !ok {
+			disk = volumeDisk(volume.Device)
+			sampler.volumeDisks[volume.Device] = disk
+		}
+
+		// Only refer to the disks that are actually reported
+		if _, ok := current.Disks[disk]; ok {
+			sample.Volumes[i].Disk = disk
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(current.Disks)) {
+		in, out := ioRates(previous.Disks[name], current.Disks[name], elapsed)
+
+		sample.Disks = append(sample.Disks, Disk{
+			Name:                name,
+			ReadBytesPerSecond:  in,
+			WriteBytesPerSecond: out,
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(current.Interfaces)) {
+		in, out := ioRates(previous.Interfaces[name], current.Interfaces[name], elapsed)
+
+		sample.Interfaces = append(sample.Interfaces, Interface{
+			Name:                   name,
+			ReceiveBytesPerSecond:  in,
+			TransmitBytesPerSecond: out,
+		})
+	}
+
+	sample.TopProcessesByCPU, sample.TopProcessesByMemory = topProcesses(previous.Processes,
+		processes, elapsed, sampler.topProcesses)
+
+	sampler.previous = current
+
+	return sample, nil
+}
+
+func readCounters() (*counters, []process.Process, error) {
+	result := &counters{
+		Time:      time.Now(),
+		Processes: map[processKey]time.Duration{},
+	}
+
+	var err error
+
+	result.CPU, result.PerCore, err = readCPUTicks()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result.Disks, err = readDiskCounters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result.Interfaces, err = readInterfaceCounters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	processes, err := process.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, process := range processes {
+		result.Processes[processKey{process.PID, process.StartTime}] = process.CPUTime
+	}
+
+	return result, processes, nil
+}
+
+func cpuUsage(previous cpuTicks, current cpuTicks) float64 {
+	if current.Total <= previous.Total || current.Busy < previous.Busy {
+		return 0
+	}
+
+	return float64(current.Busy-previous.Busy) / float64(current.Total-previous.Total) * 100
+}
+
+func ioRates(previous ioCounters, current ioCounters, elapsed float64) (float64, float64) {
+	return rate(previous.In, current.In, elapsed), rate(previous.Out, current.Out, elapsed)
+}
+
+func rate(previous uint64, current uint64, elapsed float64) float64 {
+	// The counters might have been reset, e.g. when
+	// an interface was re-created between the samples
+	if elapsed <= 0 || current < previous {
+		return 0
+	}
+
+	return float64(current-previous) / elapsed
+}
+
+func topProcesses(
+	previous map[processKey]time.Duration,
+	processes []process.Process,
+	elapsed float64,
+	n int,
+) ([]Process, []Process) {
+	var all []Process
+
+	for _, process := range processes {
+		// Processes started after the previous sample
+		// have all of their CPU time accounted for
+		cpuTime := process.CPUTime - previous[processKey{process.PID, process.StartTime}]
+
+		var usage float64
+
+		if elapsed > 0 && cpuTime > 0 {
+			usage = cpuTime.Seconds() / elapsed * 100
+		}
+
+		all = append(all, Process{
+			PID:      process.PID,
+			Name:     process.Name,
+			User:     process.User,
+			CPUUsage: usage,
+			RSS:      process.RSS,
+		})
+	}
+
+	byCPU := slices.Clone(all)
+	slices.SortStableFunc(byCPU, func(a, b Process) int {
+		return cmp.Compare(b.CPUUsage, a.CPUUsage)
+	})
+
+	byMemory := slices.Clone(all)
+	slices.SortStableFunc(byMemory, func(a, b Process) int {
+		return cmp.Compare(b.RSS, a.RSS)
+	})
+
+	return byCPU[:min(n, len(byCPU))], byMemory[:min(n, len(byMemory))]
+}
@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/net/route"
+	"golang.org/x/sys/unix"
+)
+
+func readLoadAverage() (LoadAverage, error) {
+	// struct loadavg from <sys/sysctl.h>: fixpt_t ldavg[3] and long fscale
+	loadavg, err := unix.SysctlRaw("vm.loadavg")
+	if err != nil {
+		return LoadAverage{}, err
+	}
+
+	if len(loadavg) < 24 {
+		return LoadAverage{}, fmt.Errorf("unexpected vm.loadavg size %d", len(loadavg))
+	}
+
+	fscale := float64(binary.LittleEndian.Uint64(loadavg[16:]))
+
+	return LoadAverage{
+		One:     float64(binary.LittleEndian.Uint32(loadavg[0:])) / fscale,
+		Five:    float64(binary.LittleEndian.Uint32(loadavg[4:])) / fscale,
+		Fifteen: float64(binary.LittleEndian.Uint32(loadavg[8:])) / fscale,
+	}, nil
+}
+
+func readMemory() (Memory, error) {
+	total, err := unix.SysctlUint64("hw.memsize")
+	if err != nil {
+		return Memory{}, err
+	}
+
+	// Percentage of the memory available before the
+	// system starts to experience the memory pressure
+	level, err := unix.SysctlUint32("kern.memorystatus_level")
+	if err != nil {
+		return Memory{}, err
+	}
+
+	available := total / 100 * uint64(min(level, 100))
+
+	memory := Memory{
+		Total:     total,
+		Used:      total - available,
+		Available: available,
+		Pressure:  float64(100 - min(level, 100)),
+	}
+
+	// struct xsw_usage from <sys/sysctl.h>: xsu_total, xsu_avail and xsu_used
+	swapUsage, err := unix.SysctlRaw("vm.swapusage")
+	if err != nil {
+		return Memory{}, err
+	}
+
+	if len(swapUsage) < 24 {
+		return Memory{}, fmt.Errorf("unexpected vm.swapusage size %d", len(swapUsage))
+	}
+
+	memory.SwapTotal = binary.LittleEndian.Uint64(swapUsage[0:])
+	memory.SwapUsed = binary.LittleEndian.Uint64(swapUsage[16:])
+
+	return memory, nil
+}
+
+func readVolumes() ([]Volume, error) {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]unix.Statfs_t, n)
+
+	n, err = unix.Getfsstat(stats, unix.MNT_NOWAIT)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Volume
+
+	for _, stat := range stats[:n] {
+		device := unix.ByteSliceToString(stat.Mntfromname[:])
+
+		if stat.Flags&unix.MNT_LOCAL == 0 || !strings.HasPrefix(device, "/dev/") {
+			continue
+		}
+
+		blockSize := uint64(stat.Bsize)
+
+		result = append(result, Volume{
+			MountPoint: unix.ByteSliceToString(stat.Mntonname[:]),
+			Device:     device,
+			FileSystem: unix.ByteSliceToString(stat.Fstypename[:]),
+			Total:      stat.Blocks * blockSize,
+			Used:       (stat.Blocks - stat.Bfree) * blockSize,
+			Available:  stat.Bavail * blockSize,
+		})
+	}
+
+	return result, nil
+}
+
+// volumeDisk returns the whole disk backing the volume, since ioreg
+// only keeps the statistics per whole disk. APFS volumes reside on
+// the synthesized disks, so their container's physical store is used.
+func volumeDisk(device string) string {
+	output, err := exec.Command("diskutil", "info", "-plist", device).Output()
+	if err != nil {
+		return ""
+	}
+
+	return parseDiskutilInfo(output)
+}
+
+func readDiskCounters() (map[string]ioCounters, error) {
+	// Disk statistics are only available through the I/O Kit
+	output, err := exec.Command("ioreg", "-r", "-c", "IOBlockStorageDriver", "-a").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run ioreg: %w", err)
+	}
+
+	return parseIoreg(output)
+}
+
+func readInterfaceCounters() (map[string]ioCounters, error) {
+	// Unlike NET_RT_IFLIST, NET_RT_IFLIST2 reports 64-bit counters
+	rib, err := route.FetchRIB(unix.AF_UNSPEC, route.RIBType(unix.NET_RT_IFLIST2), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[int]string{}
+
+	for _, iface := range interfaces {
+		names[iface.Index] = iface.Name
+	}
+
+	result := map[string]ioCounters{}
+
+	for len(rib) >= 4 {
+		msglen := int(binary.LittleEndian.Uint16(rib))
+		if msglen == 0 || msglen > len(rib) {
+			break
+		}
+
+		if rib[3] == unix.RTM_IFINFO2 && msglen >= unix.SizeofIfMsghdr2 {
+			var msg unix.IfMsghdr2
+
+			copy(unsafe.Slice((*byte)(unsafe.Pointer(&msg)), unix.SizeofIfMsghdr2), rib)
+
+			if name, ok := names[int(msg.Index)]; ok {
+				result[name] = ioCounters{
+					In:  msg.Data.Ibytes,
+					Out: msg.Data.Obytes,
+				}
+			}
+		}
+
+		rib = rib[msglen:]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+func readCPUTicks() (cpuTicks, []cpuTicks, error) {
+	content, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return cpuTicks{}, nil, err
+	}
+
+	return parseProcStatCPU(content)
+}
+
+func readLoadAverage() (LoadAverage, error) {
+	content, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return LoadAverage{}, err
+	}
+
+	return parseLoadavg(content)
+}
+
+func readMemory() (Memory, error) {
+	content, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return Memory{}, err
+	}
+
+	meminfo := parseMeminfo(content)
+
+	memory := Memory{
+		Total:     meminfo["MemTotal"],
+		Available: meminfo["MemAvailable"],
+		SwapTotal: meminfo["SwapTotal"],
+	}
+
+	if memory.Total > memory.Available {
+		memory.Used = memory.Total - memory.Available
+	}
+
+	if memory.SwapTotal > meminfo["SwapFree"] {
+		memory.SwapUsed = memory.SwapTotal - meminfo["SwapFree"]
+	}
+
+	// Pressure stall information might be disabled in the kernel
+	pressure, err := os.ReadFile("/proc/pressure/memory")
+	if err != nil && !errors.Is(err, os.ErrNotExist) && !errors.Is(err, unix.EOPNOTSUPP) {
+		return Memory{}, err
+	}
+
+	memory.Pressure = parsePressure(pressure)
+
+	return memory, nil
+}
+
+func readVolumes() ([]Volume, error) {
+	content, err := os.ReadFile("/proc/self/mounts")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Volume
+
+	seenDevices := map[string]struct{}{}
+
+	for _, mount := range parseMounts(content) {
+		// Only consider the file systems backed by block devices,
+		// once per device, as it might be bind-mounted elsewhere
+		if !strings.HasPrefix(mount.Device, "/dev/") {
+			continue
+		}
+
+		if _, ok := seenDevices[mount.Device]; ok {
+			continue
+		}
+
+		seenDevices[mount.Device] = struct{}{}
+
+		var stat unix.Statfs_t
+
+		if err := unix.Statfs(mount.MountPoint, &stat); err != nil {
+			// The file system might have been unmounted in the meantime
+			continue
+		}
+
+		blockSize := uint64(stat.Bsize)
+
+		result = append(result, Volume{
+			MountPoint: mount.MountPoint,
+			Device:     mount.Device,
+			FileSystem: mount.FileSystem,
+			Total:      stat.Blocks * blockSize,
+			Used:       (stat.Blocks - stat.Bfree) * blockSize,
+			Available:  stat.Bavail * blockSize,
+		})
+	}
+
+	return result, nil
+}
+
+// volumeDisk returns the name of the block device in /proc/diskstats,
+// which lists the partitions and device mapper devices too, resolving
+// the symbolic links like /dev/mapper/* and /dev/disk/by-uuid/*.
+func volumeDisk(device string) string {
+	resolved, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Base(resolved)
+}
+
+func readDiskCounters() (map[string]ioCounters, error) {
+	content, err := os.ReadFile("/proc/diskstats")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDiskstats(content), nil
+}
+
+func readInterfaceCounters() (map[string]ioCounters, error) {
+	content, err := os.ReadFile("/proc/net/dev")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNetDev(content), nil
+}
@@ -0,0 +1,193 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcStatCPU(t *testing.T) {
+	total, perCore, err := parseProcStatCPU([]byte(`cpu  100 10 50 800 40 0 0 0 5 0
+cpu0 60 5 30 400 5 0 0 0 5 0
+cpu1 40 5 20 400 35 0 0 0 0 0
+intr 12345
+`))
+	require.NoError(t, err)
+	require.Equal(t, cpuTicks{Busy: 160, Total: 1000}, total)
+	require.Equal(t, []cpuTicks{{Busy: 95, Total: 500}, {Busy: 65, Total: 500}}, perCore)
+
+	require.InDelta(t, 50.0, cpuUsage(cpuTicks{Busy: 100, Total: 1000}, cpuTicks{Busy: 150, Total: 1100}), 0.001)
+
+	// Counters that went backwards
+	require.Zero(t, cpuUsage(cpuTicks{Busy: 100, Total: 1000}, cpuTicks{Busy: 10, Total: 100}))
+}
+
+func TestParseLoadavg(t *testing.T) {
+	loadAverage, err := parseLoadavg([]byte("0.52 1.25 2.00 1/234 5678\n"))
+	require.NoError(t, err)
+	require.Equal(t, LoadAverage{One: 0.52, Five: 1.25, Fifteen: 2.00}, loadAverage)
+}
+
+func TestParseMeminfo(t *testing.T) {
+	require.Equal(t, map[string]uint64{
+		"MemTotal":        2048 * 1024,
+		"SwapFree":        512 * 1024,
+		"HugePages_Total": 0,
+	}, parseMeminfo([]byte(`MemTotal:           2048 kB
+SwapFree:            512 kB
+HugePages_Total:       0
+`)))
+}
+
+func TestParsePressure(t *testing.T) {
+	require.InDelta(t, 12.5, parsePressure([]byte(`some avg10=12.50 avg60=3.00 avg300=1.00 total=123456
+full avg10=1.00 avg60=0.50 avg300=0.10 total=1234
+`)), 0.001)
+	require.Zero(t, parsePressure(nil))
+}
+
+func TestParseDiskstats(t *testing.T) {
+	require.Equal(t, map[string]ioCounters{
+		"vda":  {In: 2000 * 512, Out: 4000 * 512},
+		"vda1": {In: 1000 * 512, Out: 3000 * 512},
+	}, parseDiskstats([]byte(`   7       0 loop0 10 0 20 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+ 254       0 vda 100 10 2000 50 200 20 4000 60 0 100 110 0 0 0 0 0 0
+ 254       1 vda1 50 5 1000 25 150 15 3000 40 0 80 65 0 0 0 0 0 0
+`)))
+}
+
+func TestParseNetDev(t *testing.T) {
+	require.Equal(t, map[string]ioCounters{
+		"lo":   {In: 1000, Out: 1000},
+		"eth0": {In: 123456, Out: 654321},
+	}, parseNetDev([]byte(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0:  123456     100    0    0    0     0          0         0   654321     200    0    0    0     0       0          0
+`)))
+}
+
+func TestParseMounts(t *testing.T) {
+	require.Equal(t, []mount{
+		{Device: "/dev/vda1", MountPoint: "/", FileSystem: "ext4"},
+		{Device: "/dev/vdb1", MountPoint: "/mnt/My Volume", FileSystem: "xfs"},
+		{Device: "proc", MountPoint: "/proc", FileSystem: "proc"},
+	}, parseMounts([]byte(`/dev/vda1 / ext4 rw,relatime 0 0
+/dev/vdb1 /mnt/My\040Volume xfs rw 0 0
+proc /proc proc rw,nosuid,nodev,noexec,relatime 0 0
+`)))
+}
+
+func TestParseIoreg(t *testing.T) {
+	counters, err := parseIoreg([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<array>
+	<dict>
+		<key>IOObjectClass</key>
+		<string>IOBlockStorageDriver</string>
+		<key>Statistics</key>
+		<dict>
+			<key>Bytes (Read)</key>
+			<integer>1048576</integer>
+			<key>Bytes (Write)</key>
+			<integer>2097152</integer>
+			<key>Operations (Read)</key>
+			<integer>100</integer>
+		</dict>
+		<key>IORegistryEntryChildren</key>
+		<array>
+			<dict>
+				<key>IOObjectClass</key>
+				<string>IOMedia</string>
+				<key>BSD Name</key>
+				<string>disk0</string>
+			</dict>
+		</array>
+	</dict>
+	<dict>
+		<key>IOObjectClass</key>
+		<string>IOBlockStorageDriver</string>
+	</dict>
+</array>
+</plist>
+`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]ioCounters{
+		"disk0": {In: 1048576, Out: 2097152},
+	}, counters)
+}
+
+func TestParseDiskutilInfo(t *testing.T) {
+	require.Equal(t, "disk0", parseDiskutilInfo([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>APFSPhysicalStores</key>
+	<array>
+		<dict>
+			<key>APFSPhysicalStore</key>
+			<string>disk0s2</string>
+		</dict>
+	</array>
+	<key>DeviceIdentifier</key>
+	<string>disk3s1s1</string>
+	<key>ParentWholeDisk</key>
+	<string>disk3</string>
+</dict>
+</plist>`)))
+
+	require.Equal(t, "disk4", parseDiskutilInfo([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>DeviceIdentifier</key>
+	<string>disk4s1</string>
+	<key>ParentWholeDisk</key>
+	<string>disk4</string>
+</dict>
+</plist>`)))
+
+	require.Empty(t, parseDiskutilInfo([]byte("garbage")))
+}
+
+func TestTopProcesses(t *testing.T) {
+	startTime := time.Now().Add(-time.Hour)
+
+	previous := map[processKey]time.Duration{
+		{PID: 1, StartTime: startTime}: 10 * time.Second,
+		{PID: 2, StartTime: startTime}: 10 * time.Second,
+		// Same PID, but a different process
+		{PID: 3, StartTime: startTime.Add(-time.Hour)}: 100 * time.Second,
+	}
+
+	byCPU, byMemory := topProcesses(previous, []process.Process{
+		{PID: 1, Name: "idle", StartTime: startTime, CPUTime: 10 * time.Second, RSS: 300},
+		{PID: 2, Name: "busy", StartTime: startTime, CPUTime: 12 * time.Second, RSS: 100},
+		{PID: 3, Name: "new", StartTime: startTime, CPUTime: time.Second, RSS: 200},
+	}, 2, 2)
+
+	require.Equal(t, []Process{
+		{PID: 2, Name: "busy", CPUUsage: 100, RSS: 100},
+		{PID: 3, Name: "new", CPUUsage: 50, RSS: 200},
+	}, byCPU)
+
+	require.Equal(t, []int{1, 3}, []int{byMemory[0].PID, byMemory[1].PID})
+}
+
+func TestSampler(t *testing.T) {
+	sampler, err := NewSampler(3)
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	sample, err := sampler.Sample()
+	require.NoError(t, err)
+	require.NotZero(t, sample.Memory.Total)
+	require.NotEmpty(t, sample.CPU.PerCore)
+	require.NotEmpty(t, sample.Interfaces)
+	require.Len(t, sample.TopProcessesByCPU, 3)
+	require.Len(t, sample.TopProcessesByMemory, 3)
+}
@@ -0,0 +1,270 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseProcStatCPU returns the overall and per-core CPU
+// ticks from the contents of /proc/stat, see proc_stat(5).
+func parseProcStatCPU(content []byte) (cpuTicks, []cpuTicks, error) {
+	var total cpuTicks
+	var perCore []cpuTicks
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+
+		var ticks cpuTicks
+		var idle uint64
+
+		// "user", "nice", "system", "idle", "iowait", "irq", "softirq" and "steal",
+		// the "guest" and "guest_nice" are already accounted for in "user" and "nice"
+		for i, field := range fields[1:min(len(fields), 9)] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return cpuTicks{}, nil, fmt.Errorf("failed to parse %s ticks: %w", fields[0], err)
+			}
+
+			ticks.Total += value
+
+			// "idle" and "iowait"
+			if i == 3 || i == 4 {
+				idle += value
+			}
+		}
+
+		ticks.Busy = ticks.Total - idle
+
+		if fields[0] == "cpu" {
+			total = ticks
+		} else {
+			perCore = append(perCore, ticks)
+		}
+	}
+
+	return total, perCore, nil
+}
+
+// parseLoadavg parses the contents of /proc/loadavg, see proc_loadavg(5).
+func parseLoadavg(content []byte) (LoadAverage, error) {
+	fields := strings.Fields(string(content))
+	if len(fields) < 3 {
+		return LoadAverage{}, fmt.Errorf("expected at least 3 fields in /proc/loadavg, got %d", len(fields))
+	}
+
+	var values [3]float64
+
+	for i := range values {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return LoadAverage{}, fmt.Errorf("failed to parse load average: %w", err)
+		}
+
+		values[i] = value
+	}
+
+	return LoadAverage{
+		One:     values[0],
+		Five:    values[1],
+		Fifteen: values[2],
+	}, nil
+}
+
+// parseMeminfo parses the contents of /proc/meminfo,
+// see proc_meminfo(5), with the values in bytes.
+func parseMeminfo(content []byte) map[string]uint64 {
+	result := map[string]uint64{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if len(fields) > 2 && fields[2] == "kB" {
+			value *= 1024
+		}
+
+		result[strings.TrimSuffix(fields[0], ":")] = value
+	}
+
+	return result
+}
+
+// parsePressure returns the "some avg10" value from the contents
+// of /proc/pressure/memory, see https://docs.kernel.org/accounting/psi.html.
+func parsePressure(content []byte) float64 {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "some" {
+			continue
+		}
+
+		for _, field := range fields[1:] {
+			valueRaw, ok := strings.CutPrefix(field, "avg10=")
+			if !ok {
+				continue
+			}
+
+			value, err := strconv.ParseFloat(valueRaw, 64)
+			if err != nil {
+				return 0
+			}
+
+			return value
+		}
+	}
+
+	return 0
+}
+
+// parseDiskstats returns the bytes read and written by each of the block
+// devices from the contents of /proc/diskstats, skipping the loop and
+// RAM devices, see https://docs.kernel.org/admin-guide/iostats.html.
+func parseDiskstats(content []byte) map[string]ioCounters {
+	// Regardless of the device's actual sector size
+	const sectorSize = 512
+
+	result := map[string]ioCounters{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		name := fields[2]
+
+		if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") ||
+			strings.HasPrefix(name, "zram") {
+			continue
+		}
+
+		sectorsRead, err := strconv.ParseUint(fields[5], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		sectorsWritten, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		result[name] = ioCounters{
+			In:  sectorsRead * sectorSize,
+			Out: sectorsWritten * sectorSize,
+		}
+	}
+
+	return result
+}
+
+// parseNetDev returns the bytes received and transmitted by each of
+// the network interfaces from the contents of /proc/net/dev.
+func parseNetDev(content []byte) map[string]ioCounters {
+	result := map[string]ioCounters{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		// Header lines have no colon after the interface name
+		name, rest, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) < 9 {
+			continue
+		}
+
+		received, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		transmitted, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		result[strings.TrimSpace(name)] = ioCounters{
+			In:  received,
+			Out: transmitted,
+		}
+	}
+
+	return result
+}
+
+type mount struct {
+	Device     string
+	MountPoint string
+	FileSystem string
+}
+
+// parseMounts parses the contents of /proc/self/mounts, see fstab(5).
+func parseMounts(content []byte) []mount {
+	var result []mount
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+
+		result = append(result, mount{
+			Device:     unescapeOctal(fields[0]),
+			MountPoint: unescapeOctal(fields[1]),
+			FileSystem: fields[2],
+		})
+	}
+
+	return result
+}
+
+// unescapeOctal decodes the "\040"-style escapes
+// used for spaces and other special characters.
+func unescapeOctal(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var buf strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if value, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				buf.WriteByte(byte(value))
+				i += 3
+
+				continue
+			}
+		}
+
+		buf.WriteByte(s[i])
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,82 @@
+package netconfig
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+)
+
+const (
+	DefaultCheckTimeout = 30 * time.Second
+
+	checkInterval    = time.Second
+	checkDialTimeout = 5 * time.Second
+)
+
+// check waits until the interface has all the static addresses
+// assigned and all the addresses are reachable.
+func check(ctx context.Context, config InterfaceConfig, addresses []string, timeout time.Duration) error {
+	if timeout == 0 {
+		timeout = DefaultCheckTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		err := checkOnce(ctx, config, addresses)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-time.After(checkInterval):
+			continue
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+func checkOnce(ctx context.Context, config InterfaceConfig, addresses []string) error {
+	interfaces, err := netinfo.Interfaces()
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(interfaces, func(iface netinfo.Interface) bool {
+		return iface.Name == config.Name
+	})
+	if idx == -1 {
+		return fmt.Errorf("interface %s does not exist", config.Name)
+	}
+
+	for _, expected := range config.Addresses {
+		assigned := slices.ContainsFunc(interfaces[idx].Addresses, func(address netinfo.Address) bool {
+			actual, ok := netip.AddrFromSlice(address.IP)
+
+			return ok && actual.Unmap() == expected.Addr() && address.PrefixLength == expected.Bits()
+		})
+		if !assigned {
+			return fmt.Errorf("address %s is not assigned to interface %s", expected, config.Name)
+		}
+	}
+
+	for _, address := range addresses {
+		dialer := net.Dialer{Timeout: checkDialTimeout}
+
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+
+		_ = conn.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package netconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+func runCommand(ctx context.Context, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+
+	stderrBuf := &bytes.Buffer{}
+	cmd.Stderr = stderrBuf
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%q failed: %w: %s", strings.Join(append([]string{name}, args...), " "),
+			err, strings.TrimSpace(stderrBuf.String()))
+	}
+
+	return string(output), nil
+}
+
+func commandExists(name string) bool {
+	_, err := exec.LookPath(name)
+
+	return err == nil
+}
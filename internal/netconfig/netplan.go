@@ -0,0 +1,100 @@
+package netconfig
+
+import (
+	"fmt"
+	"net/netip"
+	"path/filepath"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+const netplanDir = "/etc/netplan"
+
+type netplanFile struct {
+	Network netplanNetwork `yaml:"network"`
+}
+
+type netplanNetwork struct {
+	Version   int                        `yaml:"version"`
+	Ethernets map[string]netplanEthernet `yaml:"ethernets"`
+}
+
+type netplanEthernet struct {
+	DHCP4       bool                `yaml:"dhcp4"`
+	Addresses   []string            `yaml:"addresses,omitempty"`
+	Routes      []netplanRoute      `yaml:"routes,omitempty"`
+	Nameservers *netplanNameservers `yaml:"nameservers,omitempty"`
+	MTU         uint32              `yaml:"mtu,omitempty"`
+}
+
+type netplanRoute struct {
+	To     string `yaml:"to"`
+	Via    string `yaml:"via"`
+	Metric uint32 `yaml:"metric,omitempty"`
+}
+
+type netplanNameservers struct {
+	Addresses []string `yaml:"addresses,omitempty"`
+	Search    []string `yaml:"search,omitempty"`
+}
+
+// renderNetplan renders a netplan file that takes precedence over
+// the files typically shipped with the distributions (e.g. by cloud-init)
+// thanks to its name being sorted after theirs.
+func renderNetplan(config InterfaceConfig) (*Plan, error) {
+	ethernet := netplanEthernet{
+		DHCP4: config.DHCP4,
+		Addresses: lo.Map(config.Addresses, func(address netip.Prefix, _ int) string {
+			return address.String()
+		}),
+		Routes: lo.Map(config.Routes, func(route Route, _ int) netplanRoute {
+			return netplanRoute{
+				To:     route.Destination.String(),
+				Via:    route.Gateway.String(),
+				Metric: route.Metric,
+			}
+		}),
+		MTU: config.MTU,
+	}
+
+	if len(config.DNSServers) != 0 || len(config.SearchDomains) != 0 {
+		ethernet.Nameservers = &netplanNameservers{
+			Addresses: lo.Map(config.DNSServers, func(server netip.Addr, _ int) string {
+				return server.String()
+			}),
+			Search: config.SearchDomains,
+		}
+	}
+
+	content, err := yaml.Marshal(netplanFile{
+		Network: netplanNetwork{
+			Version: 2,
+			Ethernets: map[string]netplanEthernet{
+				config.Name: ethernet,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render netplan configuration: %w", err)
+	}
+
+	return &Plan{
+		Backend: backendNetplan,
+		Files: []File{
+			{
+				Path:    filepath.Join(netplanDir, fmt.Sprintf("90-tart-guest-agent-%s.yaml", config.Name)),
+				Content: content,
+				// netplan warns about world-readable files
+				Mode: 0600,
+			},
+		},
+		Commands: netplanCommands(),
+	}, nil
+}
+
+func netplanCommands() [][]string {
+	return [][]string{
+		{"netplan", "apply"},
+	}
+}
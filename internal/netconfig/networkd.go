@@ -0,0 +1,73 @@
+package netconfig
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const networkdDir = "/etc/systemd/network"
+
+// renderNetworkd renders a systemd.network(5) file that takes precedence
+// over the files typically shipped with the distributions, since networkd
+// only applies the first file in the lexical order that matches the interface.
+func renderNetworkd(config InterfaceConfig) *Plan {
+	var sb strings.Builder
+
+	sb.WriteString("[Match]\n")
+	fmt.Fprintf(&sb, "Name=%s\n", config.Name)
+
+	if config.MTU != 0 {
+		sb.WriteString("\n[Link]\n")
+		fmt.Fprintf(&sb, "MTUBytes=%d\n", config.MTU)
+	}
+
+	sb.WriteString("\n[Network]\n")
+
+	if config.DHCP4 {
+		sb.WriteString("DHCP=ipv4\n")
+	} else {
+		sb.WriteString("DHCP=no\n")
+	}
+
+	for _, address := range config.Addresses {
+		fmt.Fprintf(&sb, "Address=%s\n", address)
+	}
+
+	for _, server := range config.DNSServers {
+		fmt.Fprintf(&sb, "DNS=%s\n", server)
+	}
+
+	if len(config.SearchDomains) != 0 {
+		fmt.Fprintf(&sb, "Domains=%s\n", strings.Join(config.SearchDomains, " "))
+	}
+
+	for _, route := range config.Routes {
+		sb.WriteString("\n[Route]\n")
+		fmt.Fprintf(&sb, "Destination=%s\n", route.Destination)
+		fmt.Fprintf(&sb, "Gateway=%s\n", route.Gateway)
+
+		if route.Metric != 0 {
+			fmt.Fprintf(&sb, "Metric=%d\n", route.Metric)
+		}
+	}
+
+	return &Plan{
+		Backend: backendNetworkd,
+		Files: []File{
+			{
+				Path:    filepath.Join(networkdDir, fmt.Sprintf("10-tart-guest-agent-%s.network", config.Name)),
+				Content: []byte(sb.String()),
+				Mode:    0644,
+			},
+		},
+		Commands: networkdCommands(config),
+	}
+}
+
+func networkdCommands(config InterfaceConfig) [][]string {
+	return [][]string{
+		{"networkctl", "reload"},
+		{"networkctl", "reconfigure", config.Name},
+	}
+}
@@ -0,0 +1,133 @@
+package netconfig
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/netip"
+	"path/filepath"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const networkManagerDir = "/etc/NetworkManager/system-connections"
+
+// renderNetworkManager renders a NetworkManager keyfile connection profile
+// (see nm-settings-keyfile(5)) bound to the interface, with a priority
+// that makes it preferred over the automatically created profiles.
+func renderNetworkManager(config InterfaceConfig) *Plan {
+	id := networkManagerConnectionID(config)
+
+	var sb strings.Builder
+
+	sb.WriteString("[connection]\n")
+	fmt.Fprintf(&sb, "id=%s\n", id)
+	fmt.Fprintf(&sb, "uuid=%s\n", networkManagerConnectionUUID(id))
+	sb.WriteString("type=ethernet\n")
+	fmt.Fprintf(&sb, "interface-name=%s\n", config.Name)
+	sb.WriteString("autoconnect-priority=100\n")
+
+	if config.MTU != 0 {
+		sb.WriteString("\n[ethernet]\n")
+		fmt.Fprintf(&sb, "mtu=%d\n", config.MTU)
+	}
+
+	for _, ipv4 := range []bool{true, false} {
+		addresses := config.addresses(ipv4)
+
+		if ipv4 {
+			sb.WriteString("\n[ipv4]\n")
+		} else {
+			sb.WriteString("\n[ipv6]\n")
+		}
+
+		switch {
+		case ipv4 && config.DHCP4:
+			sb.WriteString("method=auto\n")
+		case len(addresses) != 0:
+			sb.WriteString("method=manual\n")
+		case ipv4:
+			sb.WriteString("method=disabled\n")
+		default:
+			// Rely on router advertisements
+			sb.WriteString("method=auto\n")
+		}
+
+		for i, address := range addresses {
+			fmt.Fprintf(&sb, "address%d=%s\n", i+1, address)
+		}
+
+		var routeIdx int
+
+		for _, route := range config.routes(ipv4) {
+			if route.IsDefault() {
+				fmt.Fprintf(&sb, "gateway=%s\n", route.Gateway)
+
+				if route.Metric != 0 {
+					fmt.Fprintf(&sb, "route-metric=%d\n", route.Metric)
+				}
+
+				continue
+			}
+
+			routeIdx++
+
+			if route.Metric != 0 {
+				fmt.Fprintf(&sb, "route%d=%s,%s,%d\n", routeIdx, route.Destination, route.Gateway, route.Metric)
+			} else {
+				fmt.Fprintf(&sb, "route%d=%s,%s\n", routeIdx, route.Destination, route.Gateway)
+			}
+		}
+
+		if servers := config.dnsServers(ipv4); len(servers) != 0 {
+			fmt.Fprintf(&sb, "dns=%s;\n", strings.Join(lo.Map(servers, func(server netip.Addr, _ int) string {
+				return server.String()
+			}), ";"))
+		}
+
+		if ipv4 && len(config.SearchDomains) != 0 {
+			fmt.Fprintf(&sb, "dns-search=%s;\n", strings.Join(config.SearchDomains, ";"))
+		}
+	}
+
+	return &Plan{
+		Backend: backendNetworkManager,
+		Files: []File{
+			{
+				Path:    filepath.Join(networkManagerDir, id+".nmconnection"),
+				Content: []byte(sb.String()),
+				// NetworkManager ignores the keyfiles accessible by other users
+				Mode: 0600,
+			},
+		},
+		Commands: [][]string{
+			{"nmcli", "connection", "reload"},
+			{"nmcli", "connection", "up", id},
+		},
+	}
+}
+
+func networkManagerRollbackCommands(config InterfaceConfig) [][]string {
+	// The profile might have been removed by the rollback,
+	// so let NetworkManager pick the most suitable one
+	return [][]string{
+		{"nmcli", "connection", "reload"},
+		{"nmcli", "device", "connect", config.Name},
+	}
+}
+
+func networkManagerConnectionID(config InterfaceConfig) string {
+	return "tart-guest-agent-" + config.Name
+}
+
+// networkManagerConnectionUUID derives a stable UUID from the connection
+// ID, so that re-applying the configuration updates the same profile.
+func networkManagerConnectionUUID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+
+	// Mark as a name-based UUID (version 5, RFC 4122 variant)
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
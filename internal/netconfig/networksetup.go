@@ -0,0 +1,264 @@
+package netconfig
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// parseHardwarePorts maps the device names (e.g. "en0") to the network
+// service names (e.g. "Ethernet") in "networksetup -listallhardwareports" output.
+func parseHardwarePorts(output string) map[string]string {
+	result := map[string]string{}
+
+	var service string
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "Hardware Port":
+			service = value
+		case "Device":
+			if service != "" {
+				result[value] = service
+			}
+		}
+	}
+
+	return result
+}
+
+// renderNetworksetup renders the networksetup(8) invocations that configure
+// the network service, which supports only a subset of the configurations.
+func renderNetworksetup(service string, config InterfaceConfig) (*Plan, error) {
+	var commands [][]string
+
+	ipv4Addresses := config.addresses(true)
+	ipv6Addresses := config.addresses(false)
+
+	if len(ipv4Addresses) > 1 || len(ipv6Addresses) > 1 {
+		return nil, fmt.Errorf("%w: %s supports at most one static address per address family",
+			ErrUnsupportedConfig, backendNetworksetup)
+	}
+
+	var ipv4Gateway, ipv6Gateway netip.Addr
+	var ipv4Routes []string
+
+	for _, route := range config.Routes {
+		if route.Metric != 0 {
+			return nil, fmt.Errorf("%w: %s does not support route metrics",
+				ErrUnsupportedConfig, backendNetworksetup)
+		}
+
+		switch {
+		case route.IsDefault() && route.Gateway.Is4():
+			ipv4Gateway = route.Gateway
+		case route.IsDefault():
+			ipv6Gateway = route.Gateway
+		case route.Gateway.Is4():
+			ipv4Routes = append(ipv4Routes, route.Destination.Masked().Addr().String(),
+				prefixToMask(route.Destination), route.Gateway.String())
+		default:
+			return nil, fmt.Errorf("%w: %s does not support non-default IPv6 routes",
+				ErrUnsupportedConfig, backendNetworksetup)
+		}
+	}
+
+	switch {
+	case config.DHCP4 && len(ipv4Addresses) != 0:
+		return nil, fmt.Errorf("%w: %s does not support combining DHCP with a static IPv4 address",
+			ErrUnsupportedConfig, backendNetworksetup)
+	case config.DHCP4:
+		commands = append(commands, []string{"networksetup", "-setdhcp", service})
+	case len(ipv4Addresses) != 0:
+		if !ipv4Gateway.IsValid() {
+			return nil, fmt.Errorf("%w: %s requires an IPv4 default route along with a static IPv4 address",
+				ErrUnsupportedConfig, backendNetworksetup)
+		}
+
+		commands = append(commands, []string{"networksetup", "-setmanual", service,
+			ipv4Addresses[0].Addr().String(), prefixToMask(ipv4Addresses[0]), ipv4Gateway.String()})
+	default:
+		commands = append(commands, []string{"networksetup", "-setv4off", service})
+	}
+
+	if len(ipv6Addresses) != 0 {
+		if !ipv6Gateway.IsValid() {
+			return nil, fmt.Errorf("%w: %s requires an IPv6 default route along with a static IPv6 address",
+				ErrUnsupportedConfig, backendNetworksetup)
+		}
+
+		commands = append(commands, []string{"networksetup", "-setv6manual", service,
+			ipv6Addresses[0].Addr().String(), strconv.Itoa(ipv6Addresses[0].Bits()), ipv6Gateway.String()})
+	} else {
+		commands = append(commands, []string{"networksetup", "-setv6automatic", service})
+	}
+
+	commands = append(commands, networksetupListCommand("-setdnsservers", service,
+		lo.Map(config.DNSServers, func(server netip.Addr, _ int) string {
+			return server.String()
+		})))
+	commands = append(commands, networksetupListCommand("-setsearchdomains", service, config.SearchDomains))
+
+	// Passing no routes clears them
+	commands = append(commands, append([]string{"networksetup", "-setadditionalroutes", service}, ipv4Routes...))
+
+	if config.MTU != 0 {
+		commands = append(commands, []string{"networksetup", "-setMTU", config.Name,
+			strconv.FormatUint(uint64(config.MTU), 10)})
+	}
+
+	return &Plan{
+		Backend:  backendNetworksetup,
+		Commands: commands,
+	}, nil
+}
+
+func networksetupListCommand(flag string, service string, values []string) []string {
+	if len(values) == 0 {
+		values = []string{"empty"}
+	}
+
+	return append([]string{"networksetup", flag, service}, values...)
+}
+
+func prefixToMask(prefix netip.Prefix) string {
+	bits := 128
+	if prefix.Addr().Is4() {
+		bits = 32
+	}
+
+	mask := net.CIDRMask(prefix.Bits(), bits)
+
+	return net.IP(mask).String()
+}
+
+// networksetupState is the current configuration of
+// a network service, as reported by networksetup(8).
+type networksetupState struct {
+	DHCP       bool
+	IPv4Off    bool
+	IP         string
+	SubnetMask string
+	Router     string
+
+	IPv6Manual       bool
+	IPv6Address      string
+	IPv6PrefixLength string
+	IPv6Router       string
+
+	DNSServers       []string
+	SearchDomains    []string
+	AdditionalRoutes []string
+}
+
+// parseNetworksetupInfo parses "networksetup -getinfo" output.
+func parseNetworksetupInfo(state *networksetupState, output string) {
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch line {
+		case "DHCP Configuration":
+			state.DHCP = true
+
+			continue
+		case "Manual Configuration":
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if value == "none" {
+			value = ""
+		}
+
+		switch key {
+		case "IP address":
+			state.IP = value
+		case "Subnet mask":
+			state.SubnetMask = value
+		case "Router":
+			state.Router = value
+		case "IPv6":
+			state.IPv6Manual = value == "Manual"
+		case "IPv6 IP address":
+			state.IPv6Address = value
+		case "IPv6 Prefix Length":
+			state.IPv6PrefixLength = value
+		case "IPv6 Router":
+			state.IPv6Router = value
+		}
+	}
+
+	state.IPv4Off = !state.DHCP && state.IP == ""
+}
+
+// parseNetworksetupList parses the output of the commands like "networksetup
+// -getdnsservers", which either list one value per line or explain that
+// there are none.
+func parseNetworksetupList(output string) []string {
+	var result []string
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "There aren't any") || strings.HasPrefix(line, "There are no") {
+			return nil
+		}
+
+		result = append(result, strings.Fields(line)...)
+	}
+
+	return result
+}
+
+// commands returns the networksetup(8) invocations that restore the state.
+func (state *networksetupState) commands(service string) [][]string {
+	var commands [][]string
+
+	switch {
+	case state.DHCP:
+		commands = append(commands, []string{"networksetup", "-setdhcp", service})
+	case state.IPv4Off:
+		commands = append(commands, []string{"networksetup", "-setv4off", service})
+	default:
+		commands = append(commands, []string{"networksetup", "-setmanual", service,
+			state.IP, state.SubnetMask, state.Router})
+	}
+
+	if state.IPv6Manual && state.IPv6Address != "" {
+		commands = append(commands, []string{"networksetup", "-setv6manual", service,
+			state.IPv6Address, state.IPv6PrefixLength, state.IPv6Router})
+	} else {
+		commands = append(commands, []string{"networksetup", "-setv6automatic", service})
+	}
+
+	commands = append(commands, networksetupListCommand("-setdnsservers", service, state.DNSServers))
+	commands = append(commands, networksetupListCommand("-setsearchdomains", service, state.SearchDomains))
+	commands = append(commands, append([]string{"networksetup", "-setadditionalroutes", service},
+		state.AdditionalRoutes...))
+
+	return commands
+}
@@ -0,0 +1,119 @@
+package netconfig
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Plan describes how to apply a configuration: which files
+// to write and which commands to run afterwards.
+type Plan struct {
+	Backend  string
+	Files    []File
+	Commands [][]string
+}
+
+type File struct {
+	Path string
+
+	// Nil content means that the file should be removed
+	Content []byte
+	Mode    fs.FileMode
+}
+
+func (plan *Plan) Apply(ctx context.Context) error {
+	for _, file := range plan.Files {
+		if err := file.apply(); err != nil {
+			return err
+		}
+	}
+
+	for _, command := range plan.Commands {
+		if _, err := runCommand(ctx, command[0], command[1:]...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (file File) apply() error {
+	if file.Content == nil {
+		if err := os.Remove(file.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file.Path), 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first to avoid leaving
+	// a half-written configuration file behind
+	tmpFile, err := os.CreateTemp(filepath.Dir(file.Path), "."+filepath.Base(file.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(file.Content); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Chmod(file.Mode); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), file.Path)
+}
+
+// renderFilesRollback returns a plan that restores the files
+// that the plan is about to overwrite or create, and then runs
+// the specified commands.
+func renderFilesRollback(plan *Plan, commands [][]string) (*Plan, error) {
+	rollbackPlan := &Plan{
+		Backend:  plan.Backend,
+		Commands: commands,
+	}
+
+	for _, file := range plan.Files {
+		rollbackFile := File{
+			Path: file.Path,
+			Mode: file.Mode,
+		}
+
+		fileInfo, err := os.Stat(file.Path)
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+
+			rollbackPlan.Files = append(rollbackPlan.Files, rollbackFile)
+
+			continue
+		}
+
+		rollbackFile.Content, err = os.ReadFile(file.Path)
+		if err != nil {
+			return nil, err
+		}
+		rollbackFile.Mode = fileInfo.Mode().Perm()
+
+		rollbackPlan.Files = append(rollbackPlan.Files, rollbackFile)
+	}
+
+	return rollbackPlan, nil
+}
@@ -0,0 +1,42 @@
+package netconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrNoRenewMethod = errors.New("no supported DHCP client found")
+
+// renewMethod is one of the ways to make the DHCP
+// client re-acquire the lease for an interface.
+type renewMethod struct {
+	Name string
+
+	// Available reports whether this method is
+	// responsible for the interface's configuration
+	Available func(ctx context.Context, iface string) bool
+
+	Renew func(ctx context.Context, iface string) error
+}
+
+// Renew releases the interface's DHCP lease and acquires a new one
+// through the first available method, returning the method's name.
+//
+// Renew doesn't wait for the new lease to be acquired.
+func Renew(ctx context.Context, iface string) (string, error) {
+	for _, method := range renewMethods {
+		if !method.Available(ctx, iface) {
+			continue
+		}
+
+		if err := method.Renew(ctx, iface); err != nil {
+			return method.Name, fmt.Errorf("failed to renew DHCP lease for %s using %s: %w",
+				iface, method.Name, err)
+		}
+
+		return method.Name, nil
+	}
+
+	return "", ErrNoRenewMethod
+}
@@ -0,0 +1,19 @@
+package netconfig
+
+import "context"
+
+var renewMethods = []renewMethod{
+	{
+		Name: "ipconfig",
+		Available: func(_ context.Context, _ string) bool {
+			return true
+		},
+		Renew: func(ctx context.Context, iface string) error {
+			// Re-setting the DHCP method restarts the
+			// DHCP client and discards the current lease
+			_, err := runCommand(ctx, "ipconfig", "set", iface, "DHCP")
+
+			return err
+		},
+	},
+}
@@ -0,0 +1,73 @@
+package netconfig
+
+import (
+	"context"
+	"strings"
+)
+
+// The order matters: dhclient may be installed alongside
+// network managers that don't rely on it
+var renewMethods = []renewMethod{
+	{
+		Name: "systemd-networkd",
+		Available: func(ctx context.Context, iface string) bool {
+			if !commandExists("networkctl") {
+				return false
+			}
+
+			// Only consider the interfaces that networkd manages
+			output, err := runCommand(ctx, "networkctl", "list", "--no-legend", "--no-pager", iface)
+			if err != nil {
+				return false
+			}
+
+			return strings.Contains(output, "configured") || strings.Contains(output, "configuring")
+		},
+		Renew: func(ctx context.Context, iface string) error {
+			// Reconfiguring drops the current lease and restarts
+			// the DHCP client from scratch, unlike "renew", which
+			// keeps asking for the current address
+			_, err := runCommand(ctx, "networkctl", "reconfigure", iface)
+
+			return err
+		},
+	},
+	{
+		Name: "NetworkManager",
+		Available: func(ctx context.Context, iface string) bool {
+			if !commandExists("nmcli") {
+				return false
+			}
+
+			output, err := runCommand(ctx, "nmcli", "--terse", "--fields", "GENERAL.STATE",
+				"device", "show", iface)
+			if err != nil {
+				return false
+			}
+
+			return !strings.Contains(output, "unmanaged")
+		},
+		Renew: func(ctx context.Context, iface string) error {
+			// Re-activating the connection restarts the DHCP client
+			_, err := runCommand(ctx, "nmcli", "device", "connect", iface)
+
+			return err
+		},
+	},
+	{
+		Name: "dhclient",
+		Available: func(_ context.Context, _ string) bool {
+			return commandExists("dhclient")
+		},
+		Renew: func(ctx context.Context, iface string) error {
+			if _, err := runCommand(ctx, "dhclient", "-r", iface); err != nil {
+				return err
+			}
+
+			// Don't wait for the lease to be acquired
+			_, err := runCommand(ctx, "dhclient", "-nw", iface)
+
+			return err
+		},
+	},
+}
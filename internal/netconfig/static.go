@@ -0,0 +1,252 @@
+package netconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+var (
+	ErrNoBackend      = errors.New("no supported network configuration mechanism found")
+	ErrUnknownBackend = errors.New("unknown network configuration mechanism")
+	ErrCheckFailed    = errors.New("connectivity check failed")
+
+	ErrUnsupportedConfig = errors.New("unsupported configuration")
+)
+
+const (
+	backendNetplan        = "netplan"
+	backendNetworkd       = "systemd-networkd"
+	backendNetworkManager = "NetworkManager"
+	backendNetworksetup   = "networksetup"
+)
+
+// How long the rollback may take, it's not bound to the
+// request's context since an interrupted rollback would
+// leave the interface in a half-configured state
+const rollbackTimeout = time.Minute
+
+var (
+	interfaceNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.:-]*$`)
+
+	// Search domains end up in the networkd's Domains=, NetworkManager's
+	// dns-search= and netplan's YAML, so no whitespace, separators or quotes
+	searchDomainRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*\.?$`)
+)
+
+// InterfaceConfig is a declarative static configuration of an interface.
+type InterfaceConfig struct {
+	Name string
+
+	// Whether to obtain an IPv4 address via DHCP
+	// in addition to the static addresses
+	DHCP4 bool
+
+	Addresses     []netip.Prefix
+	Routes        []Route
+	DNSServers    []netip.Addr
+	SearchDomains []string
+
+	// Zero leaves the MTU intact
+	MTU uint32
+}
+
+type Route struct {
+	// 0.0.0.0/0 or ::/0 for the default route
+	Destination netip.Prefix
+	Gateway     netip.Addr
+	Metric      uint32
+}
+
+func (route Route) IsDefault() bool {
+	return route.Destination.Bits() == 0
+}
+
+func (config InterfaceConfig) Validate() error {
+	if !interfaceNameRegexp.MatchString(config.Name) {
+		return fmt.Errorf("invalid interface name %q", config.Name)
+	}
+
+	if !config.DHCP4 && len(config.Addresses) == 0 {
+		return fmt.Errorf("interface %s needs either DHCP or at least one static address", config.Name)
+	}
+
+	for _, address := range config.Addresses {
+		if !address.IsValid() {
+			return fmt.Errorf("invalid address for interface %s", config.Name)
+		}
+	}
+
+	for _, route := range config.Routes {
+		if !route.Destination.IsValid() || !route.Gateway.IsValid() {
+			return fmt.Errorf("route for interface %s needs both a destination and a gateway", config.Name)
+		}
+
+		if route.Destination.Addr().Is4() != route.Gateway.Is4() {
+			return fmt.Errorf("route to %s for interface %s has a gateway of a different address family",
+				route.Destination, config.Name)
+		}
+	}
+
+	for _, server := range config.DNSServers {
+		if !server.IsValid() {
+			return fmt.Errorf("invalid DNS server for interface %s", config.Name)
+		}
+	}
+
+	for _, domain := range config.SearchDomains {
+		if len(domain) > 253 || !searchDomainRegexp.MatchString(domain) {
+			return fmt.Errorf("invalid search domain %q for interface %s", domain, config.Name)
+		}
+	}
+
+	return nil
+}
+
+func (config InterfaceConfig) addresses(ipv4 bool) []netip.Prefix {
+	return lo.Filter(config.Addresses, func(address netip.Prefix, _ int) bool {
+		return address.Addr().Is4() == ipv4
+	})
+}
+
+func (config InterfaceConfig) routes(ipv4 bool) []Route {
+	return lo.Filter(config.Routes, func(route Route, _ int) bool {
+		return route.Destination.Addr().Is4() == ipv4
+	})
+}
+
+func (config InterfaceConfig) dnsServers(ipv4 bool) []netip.Addr {
+	return lo.Filter(config.DNSServers, func(server netip.Addr, _ int) bool {
+		return server.Is4() == ipv4
+	})
+}
+
+// backend is a platform's native mechanism
+// for configuring the network interfaces.
+type backend struct {
+	Name string
+
+	Available func(ctx context.Context) bool
+
+	Render func(ctx context.Context, config InterfaceConfig) (*Plan, error)
+
+	// RenderRollback returns a plan that restores the current
+	// configuration of the interface, before the plan is applied
+	RenderRollback func(ctx context.Context, config InterfaceConfig, plan *Plan) (*Plan, error)
+}
+
+type ConfigureOptions struct {
+	// Name of the mechanism to use, auto-detected when empty
+	Backend string
+
+	// Only render the plan without applying it
+	DryRun bool
+
+	// TCP addresses that should be reachable once the configuration
+	// is applied, in addition to the interface's static addresses
+	// being assigned, otherwise the configuration is rolled back
+	CheckAddresses []string
+	CheckTimeout   time.Duration
+}
+
+type ConfigureResult struct {
+	Plan *Plan
+
+	// Set when the configuration was applied, but rolled
+	// back because the connectivity check has failed
+	CheckError error
+}
+
+// Configure applies the static configuration to the interface
+// through the platform's native mechanism.
+func Configure(ctx context.Context, config InterfaceConfig, options ConfigureOptions) (*ConfigureResult, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	backend, err := selectBackend(ctx, options.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := backend.Render(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigureResult{
+		Plan: plan,
+	}
+
+	if options.DryRun {
+		return result, nil
+	}
+
+	rollbackPlan, err := backend.RenderRollback(ctx, config, plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture the current configuration of %s: %w", config.Name, err)
+	}
+
+	if err := plan.Apply(ctx); err != nil {
+		if rollbackErr := rollback(ctx, rollbackPlan); rollbackErr != nil {
+			return nil, fmt.Errorf("%w, additionally, rollback has failed: %v", err, rollbackErr)
+		}
+
+		return nil, err
+	}
+
+	if err := check(ctx, config, options.CheckAddresses, options.CheckTimeout); err != nil {
+		if rollbackErr := rollback(ctx, rollbackPlan); rollbackErr != nil {
+			return nil, fmt.Errorf("%w: %v, additionally, rollback has failed: %v",
+				ErrCheckFailed, err, rollbackErr)
+		}
+
+		result.CheckError = fmt.Errorf("%w: %v", ErrCheckFailed, err)
+	}
+
+	return result, nil
+}
+
+// rollback applies the rollback plan even if the request
+// was cancelled, which is often the reason to roll back.
+func rollback(ctx context.Context, plan *Plan) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	return plan.Apply(ctx)
+}
+
+func selectBackend(ctx context.Context, name string) (*backend, error) {
+	for _, backend := range backends {
+		if name != "" {
+			if backend.Name == name {
+				return &backend, nil
+			}
+
+			continue
+		}
+
+		if backend.Available(ctx) {
+			return &backend, nil
+		}
+	}
+
+	if name != "" {
+		return nil, fmt.Errorf("%w %q, supported mechanisms: %v", ErrUnknownBackend, name,
+			BackendNames())
+	}
+
+	return nil, ErrNoBackend
+}
+
+// BackendNames returns the names of the mechanisms supported on this platform.
+func BackendNames() []string {
+	return lo.Map(backends, func(backend backend, _ int) string {
+		return backend.Name
+	})
+}
@@ -0,0 +1,88 @@
+package netconfig
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+var currentMTURegexp = regexp.MustCompile(`Current Setting: (\d+)`)
+
+var backends = []backend{
+	{
+		Name: backendNetworksetup,
+		Available: func(_ context.Context) bool {
+			return true
+		},
+		Render: func(ctx context.Context, config InterfaceConfig) (*Plan, error) {
+			service, err := networkService(ctx, config.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			return renderNetworksetup(service, config)
+		},
+		RenderRollback: func(ctx context.Context, config InterfaceConfig, _ *Plan) (*Plan, error) {
+			service, err := networkService(ctx, config.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			var state networksetupState
+
+			info, err := runCommand(ctx, "networksetup", "-getinfo", service)
+			if err != nil {
+				return nil, err
+			}
+			parseNetworksetupInfo(&state, info)
+
+			for _, list := range []struct {
+				Flag   string
+				Values *[]string
+			}{
+				{"-getdnsservers", &state.DNSServers},
+				{"-getsearchdomains", &state.SearchDomains},
+				{"-getadditionalroutes", &state.AdditionalRoutes},
+			} {
+				output, err := runCommand(ctx, "networksetup", list.Flag, service)
+				if err != nil {
+					return nil, err
+				}
+
+				*list.Values = parseNetworksetupList(output)
+			}
+
+			commands := state.commands(service)
+
+			if config.MTU != 0 {
+				output, err := runCommand(ctx, "networksetup", "-getMTU", config.Name)
+				if err != nil {
+					return nil, err
+				}
+
+				if matches := currentMTURegexp.FindStringSubmatch(output); matches != nil {
+					commands = append(commands, []string{"networksetup", "-setMTU", config.Name, matches[1]})
+				}
+			}
+
+			return &Plan{
+				Backend:  backendNetworksetup,
+				Commands: commands,
+			}, nil
+		},
+	},
+}
+
+func networkService(ctx context.Context, iface string) (string, error) {
+	output, err := runCommand(ctx, "networksetup", "-listallhardwareports")
+	if err != nil {
+		return "", err
+	}
+
+	service, ok := parseHardwarePorts(output)[iface]
+	if !ok {
+		return "", fmt.Errorf("no network service found for interface %s", iface)
+	}
+
+	return service, nil
+}
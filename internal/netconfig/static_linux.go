@@ -0,0 +1,68 @@
+package netconfig
+
+import (
+	"context"
+	"os"
+	"strings"
+)
+
+var backends = []backend{
+	{
+		Name: backendNetplan,
+		Available: func(_ context.Context) bool {
+			if !commandExists("netplan") {
+				return false
+			}
+
+			_, err := os.Stat(netplanDir)
+
+			return err == nil
+		},
+		Render: func(_ context.Context, config InterfaceConfig) (*Plan, error) {
+			return renderNetplan(config)
+		},
+		RenderRollback: func(_ context.Context, _ InterfaceConfig, plan *Plan) (*Plan, error) {
+			return renderFilesRollback(plan, netplanCommands())
+		},
+	},
+	{
+		Name: backendNetworkd,
+		Available: func(ctx context.Context) bool {
+			return commandExists("networkctl") && serviceActive(ctx, "systemd-networkd")
+		},
+		Render: func(_ context.Context, config InterfaceConfig) (*Plan, error) {
+			return renderNetworkd(config), nil
+		},
+		RenderRollback: func(_ context.Context, config InterfaceConfig, plan *Plan) (*Plan, error) {
+			return renderFilesRollback(plan, networkdCommands(config))
+		},
+	},
+	{
+		Name: backendNetworkManager,
+		Available: func(ctx context.Context) bool {
+			if !commandExists("nmcli") {
+				return false
+			}
+
+			output, err := runCommand(ctx, "nmcli", "--terse", "--fields", "RUNNING", "general")
+
+			return err == nil && strings.TrimSpace(output) == "running"
+		},
+		Render: func(_ context.Context, config InterfaceConfig) (*Plan, error) {
+			return renderNetworkManager(config), nil
+		},
+		RenderRollback: func(_ context.Context, config InterfaceConfig, plan *Plan) (*Plan, error) {
+			return renderFilesRollback(plan, networkManagerRollbackCommands(config))
+		},
+	},
+}
+
+func serviceActive(ctx context.Context, name string) bool {
+	if !commandExists("systemctl") {
+		return false
+	}
+
+	_, err := runCommand(ctx, "systemctl", "is-active", "--quiet", name)
+
+	return err == nil
+}
@@ -0,0 +1,282 @@
+package netconfig
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() InterfaceConfig {
+	return InterfaceConfig{
+		Name: "enp0s1",
+		Addresses: []netip.Prefix{
+			netip.MustParsePrefix("192.168.64.10/24"),
+			netip.MustParsePrefix("fd00::10/64"),
+		},
+		Routes: []Route{
+			{Destination: netip.MustParsePrefix("0.0.0.0/0"), Gateway: netip.MustParseAddr("192.168.64.1")},
+			{Destination: netip.MustParsePrefix("::/0"), Gateway: netip.MustParseAddr("fd00::1")},
+			{
+				Destination: netip.MustParsePrefix("10.0.0.0/8"),
+				Gateway:     netip.MustParseAddr("192.168.64.254"),
+				Metric:      50,
+			},
+		},
+		DNSServers: []netip.Addr{
+			netip.MustParseAddr("192.168.64.1"),
+			netip.MustParseAddr("fd00::1"),
+		},
+		SearchDomains: []string{"example.test"},
+		MTU:           1400,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, testConfig().Validate())
+
+	config := testConfig()
+	config.Name = "../../etc/passwd"
+	require.ErrorContains(t, config.Validate(), "invalid interface name")
+
+	config = testConfig()
+	config.Addresses = nil
+	require.ErrorContains(t, config.Validate(), "needs either DHCP or at least one static address")
+
+	config.DHCP4 = true
+	require.NoError(t, config.Validate())
+
+	config = testConfig()
+	config.Routes[0].Gateway = netip.MustParseAddr("fd00::1")
+	require.ErrorContains(t, config.Validate(), "different address family")
+
+	for _, domain := range []string{"example.test other.test", "example.test;", "exa\nmple.test", ""} {
+		config = testConfig()
+		config.SearchDomains = []string{domain}
+		require.ErrorContains(t, config.Validate(), "invalid search domain")
+	}
+}
+
+func TestRenderNetplan(t *testing.T) {
+	plan, err := renderNetplan(testConfig())
+	require.NoError(t, err)
+	require.Len(t, plan.Files, 1)
+	require.Equal(t, "/etc/netplan/90-tart-guest-agent-enp0s1.yaml", plan.Files[0].Path)
+	require.Equal(t, `network:
+    version: 2
+    ethernets:
+        enp0s1:
+            dhcp4: false
+            addresses:
+                - 192.168.64.10/24
+                - fd00::10/64
+            routes:
+                - to: 0.0.0.0/0
+                  via: 192.168.64.1
+                - to: ::/0
+                  via: fd00::1
+                - to: 10.0.0.0/8
+                  via: 192.168.64.254
+                  metric: 50
+            nameservers:
+                addresses:
+                    - 192.168.64.1
+                    - fd00::1
+                search:
+                    - example.test
+            mtu: 1400
+`, string(plan.Files[0].Content))
+	require.Equal(t, [][]string{{"netplan", "apply"}}, plan.Commands)
+}
+
+func TestRenderNetworkd(t *testing.T) {
+	plan := renderNetworkd(testConfig())
+	require.Len(t, plan.Files, 1)
+	require.Equal(t, "/etc/systemd/network/10-tart-guest-agent-enp0s1.network", plan.Files[0].Path)
+	require.Equal(t, `[Match]
+Name=enp0s1
+
+[Link]
+MTUBytes=1400
+
+[Network]
+DHCP=no
+Address=192.168.64.10/24
+Address=fd00::10/64
+DNS=192.168.64.1
+DNS=fd00::1
+Domains=example.test
+
+[Route]
+Destination=0.0.0.0/0
+Gateway=192.168.64.1
+
+[Route]
+Destination=::/0
+Gateway=fd00::1
+
+[Route]
+Destination=10.0.0.0/8
+Gateway=192.168.64.254
+Metric=50
+`, string(plan.Files[0].Content))
+	require.Equal(t, [][]string{
+		{"networkctl", "reload"},
+		{"networkctl", "reconfigure", "enp0s1"},
+	}, plan.Commands)
+}
+
+func TestRenderNetworkManager(t *testing.T) {
+	plan := renderNetworkManager(testConfig())
+	require.Len(t, plan.Files, 1)
+	require.Equal(t, "/etc/NetworkManager/system-connections/tart-guest-agent-enp0s1.nmconnection",
+		plan.Files[0].Path)
+	require.EqualValues(t, 0600, plan.Files[0].Mode)
+	require.Equal(t, `[connection]
+id=tart-guest-agent-enp0s1
+uuid=`+networkManagerConnectionUUID("tart-guest-agent-enp0s1")+`
+type=ethernet
+interface-name=enp0s1
+autoconnect-priority=100
+
+[ethernet]
+mtu=1400
+
+[ipv4]
+method=manual
+address1=192.168.64.10/24
+gateway=192.168.64.1
+route1=10.0.0.0/8,192.168.64.254,50
+dns=192.168.64.1;
+dns-search=example.test;
+
+[ipv6]
+method=manual
+address1=fd00::10/64
+gateway=fd00::1
+dns=fd00::1;
+`, string(plan.Files[0].Content))
+	require.Equal(t, [][]string{
+		{"nmcli", "connection", "reload"},
+		{"nmcli", "connection", "up", "tart-guest-agent-enp0s1"},
+	}, plan.Commands)
+}
+
+func TestNetworkManagerConnectionUUID(t *testing.T) {
+	uuid := networkManagerConnectionUUID("tart-guest-agent-enp0s1")
+	require.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, uuid)
+	require.Equal(t, uuid, networkManagerConnectionUUID("tart-guest-agent-enp0s1"))
+	require.NotEqual(t, uuid, networkManagerConnectionUUID("tart-guest-agent-enp0s2"))
+}
+
+func TestRenderNetworksetup(t *testing.T) {
+	config := testConfig()
+	config.Name = "en0"
+	config.Routes[2].Metric = 0
+
+	plan, err := renderNetworksetup("Ethernet", config)
+	require.NoError(t, err)
+	require.Empty(t, plan.Files)
+	require.Equal(t, [][]string{
+		{"networksetup", "-setmanual", "Ethernet", "192.168.64.10", "255.255.255.0", "192.168.64.1"},
+		{"networksetup", "-setv6manual", "Ethernet", "fd00::10", "64", "fd00::1"},
+		{"networksetup", "-setdnsservers", "Ethernet", "192.168.64.1", "fd00::1"},
+		{"networksetup", "-setsearchdomains", "Ethernet", "example.test"},
+		{"networksetup", "-setadditionalroutes", "Ethernet", "10.0.0.0", "255.0.0.0", "192.168.64.254"},
+		{"networksetup", "-setMTU", "en0", "1400"},
+	}, plan.Commands)
+
+	// Route metrics are not supported
+	_, err = renderNetworksetup("Ethernet", testConfig())
+	require.ErrorContains(t, err, "route metrics")
+
+	// Neither are multiple addresses
+	config.Addresses = append(config.Addresses, netip.MustParsePrefix("192.168.64.11/24"))
+	_, err = renderNetworksetup("Ethernet", config)
+	require.ErrorContains(t, err, "at most one static address")
+}
+
+func TestNetworksetupState(t *testing.T) {
+	require.Equal(t, map[string]string{
+		"en0": "Ethernet",
+		"en1": "Thunderbolt Bridge",
+	}, parseHardwarePorts(`
+Hardware Port: Ethernet
+Device: en0
+Ethernet Address: 7e:9d:51:1a:2b:3c
+
+Hardware Port: Thunderbolt Bridge
+Device: en1
+Ethernet Address: 7e:9d:51:1a:2b:3d
+
+VLAN Configurations
+===================
+`))
+
+	var state networksetupState
+
+	parseNetworksetupInfo(&state, `DHCP Configuration
+IP address: 192.168.64.5
+Subnet mask: 255.255.255.0
+Router: 192.168.64.1
+Client ID:
+IPv6: Automatic
+IPv6 IP address: none
+IPv6 Router: none
+Ethernet Address: 7e:9d:51:1a:2b:3c
+`)
+	state.DNSServers = parseNetworksetupList("There aren't any DNS Servers set on Ethernet.\n")
+	state.SearchDomains = parseNetworksetupList("example.test\nexample.org\n")
+	state.AdditionalRoutes = parseNetworksetupList("There are no additional IPv4 routes on Ethernet.\n")
+
+	require.Equal(t, [][]string{
+		{"networksetup", "-setdhcp", "Ethernet"},
+		{"networksetup", "-setv6automatic", "Ethernet"},
+		{"networksetup", "-setdnsservers", "Ethernet", "empty"},
+		{"networksetup", "-setsearchdomains", "Ethernet", "example.test", "example.org"},
+		{"networksetup", "-setadditionalroutes", "Ethernet"},
+	}, state.commands("Ethernet"))
+}
+
+func TestApplyAndRollbackFiles(t *testing.T) {
+	dir := t.TempDir()
+	existingPath := filepath.Join(dir, "existing.conf")
+	newPath := filepath.Join(dir, "new", "new.conf")
+
+	require.NoError(t, os.WriteFile(existingPath, []byte("old"), 0644))
+
+	plan := &Plan{
+		Files: []File{
+			{Path: existingPath, Content: []byte("replaced"), Mode: 0600},
+			{Path: newPath, Content: []byte("created"), Mode: 0600},
+		},
+	}
+
+	rollbackPlan, err := renderFilesRollback(plan, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, plan.Apply(context.Background()))
+
+	content, err := os.ReadFile(existingPath)
+	require.NoError(t, err)
+	require.Equal(t, "replaced", string(content))
+
+	fileInfo, err := os.Stat(newPath)
+	require.NoError(t, err)
+	require.EqualValues(t, 0600, fileInfo.Mode().Perm())
+
+	require.NoError(t, rollbackPlan.Apply(context.Background()))
+
+	content, err = os.ReadFile(existingPath)
+	require.NoError(t, err)
+	require.Equal(t, "old", string(content))
+
+	fileInfo, err = os.Stat(existingPath)
+	require.NoError(t, err)
+	require.EqualValues(t, 0644, fileInfo.Mode().Perm())
+
+	require.NoFileExists(t, newPath)
+}
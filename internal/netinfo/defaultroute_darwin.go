@@ -0,0 +1,73 @@
+package netinfo
+
+import (
+	"golang.org/x/net/route"
+	"golang.org/x/sys/unix"
+)
+
+func defaultRouteInterfaces() (map[int]bool, map[int]bool, error) {
+	rib, err := route.FetchRIB(unix.AF_UNSPEC, route.RIBTypeRoute, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, err := route.ParseRIB(route.RIBTypeRoute, rib)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipv4 := map[int]bool{}
+	ipv6 := map[int]bool{}
+
+	for _, message := range messages {
+		routeMessage, ok := message.(*route.RouteMessage)
+		if !ok {
+			continue
+		}
+
+		if routeMessage.Flags&unix.RTF_UP == 0 || routeMessage.Flags&unix.RTF_GATEWAY == 0 {
+			continue
+		}
+
+		// Interface-scoped default routes are not the default ones
+		if routeMessage.Flags&unix.RTF_IFSCOPE != 0 {
+			continue
+		}
+
+		if len(routeMessage.Addrs) <= unix.RTAX_DST {
+			continue
+		}
+
+		var netmask route.Addr
+
+		if len(routeMessage.Addrs) > unix.RTAX_NETMASK {
+			netmask = routeMessage.Addrs[unix.RTAX_NETMASK]
+		}
+
+		switch destination := routeMessage.Addrs[unix.RTAX_DST].(type) {
+		case *route.Inet4Addr:
+			if destination.IP == [4]byte{} && isZeroNetmask(netmask) {
+				ipv4[routeMessage.Index] = true
+			}
+		case *route.Inet6Addr:
+			if destination.IP == [16]byte{} && isZeroNetmask(netmask) {
+				ipv6[routeMessage.Index] = true
+			}
+		}
+	}
+
+	return ipv4, ipv6, nil
+}
+
+func isZeroNetmask(netmask route.Addr) bool {
+	switch typedNetmask := netmask.(type) {
+	case nil:
+		return true
+	case *route.Inet4Addr:
+		return typedNetmask.IP == [4]byte{}
+	case *route.Inet6Addr:
+		return typedNetmask.IP == [16]byte{}
+	default:
+		return false
+	}
+}
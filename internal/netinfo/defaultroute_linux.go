@@ -0,0 +1,138 @@
+package netinfo
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	procNetRoute     = "/proc/net/route"
+	procNetIPv6Route = "/proc/net/ipv6_route"
+
+	// RTF_UP from linux/route.h
+	rtfUp = 0x0001
+)
+
+func defaultRouteInterfaces() (map[int]bool, map[int]bool, error) {
+	ipv4Names, err := parseFile(procNetRoute, parseProcNetRoute)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipv6Names, err := parseFile(procNetIPv6Route, parseProcNetIPv6Route)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return namesToIndices(ipv4Names), namesToIndices(ipv6Names), nil
+}
+
+// parseProcNetRoute returns the names of the interfaces that have
+// an IPv4 default route (destination and mask are both zero).
+func parseProcNetRoute(r io.Reader) ([]string, error) {
+	var result []string
+
+	scanner := bufio.NewScanner(r)
+
+	// Skip the header
+	scanner.Scan()
+
+	for scanner.Scan() {
+		// Iface Destination Gateway Flags RefCnt Use Metric Mask ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 {
+			continue
+		}
+
+		if fields[1] != "00000000" || fields[7] != "00000000" {
+			continue
+		}
+
+		if !hexFlagsHave(fields[3], rtfUp) {
+			continue
+		}
+
+		result = append(result, fields[0])
+	}
+
+	return result, scanner.Err()
+}
+
+// parseProcNetIPv6Route returns the names of the interfaces that have
+// an IPv6 default route (::/0 destination with a non-zero next hop).
+func parseProcNetIPv6Route(r io.Reader) ([]string, error) {
+	var result []string
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		// Destination DestinationPrefixLength Source SourcePrefixLength
+		// NextHop Metric RefCnt Use Flags Iface
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		if strings.Trim(fields[0], "0") != "" || fields[1] != "00" {
+			continue
+		}
+
+		// Unreachable routes are installed for the loopback interface
+		if fields[9] == "lo" || strings.Trim(fields[4], "0") == "" {
+			continue
+		}
+
+		if !hexFlagsHave(fields[8], rtfUp) {
+			continue
+		}
+
+		result = append(result, fields[9])
+	}
+
+	return result, scanner.Err()
+}
+
+func parseFile(path string, parse func(io.Reader) ([]string, error)) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		// IPv6 might be disabled
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	defer file.Close()
+
+	return parse(file)
+}
+
+func hexFlagsHave(flagsRaw string, flag uint64) bool {
+	flags, err := strconv.ParseUint(flagsRaw, 16, 64)
+	if err != nil {
+		return false
+	}
+
+	return flags&flag != 0
+}
+
+func namesToIndices(names []string) map[int]bool {
+	result := map[int]bool{}
+
+	for _, name := range names {
+		iface, err := net.InterfaceByName(name)
+		if err != nil {
+			continue
+		}
+
+		result[iface.Index] = true
+	}
+
+	return result
+}
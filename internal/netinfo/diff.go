@@ -0,0 +1,142 @@
+package netinfo
+
+import (
+	"net"
+	"slices"
+)
+
+type ChangeType int
+
+const (
+	ChangeInterfaceAdded ChangeType = iota + 1
+	ChangeInterfaceRemoved
+	ChangeInterfaceUp
+	ChangeInterfaceDown
+	ChangeAddressAdded
+	ChangeAddressRemoved
+	ChangeDefaultRoute
+)
+
+type Change struct {
+	Type ChangeType
+
+	// Current state of the interface, or its last known
+	// state for ChangeInterfaceRemoved, nil for
+	// ChangeDefaultRoute when there's no default route
+	Interface *Interface
+
+	// Set for ChangeAddressAdded and ChangeAddressRemoved
+	Address *Address
+
+	// Set for ChangeDefaultRoute
+	Family Family
+}
+
+// Diff describes how the network state has changed between two snapshots.
+func Diff(oldInterfaces []Interface, newInterfaces []Interface) []Change {
+	var changes []Change
+
+	oldByIndex := byIndex(oldInterfaces)
+	newByIndex := byIndex(newInterfaces)
+
+	for _, oldInterface := range oldInterfaces {
+		if _, ok := newByIndex[oldInterface.Index]; !ok {
+			changes = append(changes, Change{
+				Type:      ChangeInterfaceRemoved,
+				Interface: &oldInterface,
+			})
+		}
+	}
+
+	for _, newInterface := range newInterfaces {
+		oldInterface, ok := oldByIndex[newInterface.Index]
+		if !ok {
+			changes = append(changes, Change{
+				Type:      ChangeInterfaceAdded,
+				Interface: &newInterface,
+			})
+
+			// Report the addresses of the new interface
+			oldInterface = Interface{Flags: newInterface.Flags}
+		}
+
+		wasUp := oldInterface.Flags&net.FlagUp != 0
+		isUp := newInterface.Flags&net.FlagUp != 0
+
+		switch {
+		case !wasUp && isUp:
+			changes = append(changes, Change{Type: ChangeInterfaceUp, Interface: &newInterface})
+		case wasUp && !isUp:
+			changes = append(changes, Change{Type: ChangeInterfaceDown, Interface: &newInterface})
+		}
+
+		for _, address := range oldInterface.Addresses {
+			if !containsAddress(newInterface.Addresses, address) {
+				changes = append(changes, Change{
+					Type:      ChangeAddressRemoved,
+					Interface: &newInterface,
+					Address:   &address,
+				})
+			}
+		}
+
+		for _, address := range newInterface.Addresses {
+			if !containsAddress(oldInterface.Addresses, address) {
+				changes = append(changes, Change{
+					Type:      ChangeAddressAdded,
+					Interface: &newInterface,
+					Address:   &address,
+				})
+			}
+		}
+	}
+
+	for _, family := range []Family{FamilyIPv4, FamilyIPv6} {
+		oldDefault := defaultRouteInterface(oldInterfaces, family)
+		newDefault := defaultRouteInterface(newInterfaces, family)
+
+		if indexOrZero(oldDefault) != indexOrZero(newDefault) {
+			changes = append(changes, Change{
+				Type:      ChangeDefaultRoute,
+				Interface: newDefault,
+				Family:    family,
+			})
+		}
+	}
+
+	return changes
+}
+
+func byIndex(interfaces []Interface) map[int]Interface {
+	result := map[int]Interface{}
+
+	for _, iface := range interfaces {
+		result[iface.Index] = iface
+	}
+
+	return result
+}
+
+func containsAddress(addresses []Address, address Address) bool {
+	return slices.ContainsFunc(addresses, func(other Address) bool {
+		return other.IP.Equal(address.IP) && other.PrefixLength == address.PrefixLength
+	})
+}
+
+func defaultRouteInterface(interfaces []Interface, family Family) *Interface {
+	for _, iface := range interfaces {
+		if iface.HasDefaultRoute(family) {
+			return &iface
+		}
+	}
+
+	return nil
+}
+
+func indexOrZero(iface *Interface) int {
+	if iface == nil {
+		return 0
+	}
+
+	return iface.Index
+}
@@ -0,0 +1,63 @@
+package netinfo
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDHCPLeaseRenewal(t *testing.T) {
+	oldInterfaces := testInterfaces()
+
+	newInterfaces := testInterfaces()
+	newInterfaces[3].Addresses[1] = Address{IP: net.ParseIP("192.168.64.6"), PrefixLength: 24}
+
+	changes := Diff(oldInterfaces, newInterfaces)
+	require.Len(t, changes, 2)
+
+	require.Equal(t, ChangeAddressRemoved, changes[0].Type)
+	require.Equal(t, "en0", changes[0].Interface.Name)
+	require.Equal(t, "192.168.64.5", changes[0].Address.IP.String())
+
+	require.Equal(t, ChangeAddressAdded, changes[1].Type)
+	require.Equal(t, "192.168.64.6", changes[1].Address.IP.String())
+}
+
+func TestDiffInterfaces(t *testing.T) {
+	oldInterfaces := testInterfaces()
+
+	// Remove the VPN interface, bring the Docker bridge down and
+	// move the default route to a newly added interface
+	newInterfaces := testInterfaces()[:2]
+	newInterfaces[1].Flags = 0
+	newInterfaces = append(newInterfaces, Interface{
+		Name:             "en1",
+		Index:            5,
+		Flags:            net.FlagUp,
+		Addresses:        []Address{{IP: net.ParseIP("10.0.0.2"), PrefixLength: 24}},
+		DefaultRouteIPv4: true,
+	})
+
+	var types []ChangeType
+
+	for _, change := range Diff(oldInterfaces, newInterfaces) {
+		types = append(types, change.Type)
+
+		if change.Type == ChangeDefaultRoute {
+			require.Equal(t, FamilyIPv4, change.Family)
+			require.Equal(t, "en1", change.Interface.Name)
+		}
+	}
+
+	require.Equal(t, []ChangeType{
+		ChangeInterfaceRemoved,
+		ChangeInterfaceRemoved,
+		ChangeInterfaceDown,
+		ChangeInterfaceAdded,
+		ChangeAddressAdded,
+		ChangeDefaultRoute,
+	}, types)
+
+	require.Empty(t, Diff(newInterfaces, newInterfaces))
+}
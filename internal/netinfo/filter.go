@@ -0,0 +1,142 @@
+package netinfo
+
+import (
+	"fmt"
+	"net"
+	"slices"
+)
+
+type Filter struct {
+	// Only consider addresses of this family
+	Family Family
+
+	// Only consider interfaces with these names
+	InterfaceNames []string
+
+	// Only consider addresses belonging to these subnets
+	Subnets []*net.IPNet
+
+	// Only consider interfaces that hold the default route
+	DefaultRouteOnly bool
+}
+
+func ParseSubnets(subnetsRaw []string) ([]*net.IPNet, error) {
+	var subnets []*net.IPNet
+
+	for _, subnetRaw := range subnetsRaw {
+		_, subnet, err := net.ParseCIDR(subnetRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet %q: %w", subnetRaw, err)
+		}
+
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
+
+// Apply returns the interfaces and addresses that match the filter.
+//
+// When the filter restricts addresses (by family or subnet), interfaces
+// with no matching addresses are omitted.
+func (filter Filter) Apply(interfaces []Interface) []Interface {
+	var result []Interface
+
+	for _, iface := range interfaces {
+		if len(filter.InterfaceNames) != 0 && !slices.Contains(filter.InterfaceNames, iface.Name) {
+			continue
+		}
+
+		if filter.DefaultRouteOnly && !iface.HasDefaultRoute(filter.Family) {
+			continue
+		}
+
+		var addresses []Address
+
+		for _, address := range iface.Addresses {
+			if filter.matchesAddress(address) {
+				addresses = append(addresses, address)
+			}
+		}
+
+		if len(addresses) == 0 && (filter.Family != FamilyAny || len(filter.Subnets) != 0) {
+			continue
+		}
+
+		iface.Addresses = addresses
+
+		result = append(result, iface)
+	}
+
+	return result
+}
+
+func (filter Filter) matchesAddress(address Address) bool {
+	if filter.Family != FamilyAny && address.Family() != filter.Family {
+		return false
+	}
+
+	if len(filter.Subnets) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(filter.Subnets, func(subnet *net.IPNet) bool {
+		return subnet.Contains(address.IP)
+	})
+}
+
+// Preferred picks a single address from the (already filtered) interfaces,
+// which is what ResolveIP returned historically. The preference order is:
+//
+//  1. only global unicast addresses of the requested family are considered,
+//     IPv4 is assumed when no family is requested for backward compatibility
+//  2. addresses of the interface holding the default route for that family
+//  3. addresses of the interfaces that are up
+//  4. addresses of the remaining interfaces
+//
+// Ties are broken by the interface index and then by the address
+// order reported by the operating system.
+func Preferred(interfaces []Interface, family Family) (net.IP, bool) {
+	if family == FamilyAny {
+		family = FamilyIPv4
+	}
+
+	rank := func(iface Interface) int {
+		switch {
+		case iface.HasDefaultRoute(family):
+			return 0
+		case iface.Flags&net.FlagUp != 0:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	sorted := slices.Clone(interfaces)
+
+	slices.SortStableFunc(sorted, func(a, b Interface) int {
+		if rankA, rankB := rank(a), rank(b); rankA != rankB {
+			return rankA - rankB
+		}
+
+		return a.Index - b.Index
+	})
+
+	for _, iface := range sorted {
+		for _, address := range iface.Addresses {
+			if address.Family() != family {
+				continue
+			}
+
+			// Note that Golang's "net" package also includes
+			// IPv4 private address space in this definition.
+			if !address.IP.IsGlobalUnicast() {
+				continue
+			}
+
+			return address.IP, true
+		}
+	}
+
+	return nil, false
+}
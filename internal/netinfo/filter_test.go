@@ -0,0 +1,95 @@
+package netinfo
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testInterfaces() []Interface {
+	return []Interface{
+		{
+			Name:  "lo0",
+			Index: 1,
+			Flags: net.FlagUp | net.FlagLoopback,
+			Addresses: []Address{
+				{IP: net.ParseIP("127.0.0.1"), PrefixLength: 8},
+				{IP: net.ParseIP("::1"), PrefixLength: 128},
+			},
+		},
+		{
+			Name:  "docker0",
+			Index: 2,
+			Flags: net.FlagUp,
+			Addresses: []Address{
+				{IP: net.ParseIP("172.17.0.1"), PrefixLength: 16},
+			},
+		},
+		{
+			Name:  "utun0",
+			Index: 3,
+			Addresses: []Address{
+				{IP: net.ParseIP("10.8.0.2"), PrefixLength: 24},
+			},
+		},
+		{
+			Name:  "en0",
+			Index: 4,
+			Flags: net.FlagUp | net.FlagBroadcast,
+			Addresses: []Address{
+				{IP: net.ParseIP("fe80::1"), PrefixLength: 64},
+				{IP: net.ParseIP("192.168.64.5"), PrefixLength: 24},
+				{IP: net.ParseIP("fd00::5"), PrefixLength: 64},
+			},
+			DefaultRouteIPv4: true,
+		},
+	}
+}
+
+func TestPreferredDefaultRoute(t *testing.T) {
+	interfaces := Filter{}.Apply(testInterfaces())
+	require.Len(t, interfaces, 4)
+
+	ip, ok := Preferred(interfaces, FamilyAny)
+	require.True(t, ok)
+	require.Equal(t, "192.168.64.5", ip.String())
+
+	ip, ok = Preferred(interfaces, FamilyIPv6)
+	require.True(t, ok)
+	require.Equal(t, "fd00::5", ip.String())
+}
+
+func TestPreferredNoDefaultRoute(t *testing.T) {
+	interfaces := testInterfaces()
+	interfaces[3].DefaultRouteIPv4 = false
+	interfaces[3].Flags = 0
+
+	// Interfaces that are up are preferred
+	ip, ok := Preferred(interfaces, FamilyIPv4)
+	require.True(t, ok)
+	require.Equal(t, "172.17.0.1", ip.String())
+}
+
+func TestFilter(t *testing.T) {
+	subnets, err := ParseSubnets([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	interfaces := Filter{Subnets: subnets}.Apply(testInterfaces())
+	require.Len(t, interfaces, 1)
+	require.Equal(t, "utun0", interfaces[0].Name)
+
+	interfaces = Filter{Family: FamilyIPv6, InterfaceNames: []string{"en0"}}.Apply(testInterfaces())
+	require.Len(t, interfaces, 1)
+	require.Len(t, interfaces[0].Addresses, 2)
+
+	interfaces = Filter{DefaultRouteOnly: true}.Apply(testInterfaces())
+	require.Len(t, interfaces, 1)
+	require.Equal(t, "en0", interfaces[0].Name)
+
+	interfaces = Filter{Family: FamilyIPv6, DefaultRouteOnly: true}.Apply(testInterfaces())
+	require.Empty(t, interfaces)
+
+	_, ok := Preferred(interfaces, FamilyIPv6)
+	require.False(t, ok)
+}
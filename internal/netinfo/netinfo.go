@@ -0,0 +1,116 @@
+package netinfo
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type Family int
+
+const (
+	FamilyAny Family = iota
+	FamilyIPv4
+	FamilyIPv6
+)
+
+type Address struct {
+	IP           net.IP
+	PrefixLength int
+}
+
+func (address Address) Family() Family {
+	if address.IP.To4() != nil {
+		return FamilyIPv4
+	}
+
+	return FamilyIPv6
+}
+
+type Interface struct {
+	Name             string
+	Index            int
+	MAC              string
+	Flags            net.Flags
+	MTU              int
+	Addresses        []Address
+	DefaultRouteIPv4 bool
+	DefaultRouteIPv6 bool
+}
+
+func (iface Interface) FlagNames() []string {
+	if iface.Flags == 0 {
+		return nil
+	}
+
+	return strings.Split(iface.Flags.String(), "|")
+}
+
+func (iface Interface) HasDefaultRoute(family Family) bool {
+	switch family {
+	case FamilyIPv4:
+		return iface.DefaultRouteIPv4
+	case FamilyIPv6:
+		return iface.DefaultRouteIPv6
+	default:
+		return iface.DefaultRouteIPv4 || iface.DefaultRouteIPv6
+	}
+}
+
+// Interfaces enumerates the network interfaces along with
+// their addresses and the default route information.
+func Interfaces() ([]Interface, error) {
+	netInterfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve VM's network interfaces: %w", err)
+	}
+
+	// Treat the default route as unknown instead of failing,
+	// the addresses are still useful to the callers
+	defaultRouteIPv4, defaultRouteIPv6, err := defaultRouteInterfaces()
+	if err != nil {
+		zap.S().Warnf("failed to determine the default route: %v", err)
+	}
+
+	var result []Interface
+
+	for _, netInterface := range netInterfaces {
+		iface := Interface{
+			Name:             netInterface.Name,
+			Index:            netInterface.Index,
+			MAC:              netInterface.HardwareAddr.String(),
+			Flags:            netInterface.Flags,
+			MTU:              netInterface.MTU,
+			DefaultRouteIPv4: defaultRouteIPv4[netInterface.Index],
+			DefaultRouteIPv6: defaultRouteIPv6[netInterface.Index],
+		}
+
+		addrs, err := netInterface.Addrs()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve addresses of the network interface %s: %w",
+				netInterface.Name, err)
+		}
+
+		for _, addr := range addrs {
+			// Addresses returned by net.Interface.Addrs()
+			// generally are of type *net.IPNet
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+
+			prefixLength, _ := ipNet.Mask.Size()
+
+			iface.Addresses = append(iface.Addresses, Address{
+				IP:           ipNet.IP,
+				PrefixLength: prefixLength,
+			})
+		}
+
+		result = append(result, iface)
+	}
+
+	return result, nil
+}
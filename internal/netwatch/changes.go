@@ -0,0 +1,77 @@
+package netwatch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// How often to re-examine the network state
+	// when the notifications are not available
+	pollInterval = time.Second
+
+	// How often to re-examine the network state even if the
+	// notifications are available, in case some were missed
+	safetyInterval = 10 * time.Second
+
+	// Changes often come in bursts (e.g. an interface going up
+	// is followed by the address and route additions), so
+	// we wait a bit for the burst to end
+	debounceInterval = 100 * time.Millisecond
+)
+
+// Changes is like Subscribe, but never fails: it falls back to periodic
+// polling when the notifications are not available, and also triggers
+// periodically as a safety net when they are.
+//
+// The caller is expected to examine the network state first
+// and then re-examine it each time the channel fires.
+func Changes(ctx context.Context) <-chan struct{} {
+	result := make(chan struct{}, 1)
+
+	notifications, err := Subscribe(ctx)
+	if err != nil {
+		zap.S().Debugf("network change notifications are not available, falling back to polling: %v", err)
+	}
+
+	go func() {
+		defer close(result)
+
+		interval := safetyInterval
+		if notifications == nil {
+			interval = pollInterval
+		}
+
+		for {
+			select {
+			case _, ok := <-notifications:
+				if !ok {
+					// Notifications stopped working, resort to polling
+					notifications = nil
+					interval = pollInterval
+
+					continue
+				}
+
+				select {
+				case <-time.After(debounceInterval):
+				case <-ctx.Done():
+					return
+				}
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case result <- struct{}{}:
+			default:
+				// There's already a pending notification
+			}
+		}
+	}()
+
+	return result
+}
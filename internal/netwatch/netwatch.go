@@ -0,0 +1,58 @@
+package netwatch
+
+import (
+	"context"
+	"os"
+)
+
+const readBufferSize = 64 * 1024
+
+// Subscribe returns a channel that receives a value whenever the kernel
+// reports a change to the network interfaces, addresses or routes.
+//
+// Notifications are coalesced: a single value may stand for multiple changes,
+// so the receiver should re-examine the network state after each one.
+// The channel is closed when the context is cancelled or the
+// underlying socket fails.
+func Subscribe(ctx context.Context) (<-chan struct{}, error) {
+	file, err := openSocket()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(chan struct{}, 1)
+
+	go func() {
+		<-ctx.Done()
+
+		// Unblocks the pending read below
+		_ = file.Close()
+	}()
+
+	go func() {
+		defer close(changes)
+
+		buf := make([]byte, readBufferSize)
+
+		for {
+			if _, err := file.Read(buf); err != nil {
+				return
+			}
+
+			select {
+			case changes <- struct{}{}:
+			default:
+				// There's already a pending notification
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+func newPollableFile(fd int, name string) *os.File {
+	// Since the file descriptor is non-blocking, the resulting
+	// file will use the runtime's poller, so that closing
+	// the file unblocks the reads that are in progress
+	return os.NewFile(uintptr(fd), name)
+}
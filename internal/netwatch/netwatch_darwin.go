@@ -0,0 +1,24 @@
+package netwatch
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func openSocket() (*os.File, error) {
+	fd, err := unix.Socket(unix.AF_ROUTE, unix.SOCK_RAW, unix.AF_UNSPEC)
+	if err != nil {
+		return nil, err
+	}
+
+	unix.CloseOnExec(fd)
+
+	if err := unix.SetNonblock(fd, true); err != nil {
+		_ = unix.Close(fd)
+
+		return nil, err
+	}
+
+	return newPollableFile(fd, "route"), nil
+}
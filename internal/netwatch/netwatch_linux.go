@@ -0,0 +1,27 @@
+package netwatch
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func openSocket() (*os.File, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK,
+		unix.NETLINK_ROUTE)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{
+		Family: unix.AF_NETLINK,
+		Groups: unix.RTMGRP_LINK | unix.RTMGRP_IPV4_IFADDR | unix.RTMGRP_IPV6_IFADDR |
+			unix.RTMGRP_IPV4_ROUTE | unix.RTMGRP_IPV6_ROUTE,
+	}); err != nil {
+		_ = unix.Close(fd)
+
+		return nil, err
+	}
+
+	return newPollableFile(fd, "netlink"), nil
+}
@@ -0,0 +1,257 @@
+package pathwatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/glob"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+type Op int
+
+const (
+	OpCreate Op = iota + 1
+	OpModify
+	OpDelete
+	OpRename
+)
+
+type Event struct {
+	Op    Op
+	Path  string
+	IsDir bool
+}
+
+// PathWatcher reports file system changes under a set of paths
+// using inotify on Linux and kqueue on macOS.
+//
+// Note that kqueue requires an open file descriptor for each watched
+// file, and watching a directory also watches the files in it, so on
+// macOS a recursive watch needs as many descriptors as there are files
+// and directories in the tree. Large trees might exhaust the process'
+// descriptor limit (kern.maxfilesperproc), in which case New fails.
+type PathWatcher struct {
+	watcher   *fsnotify.Watcher
+	roots     []string
+	recursive bool
+	include   []string
+	exclude   []string
+}
+
+func New(paths []string, recursive bool, include []string, exclude []string) (*PathWatcher, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("at least one path to watch should be specified")
+	}
+
+	for _, pattern := range append(include, exclude...) {
+		if err := glob.Validate(pattern); err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+		}
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize file system watcher: %w", err)
+	}
+
+	pathWatcher := &PathWatcher{
+		watcher:   watcher,
+		recursive: recursive,
+		include:   include,
+		exclude:   exclude,
+	}
+
+	for _, path := range paths {
+		root, err := filepath.Abs(path)
+		if err != nil {
+			_ = watcher.Close()
+
+			return nil, err
+		}
+
+		pathWatcher.roots = append(pathWatcher.roots, root)
+
+		if err := pathWatcher.add(root, nil); err != nil {
+			_ = watcher.Close()
+
+			return nil, err
+		}
+	}
+
+	return pathWatcher, nil
+}
+
+// Run delivers events to the callback until the context is cancelled
+// or the callback returns an error.
+func (pathWatcher *PathWatcher) Run(ctx context.Context, callback func(Event) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err, ok := <-pathWatcher.watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			return fmt.Errorf("file system watcher failed: %w", err)
+		case fsEvent, ok := <-pathWatcher.watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if err := pathWatcher.process(fsEvent, callback); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (pathWatcher *PathWatcher) Close() error {
+	return pathWatcher.watcher.Close()
+}
+
+func (pathWatcher *PathWatcher) process(fsEvent fsnotify.Event, callback func(Event) error) error {
+	var op Op
+
+	switch {
+	case fsEvent.Has(fsnotify.Create):
+		op = OpCreate
+	case fsEvent.Has(fsnotify.Write):
+		op = OpModify
+	case fsEvent.Has(fsnotify.Remove):
+		op = OpDelete
+	case fsEvent.Has(fsnotify.Rename):
+		op = OpRename
+	default:
+		// Attribute changes are not reported
+		return nil
+	}
+
+	event := Event{
+		Op:   op,
+		Path: fsEvent.Name,
+	}
+
+	if op == OpCreate || op == OpModify {
+		if fileInfo, err := os.Lstat(fsEvent.Name); err == nil {
+			event.IsDir = fileInfo.IsDir()
+		}
+	}
+
+	// Start watching the newly created directories, reporting
+	// the entries that appeared in them before the watch was
+	// established as created too
+	if op == OpCreate && event.IsDir && pathWatcher.recursive {
+		var discovered []Event
+
+		if err := pathWatcher.add(fsEvent.Name, &discovered); err != nil {
+			zap.S().Warnf("failed to watch newly created directory %s: %v", fsEvent.Name, err)
+		}
+
+		if err := pathWatcher.deliver(event, callback); err != nil {
+			return err
+		}
+
+		for _, discoveredEvent := range discovered {
+			if err := pathWatcher.deliver(discoveredEvent, callback); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return pathWatcher.deliver(event, callback)
+}
+
+func (pathWatcher *PathWatcher) deliver(event Event, callback func(Event) error) error {
+	if !pathWatcher.matches(event.Path) {
+		return nil
+	}
+
+	return callback(event)
+}
+
+// add starts watching the path and, when in recursive mode, all of its
+// subdirectories, optionally collecting the nested entries as events.
+func (pathWatcher *PathWatcher) add(path string, discovered *[]Event) error {
+	if !pathWatcher.recursive {
+		return pathWatcher.watcher.Add(path)
+	}
+
+	return filepath.WalkDir(path, func(entryPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Entries might disappear while we're walking
+			if errors.Is(err, fs.ErrNotExist) && entryPath != path {
+				return nil
+			}
+
+			return err
+		}
+
+		if entryPath != path && discovered != nil {
+			*discovered = append(*discovered, Event{
+				Op:    OpCreate,
+				Path:  entryPath,
+				IsDir: entry.IsDir(),
+			})
+		}
+
+		// Only the directories are watched, except for the root,
+		// which might be a regular file even in recursive mode
+		if !entry.IsDir() && entryPath != path {
+			return nil
+		}
+
+		return pathWatcher.watcher.Add(entryPath)
+	})
+}
+
+// matches applies include and exclude patterns to the event's path
+// relative to the watched root it belongs to.
+func (pathWatcher *PathWatcher) matches(path string) bool {
+	relativePath := filepath.ToSlash(pathWatcher.relativize(path))
+
+	for _, pattern := range pathWatcher.exclude {
+		if matched, _ := glob.Match(pattern, relativePath); matched {
+			return false
+		}
+	}
+
+	if len(pathWatcher.include) == 0 {
+		return true
+	}
+
+	for _, pattern := range pathWatcher.include {
+		if matched, _ := glob.Match(pattern, relativePath); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (pathWatcher *PathWatcher) relativize(path string) string {
+	for _, root := range pathWatcher.roots {
+		if path == root {
+			return filepath.Base(path)
+		}
+
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil || relativePath == ".." ||
+			strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		return relativePath
+	}
+
+	return filepath.Base(path)
+}
@@ -0,0 +1,110 @@
+package pathwatcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathWatcher(t *testing.T) {
+	dir := t.TempDir()
+
+	pathWatcher, err := New([]string{dir}, true, nil, []string{"**/*.tmp"})
+	require.NoError(t, err)
+	defer pathWatcher.Close()
+
+	events := watch(t, pathWatcher)
+
+	path := filepath.Join(dir, "file.txt")
+
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0600))
+	requireEvent(t, events, Event{Op: OpCreate, Path: path})
+
+	// Excluded paths are not reported
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored.tmp"), nil, 0600))
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString(", world")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	requireEvent(t, events, Event{Op: OpModify, Path: path})
+
+	require.NoError(t, os.Remove(path))
+	requireEvent(t, events, Event{Op: OpDelete, Path: path})
+}
+
+func TestPathWatcherNewDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	pathWatcher, err := New([]string{dir}, true, nil, nil)
+	require.NoError(t, err)
+	defer pathWatcher.Close()
+
+	events := watch(t, pathWatcher)
+
+	subdir := filepath.Join(dir, "subdir")
+
+	require.NoError(t, os.Mkdir(subdir, 0700))
+	requireEvent(t, events, Event{Op: OpCreate, Path: subdir, IsDir: true})
+
+	// The newly created directory is watched too
+	path := filepath.Join(subdir, "file.txt")
+
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+	requireEvent(t, events, Event{Op: OpCreate, Path: path})
+}
+
+func TestPathWatcherRecursiveFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "marker")
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+
+	pathWatcher, err := New([]string{path}, true, nil, nil)
+	require.NoError(t, err)
+	defer pathWatcher.Close()
+
+	events := watch(t, pathWatcher)
+
+	require.NoError(t, os.WriteFile(path, []byte("done"), 0600))
+	requireEvent(t, events, Event{Op: OpModify, Path: path})
+}
+
+func watch(t *testing.T, pathWatcher *PathWatcher) chan Event {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	events := make(chan Event, 100)
+
+	go func() {
+		_ = pathWatcher.Run(ctx, func(event Event) error {
+			events <- event
+
+			return nil
+		})
+	}()
+
+	return events
+}
+
+// requireEvent waits for the expected event, skipping the other
+// events, e.g. the multiple modifications for a single write
+func requireEvent(t *testing.T, events chan Event, expected Event) {
+	timeout := time.After(10 * time.Second)
+
+	for {
+		select {
+		case event := <-events:
+			require.NotEqual(t, "ignored.tmp", filepath.Base(event.Path))
+
+			if event == expected {
+				return
+			}
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for event", "%+v", expected)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package ports
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// parseLsof parses the output of "lsof -F pctn", which consists of
+// lines prefixed with a field identifier: "p" starts a new process,
+// "c" is its command name, while "t" and "n" are the type ("IPv4"
+// or "IPv6") and the name of one of its files.
+func parseLsof(r io.Reader) ([]Port, error) {
+	var ports []Port
+
+	var pid int
+	var processName string
+	var fileType string
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		value := line[1:]
+
+		switch line[0] {
+		case 'p':
+			var err error
+
+			pid, err = strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse PID %q: %w", value, err)
+			}
+
+			processName = ""
+		case 'c':
+			processName = value
+		case 't':
+			fileType = value
+		case 'n':
+			ip, port, err := parseLsofAddress(value, fileType == "IPv6")
+			if err != nil {
+				return nil, err
+			}
+
+			ports = append(ports, Port{
+				IP:          ip,
+				Port:        port,
+				PID:         pid,
+				ProcessName: processName,
+			})
+		}
+	}
+
+	return ports, scanner.Err()
+}
+
+// parseLsofAddress parses an address like "*:8080", "127.0.0.1:8080" or "[::1]:8080".
+func parseLsofAddress(s string, ipv6 bool) (net.IP, uint16, error) {
+	host, portString, err := net.SplitHostPort(s)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, 0, fmt.Errorf("malformed port in %q", s)
+	}
+
+	if host == "*" {
+		if ipv6 {
+			return net.IPv6unspecified, uint16(port), nil
+		}
+
+		return net.IPv4zero, uint16(port), nil
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, 0, fmt.Errorf("malformed IP address in %q", s)
+	}
+
+	return ip, uint16(port), nil
+}
@@ -0,0 +1,102 @@
+package ports
+
+import (
+	"cmp"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/process"
+)
+
+// Port is a TCP socket in the LISTEN state.
+type Port struct {
+	IP   net.IP
+	Port uint16
+
+	// PID and name of the process that owns the socket, zero and empty
+	// when it cannot be determined (e.g. due to insufficient privileges)
+	PID         int
+	ProcessName string
+}
+
+func (port Port) String() string {
+	return net.JoinHostPort(port.IP.String(), strconv.Itoa(int(port.Port)))
+}
+
+func (port Port) key() string {
+	return fmt.Sprintf("%s/%d", port.String(), port.PID)
+}
+
+// List returns the TCP ports that are currently listened on.
+func List() ([]Port, error) {
+	ports, err := list()
+	if err != nil {
+		return nil, err
+	}
+
+	// Name the processes the same way as the process package does, the
+	// names reported by the kernel and lsof(8) might be truncated
+	names := map[int]string{}
+
+	for i := range ports {
+		if ports[i].PID == 0 {
+			continue
+		}
+
+		name, ok := names[ports[i].PID]
+		if !ok {
+			name, _ = process.Name(ports[i].PID)
+			names[ports[i].PID] = name
+		}
+
+		if name != "" {
+			ports[i].ProcessName = name
+		}
+	}
+
+	slices.SortFunc(ports, func(a, b Port) int {
+		return cmp.Or(
+			cmp.Compare(a.Port, b.Port),
+			cmp.Compare(a.IP.String(), b.IP.String()),
+			cmp.Compare(a.PID, b.PID),
+		)
+	})
+
+	return slices.CompactFunc(ports, func(a, b Port) bool {
+		return a.key() == b.key()
+	}), nil
+}
+
+// Diff returns the ports that were opened and closed between two snapshots.
+func Diff(oldPorts []Port, newPorts []Port) ([]Port, []Port) {
+	var opened, closed []Port
+
+	oldKeys := keys(oldPorts)
+	newKeys := keys(newPorts)
+
+	for _, port := range newPorts {
+		if _, ok := oldKeys[port.key()]; !ok {
+			opened = append(opened, port)
+		}
+	}
+
+	for _, port := range oldPorts {
+		if _, ok := newKeys[port.key()]; !ok {
+			closed = append(closed, port)
+		}
+	}
+
+	return opened, closed
+}
+
+func keys(ports []Port) map[string]struct{} {
+	result := map[string]struct{}{}
+
+	for _, port := range ports {
+		result[port.key()] = struct{}{}
+	}
+
+	return result
+}
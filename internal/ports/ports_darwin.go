@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+)
+
+// list relies on lsof(8), which only sees the sockets of
+// the processes owned by the agent's user unless running as root.
+func list() ([]Port, error) {
+	cmd := exec.Command("lsof", "-nP", "-iTCP", "-sTCP:LISTEN", "-F", "pctn")
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		// lsof exits with 1 when no files match
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 && stderr.Len() == 0 {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("lsof failed: %w: %s", err, stderr.String())
+	}
+
+	return parseLsof(bytes.NewReader(output))
+}
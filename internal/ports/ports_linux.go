@@ -0,0 +1,100 @@
+package ports
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+func list() ([]Port, error) {
+	var entries []procNetEntry
+
+	for _, path := range []string{"/proc/net/tcp", "/proc/net/tcp6"} {
+		file, err := os.Open(path)
+		if err != nil {
+			// IPv6 may be disabled
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		fileEntries, err := parseProcNet(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, fileEntries...)
+	}
+
+	owners := socketOwners()
+
+	ports := make([]Port, 0, len(entries))
+
+	for _, entry := range entries {
+		port := Port{
+			IP:   entry.IP,
+			Port: entry.Port,
+		}
+
+		// The process name is resolved by List()
+		if pid, ok := owners[entry.Inode]; ok {
+			port.PID = pid
+		}
+
+		ports = append(ports, port)
+	}
+
+	return ports, nil
+}
+
+// socketOwners maps the socket inodes to the PIDs of the processes that have
+// them open by inspecting the file descriptors in /proc/<PID>/fd,
+// the processes that cannot be inspected are skipped.
+func socketOwners() map[uint64]int {
+	result := map[uint64]int{}
+
+	procEntries, err := os.ReadDir("/proc")
+	if err != nil {
+		return result
+	}
+
+	for _, procEntry := range procEntries {
+		pid, err := strconv.Atoi(procEntry.Name())
+		if err != nil {
+			continue
+		}
+
+		fdDir := filepath.Join("/proc", procEntry.Name(), "fd")
+
+		fdEntries, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+
+		for _, fdEntry := range fdEntries {
+			target, err := os.Readlink(filepath.Join(fdDir, fdEntry.Name()))
+			if err != nil {
+				continue
+			}
+
+			inodeString, ok := strings.CutPrefix(target, "socket:[")
+			if !ok {
+				continue
+			}
+
+			inode, err := strconv.ParseUint(strings.TrimSuffix(inodeString, "]"), 10, 64)
+			if err != nil {
+				continue
+			}
+
+			result[inode] = pid
+		}
+	}
+
+	return result
+}
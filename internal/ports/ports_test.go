@@ -0,0 +1,123 @@
+package ports
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcNet(t *testing.T) {
+	const procNetTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 912 1 0000000000000000 100 0 0 10 0
+   1: 00000000:0016 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 662 1 0000000000000000 100 0 0 10 0
+   2: 0100007F:1F90 0100007F:C350 01 00000000:00000000 00:00000000 00000000  1000        0 913 1 0000000000000000 20 4 30 10 -1
+`
+
+	entries, err := parseProcNet(strings.NewReader(procNetTCP))
+	require.NoError(t, err)
+	require.Equal(t, []procNetEntry{
+		{IP: net.IPv4(127, 0, 0, 1).To4(), Port: 8080, Inode: 912},
+		{IP: net.IPv4zero.To4(), Port: 22, Inode: 662},
+	}, entries)
+}
+
+func TestParseProcNetIPv6(t *testing.T) {
+	ip, port, err := parseProcNetAddress("00000000000000000000000001000000:0277")
+	require.NoError(t, err)
+	require.Equal(t, "::1", ip.String())
+	require.EqualValues(t, 631, port)
+
+	ip, _, err = parseProcNetAddress("B80D0120000000000000000001000000:0050")
+	require.NoError(t, err)
+	require.Equal(t, "2001:db8::1", ip.String())
+
+	_, _, err = parseProcNetAddress("0100007F")
+	require.Error(t, err)
+}
+
+func TestParseLsof(t *testing.T) {
+	const lsofOutput = `p312
+claunchd
+f11
+tIPv6
+n*:22
+f12
+tIPv4
+n*:22
+p5077
+cnode
+f23
+tIPv4
+n127.0.0.1:3000
+f24
+tIPv6
+n[::1]:3000
+`
+
+	ports, err := parseLsof(strings.NewReader(lsofOutput))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"[::]:22/312/launchd",
+		"0.0.0.0:22/312/launchd",
+		"127.0.0.1:3000/5077/node",
+		"[::1]:3000/5077/node",
+	}, describe(ports))
+}
+
+func TestDiff(t *testing.T) {
+	ssh := Port{IP: net.IPv4zero, Port: 22, PID: 312, ProcessName: "launchd"}
+	oldServer := Port{IP: net.IPv4(127, 0, 0, 1), Port: 3000, PID: 5077, ProcessName: "node"}
+	newServer := Port{IP: net.IPv4(127, 0, 0, 1), Port: 3000, PID: 5078, ProcessName: "node"}
+
+	opened, closed := Diff([]Port{ssh, oldServer}, []Port{ssh, newServer})
+	require.Equal(t, []Port{newServer}, opened)
+	require.Equal(t, []Port{oldServer}, closed)
+
+	opened, closed = Diff([]Port{ssh}, []Port{ssh})
+	require.Empty(t, opened)
+	require.Empty(t, closed)
+}
+
+func TestList(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	expectedPort := uint16(listener.Addr().(*net.TCPAddr).Port)
+
+	ports, err := List()
+	require.NoError(t, err)
+	require.Contains(t, describePorts(ports), expectedPort)
+
+	// The process is named the same way as in the process list
+	for _, port := range ports {
+		if port.Port == expectedPort {
+			require.Equal(t, os.Getpid(), port.PID)
+			require.Equal(t, filepath.Base(os.Args[0]), port.ProcessName)
+		}
+	}
+}
+
+func describe(ports []Port) []string {
+	var result []string
+
+	for _, port := range ports {
+		result = append(result, port.key()+"/"+port.ProcessName)
+	}
+
+	return result
+}
+
+func describePorts(ports []Port) []uint16 {
+	var result []uint16
+
+	for _, port := range ports {
+		result = append(result, port.Port)
+	}
+
+	return result
+}
@@ -0,0 +1,87 @@
+package ports
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const tcpStateListen = "0A"
+
+type procNetEntry struct {
+	IP    net.IP
+	Port  uint16
+	Inode uint64
+}
+
+// parseProcNet parses the listening sockets from /proc/net/tcp
+// or /proc/net/tcp6, see proc_net_tcp(5) for the format.
+func parseProcNet(r io.Reader) ([]procNetEntry, error) {
+	var entries []procNetEntry
+
+	scanner := bufio.NewScanner(r)
+
+	// Skip the header
+	scanner.Scan()
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		if fields[3] != tcpStateListen {
+			continue
+		}
+
+		ip, port, err := parseProcNetAddress(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse inode %q: %w", fields[9], err)
+		}
+
+		entries = append(entries, procNetEntry{
+			IP:    ip,
+			Port:  port,
+			Inode: inode,
+		})
+	}
+
+	return entries, scanner.Err()
+}
+
+// parseProcNetAddress parses an address like "0100007F:1F90", where
+// the IP address is a sequence of 32-bit words in host byte order.
+func parseProcNetAddress(s string) (net.IP, uint16, error) {
+	ipHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, 0, fmt.Errorf("malformed address %q", s)
+	}
+
+	ipBytes, err := hex.DecodeString(ipHex)
+	if err != nil || (len(ipBytes) != net.IPv4len && len(ipBytes) != net.IPv6len) {
+		return nil, 0, fmt.Errorf("malformed IP address in %q", s)
+	}
+
+	ip := make(net.IP, len(ipBytes))
+
+	for i := 0; i < len(ipBytes); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.NativeEndian.Uint32(ipBytes[i:]))
+	}
+
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return nil, 0, fmt.Errorf("malformed port in %q", s)
+	}
+
+	return ip, uint16(port), nil
+}
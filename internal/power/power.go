@@ -0,0 +1,163 @@
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultHooksDir    = "/etc/tart-guest-agent/shutdown.d"
+	DefaultHookTimeout = time.Minute
+)
+
+var ErrNoConsoleUser = errors.New("nobody is logged in on the console")
+
+type Mode int
+
+const (
+	ModePowerOff Mode = iota
+	ModeReboot
+	ModeHalt
+	ModeLogoutConsoleUser
+)
+
+func (mode Mode) String() string {
+	switch mode {
+	case ModePowerOff:
+		return "poweroff"
+	case ModeReboot:
+		return "reboot"
+	case ModeHalt:
+		return "halt"
+	case ModeLogoutConsoleUser:
+		return "logout-console-user"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(mode))
+	}
+}
+
+type Options struct {
+	Mode  Mode
+	Delay time.Duration
+
+	// Broadcasted to the logged-in users, if not empty
+	Message string
+
+	// Executables in this directory are run in lexical
+	// order with the mode as an argument before shutting
+	// down, skipped when empty
+	HooksDir    string
+	HookTimeout time.Duration
+}
+
+// shutdown waits for the delay, runs the hooks and then shuts down
+// the system or logs out the console user, unless proceed refuses
+// to, which it does when the shutdown was cancelled in the meantime.
+func shutdown(ctx context.Context, options Options, proceed func() bool) error {
+	if options.Message != "" {
+		broadcast(ctx, options.Message)
+	}
+
+	select {
+	case <-time.After(options.Delay):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if options.HooksDir != "" {
+		hookTimeout := options.HookTimeout
+		if hookTimeout == 0 {
+			hookTimeout = DefaultHookTimeout
+		}
+
+		if err := RunHooks(ctx, options.HooksDir, options.Mode, hookTimeout); err != nil {
+			return err
+		}
+	}
+
+	argv, err := command(options.Mode)
+	if err != nil {
+		return err
+	}
+
+	if !proceed() {
+		return context.Canceled
+	}
+
+	zap.S().Infof("performing %s by running %q", options.Mode, strings.Join(argv, " "))
+
+	// Not cancellable past this point
+	output, err := exec.Command(argv[0], argv[1:]...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%q failed: %w: %s", strings.Join(argv, " "), err,
+			strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// RunHooks runs the executables in the directory in lexical order,
+// the failing hooks are logged, but do not prevent the shutdown.
+func RunHooks(ctx context.Context, dir string, mode Mode, timeout time.Duration) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		if !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+
+		zap.S().Infof("running %s hook %s...", mode, path)
+
+		hookCtx, hookCancel := context.WithTimeout(ctx, timeout)
+		output, err := exec.CommandContext(hookCtx, path, mode.String()).CombinedOutput()
+		hookCancel()
+
+		if err != nil {
+			zap.S().Warnf("%s hook %s failed: %v: %s", mode, path, err,
+				strings.TrimSpace(string(output)))
+		}
+
+		// Do not proceed if the shutdown was cancelled
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func broadcast(ctx context.Context, message string) {
+	cmd := exec.CommandContext(ctx, "wall")
+	cmd.Stdin = strings.NewReader(message + "\n")
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		zap.S().Warnf("failed to broadcast the shutdown message: %v: %s", err,
+			strings.TrimSpace(string(output)))
+	}
+}
@@ -0,0 +1,37 @@
+package power
+
+import (
+	"fmt"
+	"os/user"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/sysinfo"
+)
+
+func command(mode Mode) ([]string, error) {
+	switch mode {
+	case ModePowerOff, ModeHalt:
+		// macOS has no distinct halted state, the
+		// system is powered off after halting
+		return []string{"shutdown", "-h", "now"}, nil
+	case ModeReboot:
+		return []string{"shutdown", "-r", "now"}, nil
+	case ModeLogoutConsoleUser:
+		consoleUser, err := sysinfo.ConsoleUser()
+		if err != nil {
+			return nil, err
+		}
+
+		if consoleUser == "" {
+			return nil, ErrNoConsoleUser
+		}
+
+		u, err := user.Lookup(consoleUser)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{"launchctl", "bootout", "gui/" + u.Uid}, nil
+	default:
+		return nil, fmt.Errorf("unsupported mode %s", mode)
+	}
+}
@@ -0,0 +1,31 @@
+package power
+
+import (
+	"fmt"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/sysinfo"
+)
+
+func command(mode Mode) ([]string, error) {
+	switch mode {
+	case ModePowerOff:
+		return []string{"shutdown", "--poweroff", "now"}, nil
+	case ModeReboot:
+		return []string{"shutdown", "--reboot", "now"}, nil
+	case ModeHalt:
+		return []string{"shutdown", "--halt", "now"}, nil
+	case ModeLogoutConsoleUser:
+		consoleUser, err := sysinfo.ConsoleUser()
+		if err != nil {
+			return nil, err
+		}
+
+		if consoleUser == "" {
+			return nil, ErrNoConsoleUser
+		}
+
+		return []string{"loginctl", "terminate-user", consoleUser}, nil
+	default:
+		return nil, fmt.Errorf("unsupported mode %s", mode)
+	}
+}
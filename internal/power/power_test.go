@@ -0,0 +1,62 @@
+package power
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunHooks(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(t.TempDir(), "output")
+
+	hook := "#!/bin/sh\necho \"$(basename \"$0\") $1\" >> " + output + "\n"
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-second"), []byte(hook), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-first"), []byte(hook), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "15-failing"), []byte("#!/bin/sh\nexit 1\n"), 0700))
+
+	// Neither non-executable nor hidden files are run
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "30-not-executable"), []byte(hook), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".40-hidden"), []byte(hook), 0700))
+
+	require.NoError(t, RunHooks(context.Background(), dir, ModeReboot, time.Minute))
+
+	result, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "10-first reboot\n20-second reboot\n", string(result))
+}
+
+func TestRunHooksMissingDir(t *testing.T) {
+	require.NoError(t, RunHooks(context.Background(), filepath.Join(t.TempDir(), "missing"),
+		ModePowerOff, time.Minute))
+}
+
+func TestScheduleCancel(t *testing.T) {
+	// Never shut down the machine running the tests,
+	// even if the cancellation comes too late
+	const mode = Mode(-1)
+
+	require.ErrorIs(t, Cancel(), ErrNotScheduled)
+
+	scheduledAt, err := Schedule(Options{Mode: mode, Delay: time.Hour})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Hour), scheduledAt, time.Minute)
+
+	_, err = Schedule(Options{Mode: mode})
+	require.ErrorIs(t, err, ErrAlreadyScheduled)
+
+	require.NoError(t, Cancel())
+	require.ErrorIs(t, Cancel(), ErrNotScheduled)
+
+	// The delay is at least the grace period
+	scheduledAt, err = Schedule(Options{Mode: mode})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(GracePeriod), scheduledAt, GracePeriod/2)
+
+	require.NoError(t, Cancel())
+}
@@ -0,0 +1,109 @@
+package power
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// GracePeriod is the minimum delay of the scheduled shutdowns, which
+// gives the caller the time to send the acknowledgement to the host
+// before the shutdown tears down the agent along with its connections.
+const GracePeriod = time.Second
+
+var (
+	ErrAlreadyScheduled = errors.New("shutdown is already in progress")
+	ErrNotScheduled     = errors.New("no shutdown is scheduled")
+	ErrTooLate          = errors.New("shutdown is already being performed and cannot be cancelled")
+)
+
+type scheduledShutdown struct {
+	cancel context.CancelFunc
+
+	// Set once the shutdown command is about to be run
+	performing bool
+}
+
+// The scheduled shutdown is kept at the package level, so that
+// it survives the restarts of the components that schedule it
+var (
+	scheduled *scheduledShutdown
+	mtx       sync.Mutex
+)
+
+// Schedule performs the shutdown in the background after the delay,
+// which is at least GracePeriod, and returns the time it is scheduled
+// at. Only one shutdown can be scheduled at a time, a failed shutdown
+// or a completed logout allows scheduling the next one.
+func Schedule(options Options) (time.Time, error) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if scheduled != nil {
+		return time.Time{}, ErrAlreadyScheduled
+	}
+
+	options.Delay = max(options.Delay, GracePeriod)
+	scheduledAt := time.Now().Add(options.Delay)
+
+	// Detached from the caller's context, which
+	// is usually done once the caller returns
+	ctx, cancel := context.WithCancel(context.Background())
+
+	current := &scheduledShutdown{
+		cancel: cancel,
+	}
+	scheduled = current
+
+	go func() {
+		defer cancel()
+
+		err := shutdown(ctx, options, func() bool {
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			if ctx.Err() != nil {
+				return false
+			}
+
+			current.performing = true
+
+			return true
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			zap.S().Errorf("failed to perform %s: %v", options.Mode, err)
+		}
+
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if scheduled == current {
+			scheduled = nil
+		}
+	}()
+
+	return scheduledAt, nil
+}
+
+// Cancel cancels the scheduled shutdown, which is only
+// possible until the shutdown command starts running.
+func Cancel() error {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if scheduled == nil {
+		return ErrNotScheduled
+	}
+
+	if scheduled.performing {
+		return ErrTooLate
+	}
+
+	scheduled.cancel()
+	scheduled = nil
+
+	return nil
+}
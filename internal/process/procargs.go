@@ -0,0 +1,45 @@
+package process
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// parseProcArgs2 returns the executable path and the arguments from the
+// output of the KERN_PROCARGS2 sysctl on macOS, which starts with the
+// argument count, followed by the executable path, the arguments and
+// the environment variables, all of which are NUL-terminated.
+func parseProcArgs2(buf []byte) (string, []string) {
+	if len(buf) < 4 {
+		return "", nil
+	}
+
+	argc := int(binary.LittleEndian.Uint32(buf))
+	rest := buf[4:]
+
+	end := bytes.IndexByte(rest, 0)
+	if end == -1 {
+		return "", nil
+	}
+
+	executablePath := string(rest[:end])
+
+	// Skip the executable path's padding
+	rest = bytes.TrimLeft(rest[end:], "\x00")
+
+	var args []string
+
+	for len(args) < argc && len(rest) != 0 {
+		end := bytes.IndexByte(rest, 0)
+		if end == -1 {
+			args = append(args, string(rest))
+
+			break
+		}
+
+		args = append(args, string(rest[:end]))
+		rest = rest[end+1:]
+	}
+
+	return executablePath, args
+}
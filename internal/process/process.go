@@ -0,0 +1,104 @@
+package process
+
+import (
+	"errors"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Process describes a running process.
+type Process struct {
+	PID  int
+	PPID int
+
+	UID  uint32
+	User string
+
+	Name string
+
+	// Might be empty when the agent has no permission
+	// to inspect the process or for kernel threads
+	Args []string
+
+	// E.g. "running", "sleeping", "stopped" or "zombie"
+	State string
+
+	StartTime time.Time
+	CPUTime   time.Duration
+
+	// Resident set size in bytes
+	RSS uint64
+}
+
+var ErrNotFound = errors.New("no such process")
+
+// StartTime returns the time the process was started at,
+// which tells it apart from the processes that reuse its PID.
+func StartTime(pid int) (time.Time, error) {
+	return startTime(pid)
+}
+
+// Name returns the process's name the same way as List(),
+// i.e. not truncated, unlike the one reported by the kernel.
+func Name(pid int) (string, error) {
+	return name(pid)
+}
+
+// List returns the processes running on the system, ordered by their PID.
+func List() ([]Process, error) {
+	processes, err := list()
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the user names, many processes usually share the same user
+	userNames := map[uint32]string{}
+
+	for i := range processes {
+		userName, ok := userNames[processes[i].UID]
+		if !ok {
+			userName = lookupUserName(processes[i].UID)
+			userNames[processes[i].UID] = userName
+		}
+
+		processes[i].User = userName
+	}
+
+	slices.SortFunc(processes, func(a, b Process) int {
+		return a.PID - b.PID
+	})
+
+	return processes, nil
+}
+
+// nameFromArgs returns the basename of the argv[0], which, unlike
+// the kernel's process name, is not truncated, or the fallback
+// when the arguments are not available (e.g. for kernel threads)
+// or the process has replaced its argv[0] with a title (e.g.
+// "sshd: admin@pts/0"), which is not a path.
+func nameFromArgs(args []string, fallback string) string {
+	if len(args) == 0 || args[0] == "" {
+		return fallback
+	}
+
+	if !strings.HasPrefix(args[0], "/") && strings.ContainsRune(args[0], ' ') {
+		return fallback
+	}
+
+	return filepath.Base(args[0])
+}
+
+func lookupUserName(uid uint32) string {
+	uidRaw := strconv.FormatUint(uint64(uid), 10)
+
+	u, err := user.LookupId(uidRaw)
+	if err != nil {
+		return uidRaw
+	}
+
+	return u.Username
+}
@@ -0,0 +1,124 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func list() ([]Process, error) {
+	kinfoProcs, err := unix.SysctlKinfoProcSlice("kern.proc.all")
+	if err != nil {
+		return nil, err
+	}
+
+	// CPU time and RSS are not available via sysctl(3)
+	// and would require proc_pidinfo() from libproc
+	psOutput, err := exec.Command("ps", "-ax", "-o", "pid=,rss=,time=").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run ps: %w", err)
+	}
+
+	usages, err := parsePs(psOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Process
+
+	for _, kinfoProc := range kinfoProcs {
+		pid := int(kinfoProc.Proc.P_pid)
+
+		name, args := nameAndArgs(pid, &kinfoProc)
+
+		usage := usages[pid]
+
+		result = append(result, Process{
+			PID:       pid,
+			PPID:      int(kinfoProc.Eproc.Ppid),
+			UID:       kinfoProc.Eproc.Ucred.Uid,
+			Name:      name,
+			Args:      args,
+			State:     darwinState(kinfoProc.Proc.P_stat),
+			StartTime: time.Unix(kinfoProc.Proc.P_starttime.Unix()),
+			CPUTime:   usage.CPUTime,
+			RSS:       usage.RSS,
+		})
+	}
+
+	return result, nil
+}
+
+func name(pid int) (string, error) {
+	kinfoProc, err := unix.SysctlKinfoProc("kern.proc.pid", pid)
+	if err != nil {
+		if errors.Is(unix.Kill(pid, 0), unix.ESRCH) {
+			return "", ErrNotFound
+		}
+
+		return "", err
+	}
+
+	name, _ := nameAndArgs(pid, kinfoProc)
+
+	return name, nil
+}
+
+func nameAndArgs(pid int, kinfoProc *unix.KinfoProc) (string, []string) {
+	// Truncated to MAXCOMLEN characters
+	name := unix.ByteSliceToString(kinfoProc.Proc.P_comm[:])
+
+	// Only succeeds for our own processes, unless running as root
+	var args []string
+
+	if procArgs, err := unix.SysctlRaw("kern.procargs2", pid); err == nil {
+		var executablePath string
+
+		executablePath, args = parseProcArgs2(procArgs)
+
+		if executablePath != "" {
+			name = filepath.Base(executablePath)
+		}
+	}
+
+	// Same as on Linux
+	return nameFromArgs(args, name), args
+}
+
+func startTime(pid int) (time.Time, error) {
+	kinfoProc, err := unix.SysctlKinfoProc("kern.proc.pid", pid)
+	if err != nil {
+		// The sysctl(3) succeeds with no data for
+		// the missing processes, which yields EIO
+		if errors.Is(unix.Kill(pid, 0), unix.ESRCH) {
+			return time.Time{}, ErrNotFound
+		}
+
+		return time.Time{}, err
+	}
+
+	return time.Unix(kinfoProc.Proc.P_starttime.Unix()), nil
+}
+
+// darwinState maps the process state from <sys/proc.h>.
+func darwinState(stat int8) string {
+	switch stat {
+	case 1:
+		return "idle"
+	case 2:
+		return "running"
+	case 3:
+		return "sleeping"
+	case 4:
+		return "stopped"
+	case 5:
+		return "zombie"
+	default:
+		return strconv.Itoa(int(stat))
+	}
+}
@@ -0,0 +1,137 @@
+package process
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/sysinfo"
+)
+
+func list() ([]Process, error) {
+	bootTime, err := sysinfo.BootTime()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := uint64(os.Getpagesize())
+
+	var result []Process
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		process, err := read(pid, bootTime, pageSize)
+		if err != nil {
+			// The process might have exited in the meantime
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		result = append(result, *process)
+	}
+
+	return result, nil
+}
+
+func startTime(pid int) (time.Time, error) {
+	bootTime, err := sysinfo.BootTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	statRaw, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return time.Time{}, ErrNotFound
+		}
+
+		return time.Time{}, err
+	}
+
+	stat, err := parseProcStat(statRaw)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return bootTime.Add(time.Duration(stat.StartTime) * time.Second / userHZ), nil
+}
+
+func name(pid int) (string, error) {
+	dir := filepath.Join("/proc", strconv.Itoa(pid))
+
+	statRaw, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrNotFound
+		}
+
+		return "", err
+	}
+
+	stat, err := parseProcStat(statRaw)
+	if err != nil {
+		return "", err
+	}
+
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return "", err
+	}
+
+	return nameFromArgs(parseNULSeparated(cmdline), stat.Name), nil
+}
+
+func read(pid int, bootTime time.Time, pageSize uint64) (*Process, error) {
+	dir := filepath.Join("/proc", strconv.Itoa(pid))
+
+	statRaw, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := parseProcStat(statRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	statusRaw, err := os.ReadFile(filepath.Join(dir, "status"))
+	if err != nil {
+		return nil, err
+	}
+
+	uid, _ := parseProcStatusUID(statusRaw)
+
+	// Empty for kernel threads
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return nil, err
+	}
+
+	args := parseNULSeparated(cmdline)
+
+	return &Process{
+		PID:       pid,
+		PPID:      stat.PPID,
+		UID:       uid,
+		Name:      nameFromArgs(args, stat.Name),
+		Args:      args,
+		State:     stat.State,
+		StartTime: bootTime.Add(time.Duration(stat.StartTime) * time.Second / userHZ),
+		CPUTime:   time.Duration(stat.UTime+stat.STime) * time.Second / userHZ,
+		RSS:       stat.RSS * pageSize,
+	}, nil
+}
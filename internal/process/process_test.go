@@ -0,0 +1,125 @@
+package process
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcStat(t *testing.T) {
+	stat, err := parseProcStat([]byte("1234 (tmux: server (1)) S 1 1234 1234 0 -1 4194624 " +
+		"1436 0 0 0 250 130 0 0 20 0 1 0 5000 10567680 1024 18446744073709551615 " +
+		"1 1 0 0 0 0 0 3674112 134366727 0 0 0 17 3 0 0 0 0 0 0 0 0 0 0 0 0 0\n"))
+	require.NoError(t, err)
+	require.Equal(t, &procStat{
+		Name:      "tmux: server (1)",
+		State:     "sleeping",
+		PPID:      1,
+		UTime:     250,
+		STime:     130,
+		StartTime: 5000,
+		RSS:       1024,
+	}, stat)
+
+	_, err = parseProcStat([]byte("1234 (truncated) S 1"))
+	require.Error(t, err)
+}
+
+func TestParseProcStatusUID(t *testing.T) {
+	uid, ok := parseProcStatusUID([]byte("Name:\tsudo\nUid:\t1000\t0\t0\t0\nGid:\t1000\t1000\t1000\t1000\n"))
+	require.True(t, ok)
+	require.EqualValues(t, 0, uid)
+}
+
+func TestParseNULSeparated(t *testing.T) {
+	require.Equal(t, []string{"sleep", "", "10"}, parseNULSeparated([]byte("sleep\x00\x0010\x00")))
+	require.Empty(t, parseNULSeparated(nil))
+}
+
+func TestParsePs(t *testing.T) {
+	usages, err := parsePs([]byte(`    1  14416   0:12.34
+  345   2048 1-02:03:04
+`))
+	require.NoError(t, err)
+	require.Equal(t, map[int]psUsage{
+		1: {
+			CPUTime: 12*time.Second + 340*time.Millisecond,
+			RSS:     14416 * 1024,
+		},
+		345: {
+			CPUTime: 26*time.Hour + 3*time.Minute + 4*time.Second,
+			RSS:     2048 * 1024,
+		},
+	}, usages)
+}
+
+func TestParseProcArgs2(t *testing.T) {
+	executablePath, args := parseProcArgs2([]byte("\x02\x00\x00\x00/bin/sleep\x00\x00\x00\x00" +
+		"sleep\x0010\x00HOME=/Users/admin\x00"))
+	require.Equal(t, "/bin/sleep", executablePath)
+	require.Equal(t, []string{"sleep", "10"}, args)
+
+	executablePath, args = parseProcArgs2([]byte("\x02\x00"))
+	require.Empty(t, executablePath)
+	require.Empty(t, args)
+}
+
+func TestNameFromArgs(t *testing.T) {
+	require.Equal(t, "systemd-networkd", nameFromArgs([]string{"/usr/lib/systemd/systemd-networkd"}, "systemd-network"))
+	require.Equal(t, "Google Chrome", nameFromArgs([]string{
+		"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome"}, "Google Chrome"))
+	require.Equal(t, "-bash", nameFromArgs([]string{"-bash"}, "bash"))
+	require.Equal(t, "sshd", nameFromArgs([]string{"sshd: admin@pts/0"}, "sshd"))
+	require.Equal(t, "kthreadd", nameFromArgs(nil, "kthreadd"))
+}
+
+func TestStartTime(t *testing.T) {
+	processes, err := List()
+	require.NoError(t, err)
+
+	for _, process := range processes {
+		if process.PID != os.Getpid() {
+			continue
+		}
+
+		startTime, err := StartTime(process.PID)
+		require.NoError(t, err)
+		require.Equal(t, process.StartTime, startTime)
+	}
+
+	_, err = StartTime(1 << 30)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestName(t *testing.T) {
+	name, err := Name(os.Getpid())
+	require.NoError(t, err)
+	require.Equal(t, filepath.Base(os.Args[0]), name)
+
+	_, err = Name(1 << 30)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestList(t *testing.T) {
+	processes, err := List()
+	require.NoError(t, err)
+
+	var self *Process
+
+	for i := range processes {
+		if processes[i].PID == os.Getpid() {
+			self = &processes[i]
+		}
+	}
+
+	require.NotNil(t, self)
+	require.Equal(t, os.Getppid(), self.PPID)
+	require.NotEmpty(t, self.User)
+	require.NotEmpty(t, self.Args)
+	require.Equal(t, filepath.Base(os.Args[0]), self.Name)
+	require.NotZero(t, self.RSS)
+	require.WithinDuration(t, time.Now(), self.StartTime, time.Minute)
+}
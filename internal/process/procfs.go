@@ -0,0 +1,136 @@
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// userHZ is the unit of the times reported in /proc/[pid]/stat,
+// which is 100 on all of the architectures supported by Linux.
+const userHZ = 100
+
+type procStat struct {
+	Name  string
+	State string
+	PPID  int
+
+	// In the units of userHZ
+	UTime     uint64
+	STime     uint64
+	StartTime uint64
+
+	// In pages
+	RSS uint64
+}
+
+// parseProcStat parses the contents of /proc/[pid]/stat, see proc_pid_stat(5).
+func parseProcStat(content []byte) (*procStat, error) {
+	// The process name is enclosed in parentheses and can
+	// contain both spaces and parentheses on its own
+	start := bytes.IndexByte(content, '(')
+	end := bytes.LastIndexByte(content, ')')
+
+	if start == -1 || end == -1 || end < start {
+		return nil, fmt.Errorf("failed to locate the process name in %q", content)
+	}
+
+	fields := strings.Fields(string(content[end+1:]))
+
+	// Fields starting from the "state", which is the 3rd field
+	const (
+		stateField     = 3 - 3
+		ppidField      = 4 - 3
+		utimeField     = 14 - 3
+		stimeField     = 15 - 3
+		starttimeField = 22 - 3
+		rssField       = 24 - 3
+	)
+
+	if len(fields) <= rssField {
+		return nil, fmt.Errorf("expected at least %d fields after the process name, got %d",
+			rssField+1, len(fields))
+	}
+
+	ppid, err := strconv.Atoi(fields[ppidField])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PPID: %w", err)
+	}
+
+	var numbers [4]uint64
+
+	for i, field := range []int{utimeField, stimeField, starttimeField, rssField} {
+		numbers[i], err = strconv.ParseUint(fields[field], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse field %d: %w", field+3, err)
+		}
+	}
+
+	return &procStat{
+		Name:      string(content[start+1 : end]),
+		State:     linuxState(fields[stateField]),
+		PPID:      ppid,
+		UTime:     numbers[0],
+		STime:     numbers[1],
+		StartTime: numbers[2],
+		RSS:       numbers[3],
+	}, nil
+}
+
+func linuxState(state string) string {
+	switch state {
+	case "R":
+		return "running"
+	case "S":
+		return "sleeping"
+	case "D":
+		return "disk-sleep"
+	case "T", "t":
+		return "stopped"
+	case "Z":
+		return "zombie"
+	case "I":
+		return "idle"
+	case "X", "x":
+		return "dead"
+	default:
+		return state
+	}
+}
+
+// parseProcStatusUID returns the effective UID from
+// the contents of /proc/[pid]/status, see proc_pid_status(5).
+func parseProcStatusUID(content []byte) (uint32, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		// "Uid:" real, effective, saved set and filesystem UIDs
+		if len(fields) < 3 || fields[0] != "Uid:" {
+			continue
+		}
+
+		uid, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return 0, false
+		}
+
+		return uint32(uid), true
+	}
+
+	return 0, false
+}
+
+// parseNULSeparated parses NUL-separated strings,
+// e.g. the contents of /proc/[pid]/cmdline.
+func parseNULSeparated(content []byte) []string {
+	content = bytes.TrimRight(content, "\x00")
+	if len(content) == 0 {
+		return nil
+	}
+
+	return strings.Split(string(content), "\x00")
+}
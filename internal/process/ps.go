@@ -0,0 +1,104 @@
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type psUsage struct {
+	CPUTime time.Duration
+	RSS     uint64
+}
+
+// parsePs parses the output of "ps -ax -o pid=,rss=,time=".
+func parsePs(output []byte) (map[int]psUsage, error) {
+	result := map[int]psUsage{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("expected 3 fields in ps output line, got %d", len(fields))
+		}
+
+		pid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PID: %w", err)
+		}
+
+		// Kibibytes
+		rss, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSS: %w", err)
+		}
+
+		cpuTime, err := parsePsTime(fields[2])
+		if err != nil {
+			return nil, err
+		}
+
+		result[pid] = psUsage{
+			CPUTime: cpuTime,
+			RSS:     rss * 1024,
+		}
+	}
+
+	return result, nil
+}
+
+// parsePsTime parses the "[dd-][hh:]mm:ss[.ss]" time format used by ps(1).
+func parsePsTime(s string) (time.Duration, error) {
+	var result time.Duration
+
+	rest := s
+
+	if daysRaw, after, ok := strings.Cut(rest, "-"); ok {
+		days, err := strconv.ParseUint(daysRaw, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse time %q: %w", s, err)
+		}
+
+		result += time.Duration(days) * 24 * time.Hour
+		rest = after
+	}
+
+	parts := strings.Split(rest, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("failed to parse time %q: unexpected format", s)
+	}
+
+	var sum time.Duration
+
+	for i, part := range parts {
+		sum *= 60
+
+		if i == len(parts)-1 {
+			seconds, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return 0, fmt.Errorf("failed to parse time %q: %w", s, err)
+			}
+
+			sum += time.Duration(seconds * float64(time.Second))
+
+			continue
+		}
+
+		value, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse time %q: %w", s, err)
+		}
+
+		sum += time.Duration(value) * time.Second
+	}
+
+	return result + sum, nil
+}
@@ -0,0 +1,37 @@
+package proxy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+)
+
+func httpHandshake(r *bufio.Reader, w io.Writer) (string, error) {
+	request, err := http.ReadRequest(r)
+	if err != nil {
+		return "", err
+	}
+
+	if request.Method != http.MethodConnect {
+		_ = httpReply(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+
+		return "", fmt.Errorf("%w: HTTP method %s", ErrUnsupported, request.Method)
+	}
+
+	// Note that the port is mandatory for CONNECT requests
+	if _, _, err := net.SplitHostPort(request.Host); err != nil {
+		_ = httpReply(w, http.StatusBadRequest, "Bad Request")
+
+		return "", fmt.Errorf("invalid HTTP CONNECT target %q: %w", request.Host, err)
+	}
+
+	return request.Host, nil
+}
+
+func httpReply(w io.Writer, code int, reason string) error {
+	_, err := fmt.Fprintf(w, "HTTP/1.1 %d %s\r\n\r\n", code, reason)
+
+	return err
+}
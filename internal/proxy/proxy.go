@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Protocol is the proxy protocol spoken by the client.
+type Protocol int
+
+const (
+	ProtocolSOCKS5 Protocol = iota + 1
+	ProtocolHTTPConnect
+)
+
+func (protocol Protocol) String() string {
+	switch protocol {
+	case ProtocolSOCKS5:
+		return "SOCKS5"
+	case ProtocolHTTPConnect:
+		return "HTTP CONNECT"
+	default:
+		return fmt.Sprintf("Protocol(%d)", int(protocol))
+	}
+}
+
+// RejectReason tells the client why the connection to the target
+// could not be established.
+type RejectReason int
+
+const (
+	RejectReasonNotAllowed RejectReason = iota + 1
+	RejectReasonHostUnreachable
+	RejectReasonConnectionRefused
+)
+
+const handshakeTimeout = 30 * time.Second
+
+var ErrUnsupported = errors.New("unsupported proxy request")
+
+// Conn is a client connection for which the proxy handshake has been
+// performed, but which is yet to be either accepted or rejected.
+type Conn struct {
+	net.Conn
+
+	Protocol Protocol
+
+	// Host and port the client wants to connect to
+	Target string
+
+	reader *bufio.Reader
+}
+
+// Handshake reads the proxy request from the client, speaking SOCKS5
+// if the client's first byte is the SOCKS version 5, and HTTP CONNECT
+// otherwise. The connection is closed if the handshake fails.
+func Handshake(conn net.Conn) (*Conn, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	proxyConn := &Conn{
+		Conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+
+	firstByte, err := proxyConn.reader.Peek(1)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if firstByte[0] == socksVersion5 {
+		proxyConn.Protocol = ProtocolSOCKS5
+		proxyConn.Target, err = socksHandshake(proxyConn.reader, conn)
+	} else {
+		proxyConn.Protocol = ProtocolHTTPConnect
+		proxyConn.Target, err = httpHandshake(proxyConn.reader, conn)
+	}
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return proxyConn, nil
+}
+
+// Accept tells the client that the connection to the target has been
+// established, after which the data can be relayed.
+func (conn *Conn) Accept() error {
+	switch conn.Protocol {
+	case ProtocolSOCKS5:
+		return socksReply(conn.Conn, socksReplySucceeded)
+	default:
+		return httpReply(conn.Conn, 200, "Connection established")
+	}
+}
+
+// Reject tells the client that the connection to the target could
+// not be established and closes the connection.
+func (conn *Conn) Reject(reason RejectReason) error {
+	var err error
+
+	switch conn.Protocol {
+	case ProtocolSOCKS5:
+		err = socksReply(conn.Conn, socksReplyFromRejectReason(reason))
+	default:
+		if reason == RejectReasonNotAllowed {
+			err = httpReply(conn.Conn, 403, "Forbidden")
+		} else {
+			err = httpReply(conn.Conn, 502, "Bad Gateway")
+		}
+	}
+
+	return errors.Join(err, conn.Conn.Close())
+}
+
+// Read takes into account the data that the client
+// has sent right after the handshake.
+func (conn *Conn) Read(b []byte) (int, error) {
+	return conn.reader.Read(b)
+}
+
+func (conn *Conn) CloseWrite() error {
+	closer, ok := conn.Conn.(interface{ CloseWrite() error })
+	if !ok {
+		return nil
+	}
+
+	return closer.CloseWrite()
+}
@@ -0,0 +1,195 @@
+package proxy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	netproxy "golang.org/x/net/proxy"
+)
+
+func TestSOCKS5(t *testing.T) {
+	allowedTarget := startTargetServer(t)
+	forbiddenTarget := startTargetServer(t)
+	proxyAddress := startProxy(t, allowedTarget)
+
+	dialer, err := netproxy.SOCKS5("tcp", proxyAddress, nil, netproxy.Direct)
+	require.NoError(t, err)
+
+	conn, err := dialer.Dial("tcp", allowedTarget)
+	require.NoError(t, err)
+	requireRoundTrip(t, conn)
+
+	_, err = dialer.Dial("tcp", forbiddenTarget)
+	require.ErrorContains(t, err, "connection not allowed by ruleset")
+}
+
+func TestSOCKS5DomainName(t *testing.T) {
+	target := startTargetServer(t)
+	_, port, err := net.SplitHostPort(target)
+	require.NoError(t, err)
+
+	proxyAddress := startProxy(t, net.JoinHostPort("localhost", port))
+
+	dialer, err := netproxy.SOCKS5("tcp", proxyAddress, nil, netproxy.Direct)
+	require.NoError(t, err)
+
+	conn, err := dialer.Dial("tcp", net.JoinHostPort("localhost", port))
+	require.NoError(t, err)
+	requireRoundTrip(t, conn)
+}
+
+func TestHTTPConnect(t *testing.T) {
+	allowedTarget := startTargetServer(t)
+	forbiddenTarget := startTargetServer(t)
+	proxyAddress := startProxy(t, allowedTarget)
+
+	conn, response := httpConnect(t, proxyAddress, allowedTarget)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	requireRoundTrip(t, conn)
+
+	_, response = httpConnect(t, proxyAddress, forbiddenTarget)
+	require.Equal(t, http.StatusForbidden, response.StatusCode)
+}
+
+func TestHTTPNonConnect(t *testing.T) {
+	proxyAddress := startProxy(t)
+
+	response, err := http.Get("http://" + proxyAddress + "/")
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	require.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+}
+
+// startProxy runs a proxy that plays the role of both the agent and
+// the host: the targets from the allowlist are dialed directly,
+// while the rest of the connections are rejected.
+func startProxy(t *testing.T, allowlist ...string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				proxyConn, err := Handshake(conn)
+				if err != nil {
+					return
+				}
+
+				if !slices.Contains(allowlist, proxyConn.Target) {
+					_ = proxyConn.Reject(RejectReasonNotAllowed)
+
+					return
+				}
+
+				targetConn, err := net.Dial("tcp", proxyConn.Target)
+				if err != nil {
+					_ = proxyConn.Reject(RejectReasonConnectionRefused)
+
+					return
+				}
+				defer targetConn.Close()
+				defer proxyConn.Close()
+
+				if err := proxyConn.Accept(); err != nil {
+					return
+				}
+
+				go func() {
+					_, _ = io.Copy(targetConn, proxyConn)
+				}()
+
+				_, _ = io.Copy(proxyConn, targetConn)
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// startTargetServer runs a server that greets each client
+// with its own address and then echoes the client's data.
+func startTargetServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				_, _ = fmt.Fprintf(conn, "hello from %s\n", listener.Addr().String())
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func httpConnect(t *testing.T, proxyAddress string, target string) (net.Conn, *http.Response) {
+	conn, err := net.Dial("tcp", proxyAddress)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	_, err = fmt.Fprintf(conn, "CONNECT %s HTTP/1.1\r\nHost: %s\r\n\r\n", target, target)
+	require.NoError(t, err)
+
+	// Read the response byte by byte to avoid consuming the tunneled data
+	response, err := http.ReadResponse(bufio.NewReaderSize(&byteReader{conn}, 16), nil)
+	require.NoError(t, err)
+
+	return conn, response
+}
+
+func requireRoundTrip(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+
+	greeting, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Contains(t, greeting, "hello from")
+
+	_, err = conn.Write([]byte("ping\n"))
+	require.NoError(t, err)
+
+	echo, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", echo)
+}
+
+type byteReader struct {
+	r io.Reader
+}
+
+func (r *byteReader) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	return r.r.Read(b[:1])
+}
@@ -0,0 +1,153 @@
+package proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// See RFC 1928
+const (
+	socksVersion5 = 0x05
+
+	socksMethodNoAuthentication = 0x00
+	socksMethodNoAcceptable     = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressTypeIPv4       = 0x01
+	socksAddressTypeDomainName = 0x03
+	socksAddressTypeIPv6       = 0x04
+
+	socksReplySucceeded              = 0x00
+	socksReplyGeneralFailure         = 0x01
+	socksReplyNotAllowed             = 0x02
+	socksReplyHostUnreachable        = 0x04
+	socksReplyConnectionRefused      = 0x05
+	socksReplyCommandNotSupported    = 0x07
+	socksReplyAddressTypeUnsupported = 0x08
+)
+
+func socksHandshake(r io.Reader, w io.Writer) (string, error) {
+	// Method selection
+	var greeting [2]byte
+
+	if _, err := io.ReadFull(r, greeting[:]); err != nil {
+		return "", err
+	}
+
+	methods := make([]byte, greeting[1])
+
+	if _, err := io.ReadFull(r, methods); err != nil {
+		return "", err
+	}
+
+	var noAuthentication bool
+
+	for _, method := range methods {
+		if method == socksMethodNoAuthentication {
+			noAuthentication = true
+		}
+	}
+
+	if !noAuthentication {
+		_, _ = w.Write([]byte{socksVersion5, socksMethodNoAcceptable})
+
+		return "", fmt.Errorf("%w: SOCKS5 client requires authentication", ErrUnsupported)
+	}
+
+	if _, err := w.Write([]byte{socksVersion5, socksMethodNoAuthentication}); err != nil {
+		return "", err
+	}
+
+	// Request
+	var header [4]byte
+
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return "", err
+	}
+
+	if header[0] != socksVersion5 {
+		return "", fmt.Errorf("unexpected SOCKS version %d", header[0])
+	}
+
+	var host string
+
+	switch header[3] {
+	case socksAddressTypeIPv4:
+		ip := make(net.IP, net.IPv4len)
+
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", err
+		}
+
+		host = ip.String()
+	case socksAddressTypeIPv6:
+		ip := make(net.IP, net.IPv6len)
+
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", err
+		}
+
+		host = ip.String()
+	case socksAddressTypeDomainName:
+		var length [1]byte
+
+		if _, err := io.ReadFull(r, length[:]); err != nil {
+			return "", err
+		}
+
+		domainName := make([]byte, length[0])
+
+		if _, err := io.ReadFull(r, domainName); err != nil {
+			return "", err
+		}
+
+		host = string(domainName)
+	default:
+		_ = socksReply(w, socksReplyAddressTypeUnsupported)
+
+		return "", fmt.Errorf("%w: SOCKS5 address type %d", ErrUnsupported, header[3])
+	}
+
+	var port [2]byte
+
+	if _, err := io.ReadFull(r, port[:]); err != nil {
+		return "", err
+	}
+
+	if header[1] != socksCommandConnect {
+		_ = socksReply(w, socksReplyCommandNotSupported)
+
+		return "", fmt.Errorf("%w: SOCKS5 command %d", ErrUnsupported, header[1])
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port[:])))), nil
+}
+
+func socksReply(w io.Writer, reply byte) error {
+	// The bound address is of no use to the client
+	// since the connection is established by the host
+	_, err := w.Write([]byte{
+		socksVersion5, reply, 0x00,
+		socksAddressTypeIPv4, 0, 0, 0, 0,
+		0, 0,
+	})
+
+	return err
+}
+
+func socksReplyFromRejectReason(reason RejectReason) byte {
+	switch reason {
+	case RejectReasonNotAllowed:
+		return socksReplyNotAllowed
+	case RejectReasonHostUnreachable:
+		return socksReplyHostUnreachable
+	case RejectReasonConnectionRefused:
+		return socksReplyConnectionRefused
+	default:
+		return socksReplyGeneralFailure
+	}
+}
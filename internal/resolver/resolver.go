@@ -0,0 +1,338 @@
+package resolver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const resolvConfPath = "/etc/resolv.conf"
+
+var (
+	ErrUnsupported = errors.New("not supported on this system")
+	ErrNotFound    = errors.New("no resolver configuration for this domain")
+)
+
+var (
+	domainRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+
+	// resolv.conf(5) options are either flags (e.g. "rotate")
+	// or take a numeric argument (e.g. "ndots:2")
+	optionRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+(:[0-9]+)?$`)
+)
+
+// Config is the system-wide resolver configuration.
+type Config struct {
+	Nameservers   []netip.Addr
+	SearchDomains []string
+
+	// resolv.conf(5) options (e.g. "ndots:2")
+	Options []string
+}
+
+func (config Config) Validate() error {
+	for _, nameserver := range config.Nameservers {
+		if !nameserver.IsValid() {
+			return fmt.Errorf("invalid nameserver")
+		}
+	}
+
+	for _, domain := range config.SearchDomains {
+		if !domainRegexp.MatchString(strings.TrimSuffix(domain, ".")) {
+			return fmt.Errorf("invalid search domain %q", domain)
+		}
+	}
+
+	for _, option := range config.Options {
+		if !optionRegexp.MatchString(option) {
+			return fmt.Errorf("invalid option %q", option)
+		}
+	}
+
+	return nil
+}
+
+// ScopedConfig is the resolver configuration that only applies to the
+// queries for the names within a domain, see resolver(5) on macOS.
+type ScopedConfig struct {
+	Domain      string
+	Nameservers []netip.Addr
+
+	// Zero for the default port
+	Port uint16
+}
+
+func (config ScopedConfig) Validate() error {
+	if !domainRegexp.MatchString(config.Domain) {
+		return fmt.Errorf("invalid domain %q", config.Domain)
+	}
+
+	if len(config.Nameservers) == 0 {
+		return fmt.Errorf("no nameservers specified for domain %s", config.Domain)
+	}
+
+	return nil
+}
+
+// Global returns the system-wide resolver configuration, which is read
+// through the same mechanism as SetGlobal() uses to configure it, so
+// that the configuration can be read, modified and written back.
+func Global(ctx context.Context) (*Config, error) {
+	return global(ctx)
+}
+
+// SetGlobal replaces the system-wide resolver configuration.
+func SetGlobal(ctx context.Context, config Config) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	return setGlobal(ctx, config)
+}
+
+// Scoped returns the domain-specific resolver configurations.
+func Scoped() ([]ScopedConfig, error) {
+	return scoped()
+}
+
+// SetScoped creates or replaces the resolver configuration for a domain.
+func SetScoped(config ScopedConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	return setScoped(config)
+}
+
+// RemoveScoped removes the resolver configuration for a domain.
+func RemoveScoped(domain string) error {
+	if !domainRegexp.MatchString(domain) {
+		return fmt.Errorf("invalid domain %q", domain)
+	}
+
+	return removeScoped(domain)
+}
+
+// writeFile atomically replaces the file's contents by writing a temporary
+// file next to it and renaming it over, so that the resolver never reads
+// a partially written file. Symbolic links are followed, so that the file
+// they point to is replaced instead of the links themselves.
+func writeFile(path string, content []byte, perm os.FileMode) error {
+	if resolvedPath, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolvedPath
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Chmod(perm); err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func readResolvConf(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseResolvConf(content), nil
+}
+
+func parseResolvConf(content []byte) *Config {
+	config := &Config{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "nameserver":
+			// Scoped addresses (e.g. "fe80::1%en0") are
+			// parsed too, unlike with net.ParseIP()
+			if addr, err := netip.ParseAddr(fields[1]); err == nil {
+				config.Nameservers = append(config.Nameservers, addr)
+			}
+		case "search", "domain":
+			// The last of the "search" and "domain" lines wins
+			config.SearchDomains = fields[1:]
+		case "options":
+			config.Options = append(config.Options, fields[1:]...)
+		}
+	}
+
+	return config
+}
+
+func renderResolvConf(config Config) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("# Generated by tart-guest-agent\n")
+
+	for _, nameserver := range config.Nameservers {
+		fmt.Fprintf(&buf, "nameserver %s\n", nameserver)
+	}
+
+	if len(config.SearchDomains) != 0 {
+		fmt.Fprintf(&buf, "search %s\n", strings.Join(config.SearchDomains, " "))
+	}
+
+	if len(config.Options) != 0 {
+		fmt.Fprintf(&buf, "options %s\n", strings.Join(config.Options, " "))
+	}
+
+	return buf.Bytes()
+}
+
+// parseResolvedDropIn parses the [Resolve] section of a resolved.conf(5)
+// drop-in, where the DNS= and Domains= settings are space-separated lists.
+func parseResolvedDropIn(content []byte) *Config {
+	config := &Config{}
+
+	var section string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			section = strings.Trim(line, "[]")
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || section != "Resolve" {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "DNS":
+			for _, field := range strings.Fields(value) {
+				if addr, err := netip.ParseAddr(field); err == nil {
+					config.Nameservers = append(config.Nameservers, addr)
+				}
+			}
+		case "Domains":
+			config.SearchDomains = strings.Fields(value)
+		}
+	}
+
+	return config
+}
+
+func renderResolvedDropIn(config Config) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("# Generated by tart-guest-agent\n")
+	buf.WriteString("[Resolve]\n")
+
+	var nameservers []string
+
+	for _, nameserver := range config.Nameservers {
+		nameservers = append(nameservers, nameserver.String())
+	}
+
+	fmt.Fprintf(&buf, "DNS=%s\n", strings.Join(nameservers, " "))
+	fmt.Fprintf(&buf, "Domains=%s\n", strings.Join(config.SearchDomains, " "))
+
+	return buf.Bytes()
+}
+
+// parseNetworksetupList parses the output of "networksetup -getdnsservers"
+// and "networksetup -getsearchdomains", which list a value per line, or
+// explain that there are none, e.g. "There aren't any DNS Servers set on
+// Ethernet." or "There aren't any Search Domains set on Ethernet.".
+func parseNetworksetupList(output string) []string {
+	var result []string
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "There aren't any") {
+			continue
+		}
+
+		result = append(result, line)
+	}
+
+	return result
+}
+
+func parseResolverFile(domain string, content []byte) ScopedConfig {
+	config := ScopedConfig{
+		Domain: domain,
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "nameserver":
+			if addr, err := netip.ParseAddr(fields[1]); err == nil {
+				config.Nameservers = append(config.Nameservers, addr)
+			}
+		case "port":
+			if port, err := strconv.ParseUint(fields[1], 10, 16); err == nil {
+				config.Port = uint16(port)
+			}
+		}
+	}
+
+	return config
+}
+
+func renderResolverFile(config ScopedConfig) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("# Generated by tart-guest-agent\n")
+
+	for _, nameserver := range config.Nameservers {
+		fmt.Fprintf(&buf, "nameserver %s\n", nameserver)
+	}
+
+	if config.Port != 0 {
+		fmt.Fprintf(&buf, "port %d\n", config.Port)
+	}
+
+	return buf.Bytes()
+}
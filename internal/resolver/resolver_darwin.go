@@ -0,0 +1,183 @@
+package resolver
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const resolverDir = "/etc/resolver"
+
+func global(ctx context.Context) (*Config, error) {
+	services, err := networkServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// setGlobal() configures all of the network services the same way
+	if len(services) != 0 {
+		config := &Config{}
+
+		output, err := networksetup(ctx, "-getdnsservers", services[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nameserver := range parseNetworksetupList(output) {
+			if addr, err := netip.ParseAddr(nameserver); err == nil {
+				config.Nameservers = append(config.Nameservers, addr)
+			}
+		}
+
+		output, err = networksetup(ctx, "-getsearchdomains", services[0])
+		if err != nil {
+			return nil, err
+		}
+
+		config.SearchDomains = parseNetworksetupList(output)
+
+		if len(config.Nameservers) != 0 || len(config.SearchDomains) != 0 {
+			return config, nil
+		}
+	}
+
+	// Nothing is configured manually, so report the configuration obtained
+	// via DHCP, which the informational resolv.conf(5) reflects
+	config, err := readResolvConf(resolvConfPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The options cannot be set on macOS
+	config.Options = nil
+
+	return config, nil
+}
+
+func setGlobal(ctx context.Context, config Config) error {
+	if len(config.Options) != 0 {
+		return fmt.Errorf("%w: resolver options cannot be set on macOS", ErrUnsupported)
+	}
+
+	services, err := networkServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	nameservers := []string{"empty"}
+	if len(config.Nameservers) != 0 {
+		nameservers = nameservers[:0]
+
+		for _, nameserver := range config.Nameservers {
+			nameservers = append(nameservers, nameserver.String())
+		}
+	}
+
+	searchDomains := []string{"empty"}
+	if len(config.SearchDomains) != 0 {
+		searchDomains = config.SearchDomains
+	}
+
+	// The resolv.conf(5) file is only informational on macOS,
+	// so configure each of the network services instead
+	for _, service := range services {
+		if _, err := networksetup(ctx, append([]string{"-setdnsservers", service}, nameservers...)...); err != nil {
+			return err
+		}
+
+		if _, err := networksetup(ctx, append([]string{"-setsearchdomains", service}, searchDomains...)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func networkServices(ctx context.Context) ([]string, error) {
+	output, err := networksetup(ctx, "-listallnetworkservices")
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	// Skip the "An asterisk (*) denotes that a network service is disabled." line
+	scanner.Scan()
+
+	for scanner.Scan() {
+		service := scanner.Text()
+		if service == "" || strings.HasPrefix(service, "*") {
+			continue
+		}
+
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+func networksetup(ctx context.Context, args ...string) (string, error) {
+	output, err := exec.CommandContext(ctx, "networksetup", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("\"networksetup %s\" failed: %w: %s", strings.Join(args, " "), err,
+			strings.TrimSpace(string(output)))
+	}
+
+	return string(output), nil
+}
+
+func scoped() ([]ScopedConfig, error) {
+	entries, err := os.ReadDir(resolverDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var result []ScopedConfig
+
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(resolverDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, parseResolverFile(entry.Name(), content))
+	}
+
+	return result, nil
+}
+
+func setScoped(config ScopedConfig) error {
+	if err := os.MkdirAll(resolverDir, 0755); err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(resolverDir, config.Domain), renderResolverFile(config), 0644)
+}
+
+func removeScoped(domain string) error {
+	if err := os.Remove(filepath.Join(resolverDir, domain)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	resolvedDropInPath = "/etc/systemd/resolved.conf.d/90-tart-guest-agent.conf"
+
+	// resolvedUplinkPath lists the upstream servers known to
+	// systemd-resolved, unlike the stub resolv.conf, which
+	// only points to the resolver listening on 127.0.0.53
+	resolvedUplinkPath = "/run/systemd/resolve/resolv.conf"
+)
+
+var errScopedUnsupported = fmt.Errorf("%w: domain-specific resolver configuration is only available on macOS",
+	ErrUnsupported)
+
+func global(_ context.Context) (*Config, error) {
+	if !managedByResolved() {
+		return readResolvConf(resolvConfPath)
+	}
+
+	// Prefer the configuration previously set via SetGlobal()
+	content, err := os.ReadFile(resolvedDropInPath)
+	if err == nil {
+		return parseResolvedDropIn(content), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	config, err := readResolvConf(resolvedUplinkPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		if config, err = readResolvConf(resolvConfPath); err != nil {
+			return nil, err
+		}
+	}
+
+	// The options are generated by systemd-resolved
+	// and cannot be set when using it
+	config.Options = nil
+
+	return config, nil
+}
+
+func setGlobal(ctx context.Context, config Config) error {
+	if !managedByResolved() {
+		// Write through the symbolic link, if any
+		return writeFile(resolvConfPath, renderResolvConf(config), 0644)
+	}
+
+	if len(config.Options) != 0 {
+		return fmt.Errorf("%w: resolver options cannot be set when using systemd-resolved", ErrUnsupported)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(resolvedDropInPath), 0755); err != nil {
+		return err
+	}
+
+	if err := writeFile(resolvedDropInPath, renderResolvedDropIn(config), 0644); err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "systemctl", "restart", "systemd-resolved")
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to restart systemd-resolved: %w: %s", err,
+			strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// managedByResolved reports whether /etc/resolv.conf
+// is pointed to the one generated by systemd-resolved.
+func managedByResolved() bool {
+	target, err := os.Readlink(resolvConfPath)
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(target, "systemd/resolve")
+}
+
+func scoped() ([]ScopedConfig, error) {
+	return nil, nil
+}
+
+func setScoped(_ ScopedConfig) error {
+	return errScopedUnsupported
+}
+
+func removeScoped(_ string) error {
+	return errScopedUnsupported
+}
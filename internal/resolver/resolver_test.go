@@ -0,0 +1,131 @@
+package resolver
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvConf(t *testing.T) {
+	config := parseResolvConf([]byte(`# This is /run/systemd/resolve/stub-resolv.conf managed by man:systemd-resolved(8).
+nameserver 127.0.0.53
+nameserver fe80::1%en0
+domain example.org
+search example.test lab.example.test
+options edns0 trust-ad
+options ndots:2
+`))
+
+	require.Equal(t, &Config{
+		Nameservers: []netip.Addr{
+			netip.MustParseAddr("127.0.0.53"),
+			netip.MustParseAddr("fe80::1%en0"),
+		},
+		SearchDomains: []string{"example.test", "lab.example.test"},
+		Options:       []string{"edns0", "trust-ad", "ndots:2"},
+	}, config)
+
+	require.Equal(t, `# Generated by tart-guest-agent
+nameserver 127.0.0.53
+nameserver fe80::1%en0
+search example.test lab.example.test
+options edns0 trust-ad ndots:2
+`, string(renderResolvConf(*config)))
+
+	require.Equal(t, config, parseResolvConf(renderResolvConf(*config)))
+}
+
+func TestResolvedDropIn(t *testing.T) {
+	config := Config{
+		Nameservers: []netip.Addr{
+			netip.MustParseAddr("1.1.1.1"),
+			netip.MustParseAddr("2606:4700:4700::1111"),
+		},
+		SearchDomains: []string{"example.test"},
+	}
+
+	content := renderResolvedDropIn(config)
+	require.Equal(t, `# Generated by tart-guest-agent
+[Resolve]
+DNS=1.1.1.1 2606:4700:4700::1111
+Domains=example.test
+`, string(content))
+
+	require.Equal(t, &config, parseResolvedDropIn(content))
+
+	// Settings outside of the [Resolve] section are ignored
+	require.Equal(t, &Config{}, parseResolvedDropIn([]byte("[Other]\nDNS=1.1.1.1\n")))
+}
+
+func TestNetworksetupList(t *testing.T) {
+	require.Equal(t, []string{"1.1.1.1", "8.8.8.8"}, parseNetworksetupList("1.1.1.1\n8.8.8.8\n"))
+	require.Empty(t, parseNetworksetupList("There aren't any DNS Servers set on Ethernet.\n"))
+	require.Empty(t, parseNetworksetupList("There aren't any Search Domains set on Ethernet.\n"))
+}
+
+func TestResolverFile(t *testing.T) {
+	config := ScopedConfig{
+		Domain:      "example.test",
+		Nameservers: []netip.Addr{netip.MustParseAddr("192.168.64.1")},
+		Port:        5353,
+	}
+	require.NoError(t, config.Validate())
+
+	content := renderResolverFile(config)
+	require.Equal(t, `# Generated by tart-guest-agent
+nameserver 192.168.64.1
+port 5353
+`, string(content))
+
+	require.Equal(t, config, parseResolverFile("example.test", content))
+}
+
+func TestScopedConfigValidate(t *testing.T) {
+	nameservers := []netip.Addr{netip.MustParseAddr("192.168.64.1")}
+
+	require.Error(t, ScopedConfig{Domain: "../hosts", Nameservers: nameservers}.Validate())
+	require.Error(t, ScopedConfig{Domain: "", Nameservers: nameservers}.Validate())
+	require.Error(t, ScopedConfig{Domain: "example.test"}.Validate())
+	require.NoError(t, ScopedConfig{Domain: "local", Nameservers: nameservers}.Validate())
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, Config{
+		Nameservers:   []netip.Addr{netip.MustParseAddr("192.168.64.1")},
+		SearchDomains: []string{"example.test", "local."},
+		Options:       []string{"ndots:2", "rotate", "single-request-reopen"},
+	}.Validate())
+
+	require.Error(t, Config{Nameservers: []netip.Addr{{}}}.Validate())
+	require.Error(t, Config{SearchDomains: []string{"example.test\nnameserver 1.1.1.1"}}.Validate())
+	require.Error(t, Config{SearchDomains: []string{"example.test other.test"}}.Validate())
+	require.Error(t, Config{Options: []string{"ndots:2\nnameserver 1.1.1.1"}}.Validate())
+	require.Error(t, Config{Options: []string{""}}.Validate())
+}
+
+func TestWriteFileFollowsSymlinks(t *testing.T) {
+	dir := t.TempDir()
+
+	target := filepath.Join(dir, "stub-resolv.conf")
+	require.NoError(t, os.WriteFile(target, []byte("old\n"), 0644))
+
+	link := filepath.Join(dir, "resolv.conf")
+	require.NoError(t, os.Symlink(target, link))
+
+	require.NoError(t, writeFile(link, []byte("new\n"), 0644))
+
+	linkTarget, err := os.Readlink(link)
+	require.NoError(t, err)
+	require.Equal(t, target, linkTarget)
+
+	content, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "new\n", string(content))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
@@ -9,7 +9,9 @@ package rpc
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +24,549 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AddressFamily int32
+
+const (
+	AddressFamily_ADDRESS_FAMILY_UNSPECIFIED AddressFamily = 0
+	AddressFamily_ADDRESS_FAMILY_IPV4        AddressFamily = 1
+	AddressFamily_ADDRESS_FAMILY_IPV6        AddressFamily = 2
+)
+
+// Enum value maps for AddressFamily.
+var (
+	AddressFamily_name = map[int32]string{
+		0: "ADDRESS_FAMILY_UNSPECIFIED",
+		1: "ADDRESS_FAMILY_IPV4",
+		2: "ADDRESS_FAMILY_IPV6",
+	}
+	AddressFamily_value = map[string]int32{
+		"ADDRESS_FAMILY_UNSPECIFIED": 0,
+		"ADDRESS_FAMILY_IPV4":        1,
+		"ADDRESS_FAMILY_IPV6":        2,
+	}
+)
+
+func (x AddressFamily) Enum() *AddressFamily {
+	p := new(AddressFamily)
+	*p = x
+	return p
+}
+
+func (x AddressFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[0].Descriptor()
+}
+
+func (AddressFamily) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[0]
+}
+
+func (x AddressFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressFamily.Descriptor instead.
+func (AddressFamily) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{0}
+}
+
+type ShutdownMode int32
+
+const (
+	// Same as SHUTDOWN_MODE_POWEROFF
+	ShutdownMode_SHUTDOWN_MODE_UNSPECIFIED ShutdownMode = 0
+	ShutdownMode_SHUTDOWN_MODE_POWEROFF    ShutdownMode = 1
+	ShutdownMode_SHUTDOWN_MODE_REBOOT      ShutdownMode = 2
+	ShutdownMode_SHUTDOWN_MODE_HALT        ShutdownMode = 3
+	// Logs out the user logged in to the GUI session
+	// without shutting down the system
+	ShutdownMode_SHUTDOWN_MODE_LOGOUT_CONSOLE_USER ShutdownMode = 4
+)
+
+// Enum value maps for ShutdownMode.
+var (
+	ShutdownMode_name = map[int32]string{
+		0: "SHUTDOWN_MODE_UNSPECIFIED",
+		1: "SHUTDOWN_MODE_POWEROFF",
+		2: "SHUTDOWN_MODE_REBOOT",
+		3: "SHUTDOWN_MODE_HALT",
+		4: "SHUTDOWN_MODE_LOGOUT_CONSOLE_USER",
+	}
+	ShutdownMode_value = map[string]int32{
+		"SHUTDOWN_MODE_UNSPECIFIED":         0,
+		"SHUTDOWN_MODE_POWEROFF":            1,
+		"SHUTDOWN_MODE_REBOOT":              2,
+		"SHUTDOWN_MODE_HALT":                3,
+		"SHUTDOWN_MODE_LOGOUT_CONSOLE_USER": 4,
+	}
+)
+
+func (x ShutdownMode) Enum() *ShutdownMode {
+	p := new(ShutdownMode)
+	*p = x
+	return p
+}
+
+func (x ShutdownMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShutdownMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[1].Descriptor()
+}
+
+func (ShutdownMode) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[1]
+}
+
+func (x ShutdownMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShutdownMode.Descriptor instead.
+func (ShutdownMode) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{1}
+}
+
+type SetTimePolicy int32
+
+const (
+	// Steps the clock when the offset exceeds the step threshold
+	// and slews it otherwise
+	SetTimePolicy_SET_TIME_POLICY_UNSPECIFIED SetTimePolicy = 0
+	SetTimePolicy_SET_TIME_POLICY_STEP        SetTimePolicy = 1
+	// Slewing is done at the kernel's rate of 500 PPM,
+	// so it takes about 2000 times the offset
+	SetTimePolicy_SET_TIME_POLICY_SLEW SetTimePolicy = 2
+)
+
+// Enum value maps for SetTimePolicy.
+var (
+	SetTimePolicy_name = map[int32]string{
+		0: "SET_TIME_POLICY_UNSPECIFIED",
+		1: "SET_TIME_POLICY_STEP",
+		2: "SET_TIME_POLICY_SLEW",
+	}
+	SetTimePolicy_value = map[string]int32{
+		"SET_TIME_POLICY_UNSPECIFIED": 0,
+		"SET_TIME_POLICY_STEP":        1,
+		"SET_TIME_POLICY_SLEW":        2,
+	}
+)
+
+func (x SetTimePolicy) Enum() *SetTimePolicy {
+	p := new(SetTimePolicy)
+	*p = x
+	return p
+}
+
+func (x SetTimePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetTimePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (SetTimePolicy) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[2]
+}
+
+func (x SetTimePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetTimePolicy.Descriptor instead.
+func (SetTimePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{2}
+}
+
+type WatchPathResponse_Event_Type int32
+
+const (
+	WatchPathResponse_Event_TYPE_UNSPECIFIED WatchPathResponse_Event_Type = 0
+	WatchPathResponse_Event_TYPE_CREATE      WatchPathResponse_Event_Type = 1
+	WatchPathResponse_Event_TYPE_MODIFY      WatchPathResponse_Event_Type = 2
+	WatchPathResponse_Event_TYPE_DELETE      WatchPathResponse_Event_Type = 3
+	// Reported for the old path, the new path is reported with TYPE_CREATE
+	WatchPathResponse_Event_TYPE_RENAME WatchPathResponse_Event_Type = 4
+)
+
+// Enum value maps for WatchPathResponse_Event_Type.
+var (
+	WatchPathResponse_Event_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CREATE",
+		2: "TYPE_MODIFY",
+		3: "TYPE_DELETE",
+		4: "TYPE_RENAME",
+	}
+	WatchPathResponse_Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_CREATE":      1,
+		"TYPE_MODIFY":      2,
+		"TYPE_DELETE":      3,
+		"TYPE_RENAME":      4,
+	}
+)
+
+func (x WatchPathResponse_Event_Type) Enum() *WatchPathResponse_Event_Type {
+	p := new(WatchPathResponse_Event_Type)
+	*p = x
+	return p
+}
+
+func (x WatchPathResponse_Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchPathResponse_Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[3].Descriptor()
+}
+
+func (WatchPathResponse_Event_Type) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[3]
+}
+
+func (x WatchPathResponse_Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchPathResponse_Event_Type.Descriptor instead.
+func (WatchPathResponse_Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{8, 1, 0}
+}
+
+type CollectArtifactsRequest_Format int32
+
+const (
+	CollectArtifactsRequest_FORMAT_UNSPECIFIED CollectArtifactsRequest_Format = 0
+	CollectArtifactsRequest_FORMAT_TAR         CollectArtifactsRequest_Format = 1
+	CollectArtifactsRequest_FORMAT_TAR_GZIP    CollectArtifactsRequest_Format = 2
+	CollectArtifactsRequest_FORMAT_ZIP         CollectArtifactsRequest_Format = 3
+)
+
+// Enum value maps for CollectArtifactsRequest_Format.
+var (
+	CollectArtifactsRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_TAR",
+		2: "FORMAT_TAR_GZIP",
+		3: "FORMAT_ZIP",
+	}
+	CollectArtifactsRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_TAR":         1,
+		"FORMAT_TAR_GZIP":    2,
+		"FORMAT_ZIP":         3,
+	}
+)
+
+func (x CollectArtifactsRequest_Format) Enum() *CollectArtifactsRequest_Format {
+	p := new(CollectArtifactsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x CollectArtifactsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectArtifactsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[4].Descriptor()
+}
+
+func (CollectArtifactsRequest_Format) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[4]
+}
+
+func (x CollectArtifactsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectArtifactsRequest_Format.Descriptor instead.
+func (CollectArtifactsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{34, 0}
+}
+
+type CollectArtifactsResponse_Manifest_Skipped_Reason int32
+
+const (
+	CollectArtifactsResponse_Manifest_Skipped_REASON_UNSPECIFIED         CollectArtifactsResponse_Manifest_Skipped_Reason = 0
+	CollectArtifactsResponse_Manifest_Skipped_REASON_FILE_TOO_LARGE      CollectArtifactsResponse_Manifest_Skipped_Reason = 1
+	CollectArtifactsResponse_Manifest_Skipped_REASON_TOTAL_SIZE_EXCEEDED CollectArtifactsResponse_Manifest_Skipped_Reason = 2
+	CollectArtifactsResponse_Manifest_Skipped_REASON_NOT_REGULAR_FILE    CollectArtifactsResponse_Manifest_Skipped_Reason = 3
+	CollectArtifactsResponse_Manifest_Skipped_REASON_ERROR               CollectArtifactsResponse_Manifest_Skipped_Reason = 4
+)
+
+// Enum value maps for CollectArtifactsResponse_Manifest_Skipped_Reason.
+var (
+	CollectArtifactsResponse_Manifest_Skipped_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_FILE_TOO_LARGE",
+		2: "REASON_TOTAL_SIZE_EXCEEDED",
+		3: "REASON_NOT_REGULAR_FILE",
+		4: "REASON_ERROR",
+	}
+	CollectArtifactsResponse_Manifest_Skipped_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":         0,
+		"REASON_FILE_TOO_LARGE":      1,
+		"REASON_TOTAL_SIZE_EXCEEDED": 2,
+		"REASON_NOT_REGULAR_FILE":    3,
+		"REASON_ERROR":               4,
+	}
+)
+
+func (x CollectArtifactsResponse_Manifest_Skipped_Reason) Enum() *CollectArtifactsResponse_Manifest_Skipped_Reason {
+	p := new(CollectArtifactsResponse_Manifest_Skipped_Reason)
+	*p = x
+	return p
+}
+
+func (x CollectArtifactsResponse_Manifest_Skipped_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectArtifactsResponse_Manifest_Skipped_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[5].Descriptor()
+}
+
+func (CollectArtifactsResponse_Manifest_Skipped_Reason) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[5]
+}
+
+func (x CollectArtifactsResponse_Manifest_Skipped_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectArtifactsResponse_Manifest_Skipped_Reason.Descriptor instead.
+func (CollectArtifactsResponse_Manifest_Skipped_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{35, 0, 1, 0}
+}
+
+type WatchNetworkResponse_Event_Type int32
+
+const (
+	WatchNetworkResponse_Event_TYPE_UNSPECIFIED           WatchNetworkResponse_Event_Type = 0
+	WatchNetworkResponse_Event_TYPE_INTERFACE_ADDED       WatchNetworkResponse_Event_Type = 1
+	WatchNetworkResponse_Event_TYPE_INTERFACE_REMOVED     WatchNetworkResponse_Event_Type = 2
+	WatchNetworkResponse_Event_TYPE_INTERFACE_UP          WatchNetworkResponse_Event_Type = 3
+	WatchNetworkResponse_Event_TYPE_INTERFACE_DOWN        WatchNetworkResponse_Event_Type = 4
+	WatchNetworkResponse_Event_TYPE_ADDRESS_ADDED         WatchNetworkResponse_Event_Type = 5
+	WatchNetworkResponse_Event_TYPE_ADDRESS_REMOVED       WatchNetworkResponse_Event_Type = 6
+	WatchNetworkResponse_Event_TYPE_DEFAULT_ROUTE_CHANGED WatchNetworkResponse_Event_Type = 7
+)
+
+// Enum value maps for WatchNetworkResponse_Event_Type.
+var (
+	WatchNetworkResponse_Event_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_INTERFACE_ADDED",
+		2: "TYPE_INTERFACE_REMOVED",
+		3: "TYPE_INTERFACE_UP",
+		4: "TYPE_INTERFACE_DOWN",
+		5: "TYPE_ADDRESS_ADDED",
+		6: "TYPE_ADDRESS_REMOVED",
+		7: "TYPE_DEFAULT_ROUTE_CHANGED",
+	}
+	WatchNetworkResponse_Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":           0,
+		"TYPE_INTERFACE_ADDED":       1,
+		"TYPE_INTERFACE_REMOVED":     2,
+		"TYPE_INTERFACE_UP":          3,
+		"TYPE_INTERFACE_DOWN":        4,
+		"TYPE_ADDRESS_ADDED":         5,
+		"TYPE_ADDRESS_REMOVED":       6,
+		"TYPE_DEFAULT_ROUTE_CHANGED": 7,
+	}
+)
+
+func (x WatchNetworkResponse_Event_Type) Enum() *WatchNetworkResponse_Event_Type {
+	p := new(WatchNetworkResponse_Event_Type)
+	*p = x
+	return p
+}
+
+func (x WatchNetworkResponse_Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchNetworkResponse_Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[6].Descriptor()
+}
+
+func (WatchNetworkResponse_Event_Type) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[6]
+}
+
+func (x WatchNetworkResponse_Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchNetworkResponse_Event_Type.Descriptor instead.
+func (WatchNetworkResponse_Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{37, 1, 0}
+}
+
+type ProxyResponse_IncomingConnection_Protocol int32
+
+const (
+	ProxyResponse_IncomingConnection_PROTOCOL_UNSPECIFIED  ProxyResponse_IncomingConnection_Protocol = 0
+	ProxyResponse_IncomingConnection_PROTOCOL_SOCKS5       ProxyResponse_IncomingConnection_Protocol = 1
+	ProxyResponse_IncomingConnection_PROTOCOL_HTTP_CONNECT ProxyResponse_IncomingConnection_Protocol = 2
+)
+
+// Enum value maps for ProxyResponse_IncomingConnection_Protocol.
+var (
+	ProxyResponse_IncomingConnection_Protocol_name = map[int32]string{
+		0: "PROTOCOL_UNSPECIFIED",
+		1: "PROTOCOL_SOCKS5",
+		2: "PROTOCOL_HTTP_CONNECT",
+	}
+	ProxyResponse_IncomingConnection_Protocol_value = map[string]int32{
+		"PROTOCOL_UNSPECIFIED":  0,
+		"PROTOCOL_SOCKS5":       1,
+		"PROTOCOL_HTTP_CONNECT": 2,
+	}
+)
+
+func (x ProxyResponse_IncomingConnection_Protocol) Enum() *ProxyResponse_IncomingConnection_Protocol {
+	p := new(ProxyResponse_IncomingConnection_Protocol)
+	*p = x
+	return p
+}
+
+func (x ProxyResponse_IncomingConnection_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProxyResponse_IncomingConnection_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[7].Descriptor()
+}
+
+func (ProxyResponse_IncomingConnection_Protocol) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[7]
+}
+
+func (x ProxyResponse_IncomingConnection_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProxyResponse_IncomingConnection_Protocol.Descriptor instead.
+func (ProxyResponse_IncomingConnection_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{50, 1, 0}
+}
+
+type ProxyConnectionRequest_Reject_Reason int32
+
+const (
+	ProxyConnectionRequest_Reject_REASON_UNSPECIFIED        ProxyConnectionRequest_Reject_Reason = 0
+	ProxyConnectionRequest_Reject_REASON_NOT_ALLOWED        ProxyConnectionRequest_Reject_Reason = 1
+	ProxyConnectionRequest_Reject_REASON_HOST_UNREACHABLE   ProxyConnectionRequest_Reject_Reason = 2
+	ProxyConnectionRequest_Reject_REASON_CONNECTION_REFUSED ProxyConnectionRequest_Reject_Reason = 3
+)
+
+// Enum value maps for ProxyConnectionRequest_Reject_Reason.
+var (
+	ProxyConnectionRequest_Reject_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_NOT_ALLOWED",
+		2: "REASON_HOST_UNREACHABLE",
+		3: "REASON_CONNECTION_REFUSED",
+	}
+	ProxyConnectionRequest_Reject_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":        0,
+		"REASON_NOT_ALLOWED":        1,
+		"REASON_HOST_UNREACHABLE":   2,
+		"REASON_CONNECTION_REFUSED": 3,
+	}
+)
+
+func (x ProxyConnectionRequest_Reject_Reason) Enum() *ProxyConnectionRequest_Reject_Reason {
+	p := new(ProxyConnectionRequest_Reject_Reason)
+	*p = x
+	return p
+}
+
+func (x ProxyConnectionRequest_Reject_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProxyConnectionRequest_Reject_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[8].Descriptor()
+}
+
+func (ProxyConnectionRequest_Reject_Reason) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[8]
+}
+
+func (x ProxyConnectionRequest_Reject_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProxyConnectionRequest_Reject_Reason.Descriptor instead.
+func (ProxyConnectionRequest_Reject_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{51, 1, 0}
+}
+
+type WatchClockResponse_Kind int32
+
+const (
+	WatchClockResponse_KIND_UNSPECIFIED WatchClockResponse_Kind = 0
+	// The guest was suspended, as reported by the kernel, or paused
+	// from the outside (e.g. "tart suspend"), as detected by the RTC
+	// (Linux) or the host's times passed to the consecutive SetTime
+	// calls advancing further than the guest's clocks
+	WatchClockResponse_KIND_RESUMED WatchClockResponse_Kind = 1
+	// The wall clock has moved relative to the monotonic clock
+	// by other means than SetTime, e.g. by an NTP daemon
+	WatchClockResponse_KIND_JUMPED WatchClockResponse_Kind = 2
+)
+
+// Enum value maps for WatchClockResponse_Kind.
+var (
+	WatchClockResponse_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_RESUMED",
+		2: "KIND_JUMPED",
+	}
+	WatchClockResponse_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_RESUMED":     1,
+		"KIND_JUMPED":      2,
+	}
+)
+
+func (x WatchClockResponse_Kind) Enum() *WatchClockResponse_Kind {
+	p := new(WatchClockResponse_Kind)
+	*p = x
+	return p
+}
+
+func (x WatchClockResponse_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchClockResponse_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_agent_proto_enumTypes[9].Descriptor()
+}
+
+func (WatchClockResponse_Kind) Type() protoreflect.EnumType {
+	return &file_rpc_agent_proto_enumTypes[9]
+}
+
+func (x WatchClockResponse_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchClockResponse_Kind.Descriptor instead.
+func (WatchClockResponse_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{89, 0}
+}
+
 type ExecRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Type:
@@ -315,7 +860,23 @@ func (x *IOChunk) GetData() []byte {
 }
 
 type ResolveIPRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only consider addresses of this family,
+	// both families are considered when unspecified
+	Family AddressFamily `protobuf:"varint,1,opt,name=family,proto3,enum=AddressFamily" json:"family,omitempty"`
+	// Only consider interfaces with these names (e.g. "en0")
+	Interfaces []string `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// Only consider addresses from these subnets (e.g. "192.168.64.0/24")
+	Subnets []string `protobuf:"bytes,3,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	// Only consider interfaces that hold the default route
+	DefaultRouteOnly bool `protobuf:"varint,4,opt,name=default_route_only,json=defaultRouteOnly,proto3" json:"default_route_only,omitempty"`
+	// Instead of failing right away, wait until an address
+	// that matches the request is assigned, e.g. until
+	// the DHCP completes after the VM has booted
+	Wait bool `protobuf:"varint,5,opt,name=wait,proto3" json:"wait,omitempty"`
+	// How long to wait for, defaults to waiting
+	// until the request's deadline expires
+	WaitTimeout   *durationpb.Duration `protobuf:"bytes,6,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -350,9 +911,63 @@ func (*ResolveIPRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_agent_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *ResolveIPRequest) GetFamily() AddressFamily {
+	if x != nil {
+		return x.Family
+	}
+	return AddressFamily_ADDRESS_FAMILY_UNSPECIFIED
+}
+
+func (x *ResolveIPRequest) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *ResolveIPRequest) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *ResolveIPRequest) GetDefaultRouteOnly() bool {
+	if x != nil {
+		return x.DefaultRouteOnly
+	}
+	return false
+}
+
+func (x *ResolveIPRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *ResolveIPRequest) GetWaitTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WaitTimeout
+	}
+	return nil
+}
+
 type ResolveIPResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A single address, chosen among the interfaces that match the request:
+	//
+	//  1. only global unicast addresses of the requested family are considered,
+	//     IPv4 is assumed when no family is requested for backward compatibility
+	//  2. addresses of the interface holding the default route for that family
+	//  3. addresses of the interfaces that are up
+	//  4. addresses of the remaining interfaces
+	//
+	// Ties are broken by the interface index and then by the address
+	// order reported by the operating system.
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Interfaces that match the request, along with their matching addresses
+	Interfaces    []*NetworkInterface `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -394,32 +1009,8147 @@ func (x *ResolveIPResponse) GetIp() string {
 	return ""
 }
 
-type ExecRequest_Command struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	Interactive   bool                   `protobuf:"varint,3,opt,name=interactive,proto3" json:"interactive,omitempty"`
-	Tty           bool                   `protobuf:"varint,4,opt,name=tty,proto3" json:"tty,omitempty"`
-	TerminalSize  *TerminalSize          `protobuf:"bytes,5,opt,name=terminal_size,json=terminalSize,proto3" json:"terminal_size,omitempty"`
+func (x *ResolveIPResponse) GetInterfaces() []*NetworkInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type NetworkInterface struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Index uint32                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Mac   string                 `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	// Interface flags, e.g. "up", "broadcast", "loopback", "running"
+	Flags     []string                    `protobuf:"bytes,4,rep,name=flags,proto3" json:"flags,omitempty"`
+	Mtu       uint32                      `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Addresses []*NetworkInterface_Address `protobuf:"bytes,6,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Whether the interface holds the default route
+	DefaultRouteIpv4 bool `protobuf:"varint,7,opt,name=default_route_ipv4,json=defaultRouteIpv4,proto3" json:"default_route_ipv4,omitempty"`
+	DefaultRouteIpv6 bool `protobuf:"varint,8,opt,name=default_route_ipv6,json=defaultRouteIpv6,proto3" json:"default_route_ipv6,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NetworkInterface) Reset() {
+	*x = NetworkInterface{}
+	mi := &file_rpc_agent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterface) ProtoMessage() {}
+
+func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterface.ProtoReflect.Descriptor instead.
+func (*NetworkInterface) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NetworkInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetMac() string {
+	if x != nil {
+		return x.Mac
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *NetworkInterface) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetAddresses() []*NetworkInterface_Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *NetworkInterface) GetDefaultRouteIpv4() bool {
+	if x != nil {
+		return x.DefaultRouteIpv4
+	}
+	return false
+}
+
+func (x *NetworkInterface) GetDefaultRouteIpv6() bool {
+	if x != nil {
+		return x.DefaultRouteIpv6
+	}
+	return false
+}
+
+type WatchPathRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Paths to watch, either files or directories
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Whether to watch the subdirectories of the specified directories too,
+	// note that on macOS each watched file and directory consumes a file
+	// descriptor, so watching large trees might exhaust the agent's limit
+	Recursive bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	// Glob patterns with "**" support that are matched against
+	// the changed path relative to the watched path it belongs to,
+	// e.g. "**/*.log", when specified only matching changes are reported
+	Include []string `protobuf:"bytes,3,rep,name=include,proto3" json:"include,omitempty"`
+	// Glob patterns in the same format as above, takes precedence over "include"
+	Exclude       []string `protobuf:"bytes,4,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPathRequest) Reset() {
+	*x = WatchPathRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPathRequest) ProtoMessage() {}
+
+func (x *WatchPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPathRequest.ProtoReflect.Descriptor instead.
+func (*WatchPathRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchPathRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *WatchPathRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *WatchPathRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *WatchPathRequest) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+type WatchPathResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*WatchPathResponse_Ready_
+	//	*WatchPathResponse_Event_
+	Type          isWatchPathResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPathResponse) Reset() {
+	*x = WatchPathResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPathResponse) ProtoMessage() {}
+
+func (x *WatchPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPathResponse.ProtoReflect.Descriptor instead.
+func (*WatchPathResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchPathResponse) GetType() isWatchPathResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *WatchPathResponse) GetReady() *WatchPathResponse_Ready {
+	if x != nil {
+		if x, ok := x.Type.(*WatchPathResponse_Ready_); ok {
+			return x.Ready
+		}
+	}
+	return nil
+}
+
+func (x *WatchPathResponse) GetEvent() *WatchPathResponse_Event {
+	if x != nil {
+		if x, ok := x.Type.(*WatchPathResponse_Event_); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isWatchPathResponse_Type interface {
+	isWatchPathResponse_Type()
+}
+
+type WatchPathResponse_Ready_ struct {
+	Ready *WatchPathResponse_Ready `protobuf:"bytes,1,opt,name=ready,proto3,oneof"`
+}
+
+type WatchPathResponse_Event_ struct {
+	Event *WatchPathResponse_Event `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+func (*WatchPathResponse_Ready_) isWatchPathResponse_Type() {}
+
+func (*WatchPathResponse_Event_) isWatchPathResponse_Type() {}
+
+// SyncFile implements an rsync-style file transfer: the agent responds
+// to the Begin request with the signatures of the existing file's blocks,
+// the client then sends only the blocks that the agent doesn't have
+// as literal data, and finishes with Commit, after which the agent
+// atomically replaces the file with the reconstructed one.
+type SyncFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*SyncFileRequest_Begin_
+	//	*SyncFileRequest_CopyBlocks_
+	//	*SyncFileRequest_Literal
+	//	*SyncFileRequest_Commit_
+	Type          isSyncFileRequest_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileRequest) Reset() {
+	*x = SyncFileRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileRequest) ProtoMessage() {}
+
+func (x *SyncFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileRequest.ProtoReflect.Descriptor instead.
+func (*SyncFileRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SyncFileRequest) GetType() isSyncFileRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *SyncFileRequest) GetBegin() *SyncFileRequest_Begin {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileRequest_Begin_); ok {
+			return x.Begin
+		}
+	}
+	return nil
+}
+
+func (x *SyncFileRequest) GetCopyBlocks() *SyncFileRequest_CopyBlocks {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileRequest_CopyBlocks_); ok {
+			return x.CopyBlocks
+		}
+	}
+	return nil
+}
+
+func (x *SyncFileRequest) GetLiteral() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileRequest_Literal); ok {
+			return x.Literal
+		}
+	}
+	return nil
+}
+
+func (x *SyncFileRequest) GetCommit() *SyncFileRequest_Commit {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileRequest_Commit_); ok {
+			return x.Commit
+		}
+	}
+	return nil
+}
+
+type isSyncFileRequest_Type interface {
+	isSyncFileRequest_Type()
+}
+
+type SyncFileRequest_Begin_ struct {
+	Begin *SyncFileRequest_Begin `protobuf:"bytes,1,opt,name=begin,proto3,oneof"`
+}
+
+type SyncFileRequest_CopyBlocks_ struct {
+	CopyBlocks *SyncFileRequest_CopyBlocks `protobuf:"bytes,2,opt,name=copy_blocks,json=copyBlocks,proto3,oneof"`
+}
+
+type SyncFileRequest_Literal struct {
+	Literal *IOChunk `protobuf:"bytes,3,opt,name=literal,proto3,oneof"`
+}
+
+type SyncFileRequest_Commit_ struct {
+	Commit *SyncFileRequest_Commit `protobuf:"bytes,4,opt,name=commit,proto3,oneof"`
+}
+
+func (*SyncFileRequest_Begin_) isSyncFileRequest_Type() {}
+
+func (*SyncFileRequest_CopyBlocks_) isSyncFileRequest_Type() {}
+
+func (*SyncFileRequest_Literal) isSyncFileRequest_Type() {}
+
+func (*SyncFileRequest_Commit_) isSyncFileRequest_Type() {}
+
+type SyncFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*SyncFileResponse_Signatures_
+	//	*SyncFileResponse_SignaturesEnd_
+	//	*SyncFileResponse_Committed_
+	Type          isSyncFileResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileResponse) Reset() {
+	*x = SyncFileResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileResponse) ProtoMessage() {}
+
+func (x *SyncFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileResponse.ProtoReflect.Descriptor instead.
+func (*SyncFileResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SyncFileResponse) GetType() isSyncFileResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *SyncFileResponse) GetSignatures() *SyncFileResponse_Signatures {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileResponse_Signatures_); ok {
+			return x.Signatures
+		}
+	}
+	return nil
+}
+
+func (x *SyncFileResponse) GetSignaturesEnd() *SyncFileResponse_SignaturesEnd {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileResponse_SignaturesEnd_); ok {
+			return x.SignaturesEnd
+		}
+	}
+	return nil
+}
+
+func (x *SyncFileResponse) GetCommitted() *SyncFileResponse_Committed {
+	if x != nil {
+		if x, ok := x.Type.(*SyncFileResponse_Committed_); ok {
+			return x.Committed
+		}
+	}
+	return nil
+}
+
+type isSyncFileResponse_Type interface {
+	isSyncFileResponse_Type()
+}
+
+type SyncFileResponse_Signatures_ struct {
+	Signatures *SyncFileResponse_Signatures `protobuf:"bytes,1,opt,name=signatures,proto3,oneof"`
+}
+
+type SyncFileResponse_SignaturesEnd_ struct {
+	SignaturesEnd *SyncFileResponse_SignaturesEnd `protobuf:"bytes,2,opt,name=signatures_end,json=signaturesEnd,proto3,oneof"`
+}
+
+type SyncFileResponse_Committed_ struct {
+	Committed *SyncFileResponse_Committed `protobuf:"bytes,3,opt,name=committed,proto3,oneof"`
+}
+
+func (*SyncFileResponse_Signatures_) isSyncFileResponse_Type() {}
+
+func (*SyncFileResponse_SignaturesEnd_) isSyncFileResponse_Type() {}
+
+func (*SyncFileResponse_Committed_) isSyncFileResponse_Type() {}
+
+type UploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*UploadRequest_Begin_
+	//	*UploadRequest_Data
+	//	*UploadRequest_Commit_
+	Type          isUploadRequest_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadRequest) Reset() {
+	*x = UploadRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRequest) ProtoMessage() {}
+
+func (x *UploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRequest.ProtoReflect.Descriptor instead.
+func (*UploadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadRequest) GetType() isUploadRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *UploadRequest) GetBegin() *UploadRequest_Begin {
+	if x != nil {
+		if x, ok := x.Type.(*UploadRequest_Begin_); ok {
+			return x.Begin
+		}
+	}
+	return nil
+}
+
+func (x *UploadRequest) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*UploadRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *UploadRequest) GetCommit() *UploadRequest_Commit {
+	if x != nil {
+		if x, ok := x.Type.(*UploadRequest_Commit_); ok {
+			return x.Commit
+		}
+	}
+	return nil
+}
+
+type isUploadRequest_Type interface {
+	isUploadRequest_Type()
+}
+
+type UploadRequest_Begin_ struct {
+	Begin *UploadRequest_Begin `protobuf:"bytes,1,opt,name=begin,proto3,oneof"`
+}
+
+type UploadRequest_Data struct {
+	Data *IOChunk `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+type UploadRequest_Commit_ struct {
+	Commit *UploadRequest_Commit `protobuf:"bytes,3,opt,name=commit,proto3,oneof"`
+}
+
+func (*UploadRequest_Begin_) isUploadRequest_Type() {}
+
+func (*UploadRequest_Data) isUploadRequest_Type() {}
+
+func (*UploadRequest_Commit_) isUploadRequest_Type() {}
+
+type UploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          uint64                 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadResponse) Reset() {
+	*x = UploadResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadResponse) ProtoMessage() {}
+
+func (x *UploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadResponse.ProtoReflect.Descriptor instead.
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UploadResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type DownloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Offset from which to resume the download
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Whether to include the file's extended attributes in the metadata
+	IncludeXattrs bool `protobuf:"varint,3,opt,name=include_xattrs,json=includeXattrs,proto3" json:"include_xattrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadRequest) Reset() {
+	*x = DownloadRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRequest) ProtoMessage() {}
+
+func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRequest.ProtoReflect.Descriptor instead.
+func (*DownloadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DownloadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DownloadRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadRequest) GetIncludeXattrs() bool {
+	if x != nil {
+		return x.IncludeXattrs
+	}
+	return false
+}
+
+type DownloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*DownloadResponse_Metadata_
+	//	*DownloadResponse_Data
+	Type          isDownloadResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadResponse) Reset() {
+	*x = DownloadResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadResponse) ProtoMessage() {}
+
+func (x *DownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadResponse.ProtoReflect.Descriptor instead.
+func (*DownloadResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DownloadResponse) GetType() isDownloadResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *DownloadResponse) GetMetadata() *DownloadResponse_Metadata {
+	if x != nil {
+		if x, ok := x.Type.(*DownloadResponse_Metadata_); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *DownloadResponse) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*DownloadResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isDownloadResponse_Type interface {
+	isDownloadResponse_Type()
+}
+
+type DownloadResponse_Metadata_ struct {
+	Metadata *DownloadResponse_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type DownloadResponse_Data struct {
+	Data *IOChunk `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*DownloadResponse_Metadata_) isDownloadResponse_Type() {}
+
+func (*DownloadResponse_Data) isDownloadResponse_Type() {}
+
+type QueryTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTransferRequest) Reset() {
+	*x = QueryTransferRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTransferRequest) ProtoMessage() {}
+
+func (x *QueryTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTransferRequest.ProtoReflect.Descriptor instead.
+func (*QueryTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type QueryTransferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size  uint64                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Offset up to which the data was received and persisted,
+	// the upload should be resumed from this offset
+	CommittedOffset uint64 `protobuf:"varint,3,opt,name=committed_offset,json=committedOffset,proto3" json:"committed_offset,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueryTransferResponse) Reset() {
+	*x = QueryTransferResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTransferResponse) ProtoMessage() {}
+
+func (x *QueryTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTransferResponse.ProtoReflect.Descriptor instead.
+func (*QueryTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryTransferResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *QueryTransferResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *QueryTransferResponse) GetCommittedOffset() uint64 {
+	if x != nil {
+		return x.CommittedOffset
+	}
+	return 0
+}
+
+type ExtendedAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendedAttribute) Reset() {
+	*x = ExtendedAttribute{}
+	mi := &file_rpc_agent_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendedAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedAttribute) ProtoMessage() {}
+
+func (x *ExtendedAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedAttribute.ProtoReflect.Descriptor instead.
+func (*ExtendedAttribute) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExtendedAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtendedAttribute) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ListXattrsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Operate on the symbolic link itself instead of its target
+	NoFollow bool `protobuf:"varint,2,opt,name=no_follow,json=noFollow,proto3" json:"no_follow,omitempty"`
+	// Whether to retrieve the values too
+	IncludeValues bool `protobuf:"varint,3,opt,name=include_values,json=includeValues,proto3" json:"include_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListXattrsRequest) Reset() {
+	*x = ListXattrsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListXattrsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListXattrsRequest) ProtoMessage() {}
+
+func (x *ListXattrsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListXattrsRequest.ProtoReflect.Descriptor instead.
+func (*ListXattrsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListXattrsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListXattrsRequest) GetNoFollow() bool {
+	if x != nil {
+		return x.NoFollow
+	}
+	return false
+}
+
+func (x *ListXattrsRequest) GetIncludeValues() bool {
+	if x != nil {
+		return x.IncludeValues
+	}
+	return false
+}
+
+type ListXattrsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Xattrs        []*ExtendedAttribute   `protobuf:"bytes,1,rep,name=xattrs,proto3" json:"xattrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListXattrsResponse) Reset() {
+	*x = ListXattrsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListXattrsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListXattrsResponse) ProtoMessage() {}
+
+func (x *ListXattrsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListXattrsResponse.ProtoReflect.Descriptor instead.
+func (*ListXattrsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListXattrsResponse) GetXattrs() []*ExtendedAttribute {
+	if x != nil {
+		return x.Xattrs
+	}
+	return nil
+}
+
+type GetXattrRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NoFollow      bool                   `protobuf:"varint,2,opt,name=no_follow,json=noFollow,proto3" json:"no_follow,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetXattrRequest) Reset() {
+	*x = GetXattrRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetXattrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetXattrRequest) ProtoMessage() {}
+
+func (x *GetXattrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetXattrRequest.ProtoReflect.Descriptor instead.
+func (*GetXattrRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetXattrRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetXattrRequest) GetNoFollow() bool {
+	if x != nil {
+		return x.NoFollow
+	}
+	return false
+}
+
+func (x *GetXattrRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetXattrResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetXattrResponse) Reset() {
+	*x = GetXattrResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetXattrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetXattrResponse) ProtoMessage() {}
+
+func (x *GetXattrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetXattrResponse.ProtoReflect.Descriptor instead.
+func (*GetXattrResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetXattrResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetXattrRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NoFollow      bool                   `protobuf:"varint,2,opt,name=no_follow,json=noFollow,proto3" json:"no_follow,omitempty"`
+	Xattr         *ExtendedAttribute     `protobuf:"bytes,3,opt,name=xattr,proto3" json:"xattr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetXattrRequest) Reset() {
+	*x = SetXattrRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetXattrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetXattrRequest) ProtoMessage() {}
+
+func (x *SetXattrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetXattrRequest.ProtoReflect.Descriptor instead.
+func (*SetXattrRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetXattrRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetXattrRequest) GetNoFollow() bool {
+	if x != nil {
+		return x.NoFollow
+	}
+	return false
+}
+
+func (x *SetXattrRequest) GetXattr() *ExtendedAttribute {
+	if x != nil {
+		return x.Xattr
+	}
+	return nil
+}
+
+type SetXattrResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetXattrResponse) Reset() {
+	*x = SetXattrResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetXattrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetXattrResponse) ProtoMessage() {}
+
+func (x *SetXattrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetXattrResponse.ProtoReflect.Descriptor instead.
+func (*SetXattrResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{23}
+}
+
+type RemoveXattrRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NoFollow      bool                   `protobuf:"varint,2,opt,name=no_follow,json=noFollow,proto3" json:"no_follow,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveXattrRequest) Reset() {
+	*x = RemoveXattrRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveXattrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveXattrRequest) ProtoMessage() {}
+
+func (x *RemoveXattrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveXattrRequest.ProtoReflect.Descriptor instead.
+func (*RemoveXattrRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveXattrRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RemoveXattrRequest) GetNoFollow() bool {
+	if x != nil {
+		return x.NoFollow
+	}
+	return false
+}
+
+func (x *RemoveXattrRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RemoveXattrResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveXattrResponse) Reset() {
+	*x = RemoveXattrResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveXattrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveXattrResponse) ProtoMessage() {}
+
+func (x *RemoveXattrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveXattrResponse.ProtoReflect.Descriptor instead.
+func (*RemoveXattrResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{25}
+}
+
+type GetFileFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileFlagsRequest) Reset() {
+	*x = GetFileFlagsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileFlagsRequest) ProtoMessage() {}
+
+func (x *GetFileFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileFlagsRequest.ProtoReflect.Descriptor instead.
+func (*GetFileFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetFileFlagsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileFlagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Flags uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Names of the flags as used by chflags(1) on macOS
+	// (e.g. "uchg" or "hidden") and chattr(1) on Linux
+	// (e.g. "immutable" or "append")
+	Names         []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileFlagsResponse) Reset() {
+	*x = GetFileFlagsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileFlagsResponse) ProtoMessage() {}
+
+func (x *GetFileFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileFlagsResponse.ProtoReflect.Descriptor instead.
+func (*GetFileFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetFileFlagsResponse) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *GetFileFlagsResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type SetFileFlagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Names of the flags to set and to clear,
+	// flags not mentioned are kept intact
+	Set           []string `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty"`
+	Clear         []string `protobuf:"bytes,3,rep,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFileFlagsRequest) Reset() {
+	*x = SetFileFlagsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFileFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFileFlagsRequest) ProtoMessage() {}
+
+func (x *SetFileFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFileFlagsRequest.ProtoReflect.Descriptor instead.
+func (*SetFileFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetFileFlagsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetFileFlagsRequest) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *SetFileFlagsRequest) GetClear() []string {
+	if x != nil {
+		return x.Clear
+	}
+	return nil
+}
+
+type SetFileFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFileFlagsResponse) Reset() {
+	*x = SetFileFlagsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFileFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFileFlagsResponse) ProtoMessage() {}
+
+func (x *SetFileFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFileFlagsResponse.ProtoReflect.Descriptor instead.
+func (*SetFileFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetFileFlagsResponse) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *SetFileFlagsResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetACLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Retrieve the default ACL of a directory instead of the access ACL,
+	// only supported on Linux
+	Default       bool `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetACLRequest) Reset() {
+	*x = GetACLRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetACLRequest) ProtoMessage() {}
+
+func (x *GetACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetACLRequest.ProtoReflect.Descriptor instead.
+func (*GetACLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetACLRequest) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+type GetACLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ACL entries in the getfacl(1) short text form on Linux
+	// (e.g. "user:1000:rw-") and in the chmod(1) form on macOS
+	// (e.g. "user:admin allow read,write")
+	Entries       []string `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetACLResponse) Reset() {
+	*x = GetACLResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetACLResponse) ProtoMessage() {}
+
+func (x *GetACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetACLResponse.ProtoReflect.Descriptor instead.
+func (*GetACLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetACLResponse) GetEntries() []string {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetACLRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Path    string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Default bool                   `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	// ACL entries to replace the existing ones with in the same
+	// format as above, no entries removes the ACL completely
+	Entries       []string `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetACLRequest) Reset() {
+	*x = SetACLRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetACLRequest) ProtoMessage() {}
+
+func (x *SetACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetACLRequest.ProtoReflect.Descriptor instead.
+func (*SetACLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetACLRequest) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+func (x *SetACLRequest) GetEntries() []string {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetACLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetACLResponse) Reset() {
+	*x = SetACLResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetACLResponse) ProtoMessage() {}
+
+func (x *SetACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetACLResponse.ProtoReflect.Descriptor instead.
+func (*SetACLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{33}
+}
+
+type CollectArtifactsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Glob patterns with "**" support (e.g. "**/*.xcresult/**"),
+	// either absolute or relative to the base directory
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// Directory relative to which the relative patterns are resolved
+	// and the archive entry names are computed, defaults to the
+	// agent's working directory, files outside of it are archived
+	// under their absolute path
+	BaseDir string `protobuf:"bytes,2,opt,name=base_dir,json=baseDir,proto3" json:"base_dir,omitempty"`
+	// Limits on the total size of the files in the archive and on
+	// the size of each individual file, zero means no limit
+	MaxTotalSize uint64 `protobuf:"varint,3,opt,name=max_total_size,json=maxTotalSize,proto3" json:"max_total_size,omitempty"`
+	MaxFileSize  uint64 `protobuf:"varint,4,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// Defaults to FORMAT_TAR
+	Format        CollectArtifactsRequest_Format `protobuf:"varint,5,opt,name=format,proto3,enum=CollectArtifactsRequest_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectArtifactsRequest) Reset() {
+	*x = CollectArtifactsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectArtifactsRequest) ProtoMessage() {}
+
+func (x *CollectArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*CollectArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CollectArtifactsRequest) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *CollectArtifactsRequest) GetBaseDir() string {
+	if x != nil {
+		return x.BaseDir
+	}
+	return ""
+}
+
+func (x *CollectArtifactsRequest) GetMaxTotalSize() uint64 {
+	if x != nil {
+		return x.MaxTotalSize
+	}
+	return 0
+}
+
+func (x *CollectArtifactsRequest) GetMaxFileSize() uint64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *CollectArtifactsRequest) GetFormat() CollectArtifactsRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return CollectArtifactsRequest_FORMAT_UNSPECIFIED
+}
+
+type CollectArtifactsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*CollectArtifactsResponse_Data
+	//	*CollectArtifactsResponse_Manifest_
+	Type          isCollectArtifactsResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectArtifactsResponse) Reset() {
+	*x = CollectArtifactsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectArtifactsResponse) ProtoMessage() {}
+
+func (x *CollectArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*CollectArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CollectArtifactsResponse) GetType() isCollectArtifactsResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *CollectArtifactsResponse) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*CollectArtifactsResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *CollectArtifactsResponse) GetManifest() *CollectArtifactsResponse_Manifest {
+	if x != nil {
+		if x, ok := x.Type.(*CollectArtifactsResponse_Manifest_); ok {
+			return x.Manifest
+		}
+	}
+	return nil
+}
+
+type isCollectArtifactsResponse_Type interface {
+	isCollectArtifactsResponse_Type()
+}
+
+type CollectArtifactsResponse_Data struct {
+	// Chunk of the archive
+	Data *IOChunk `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+type CollectArtifactsResponse_Manifest_ struct {
+	Manifest *CollectArtifactsResponse_Manifest `protobuf:"bytes,2,opt,name=manifest,proto3,oneof"`
+}
+
+func (*CollectArtifactsResponse_Data) isCollectArtifactsResponse_Type() {}
+
+func (*CollectArtifactsResponse_Manifest_) isCollectArtifactsResponse_Type() {}
+
+type WatchNetworkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only report changes of the interfaces with these names
+	Interfaces []string `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// Only report address and default route changes of this family,
+	// both families are reported when unspecified
+	Family        AddressFamily `protobuf:"varint,2,opt,name=family,proto3,enum=AddressFamily" json:"family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNetworkRequest) Reset() {
+	*x = WatchNetworkRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNetworkRequest) ProtoMessage() {}
+
+func (x *WatchNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNetworkRequest.ProtoReflect.Descriptor instead.
+func (*WatchNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WatchNetworkRequest) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *WatchNetworkRequest) GetFamily() AddressFamily {
+	if x != nil {
+		return x.Family
+	}
+	return AddressFamily_ADDRESS_FAMILY_UNSPECIFIED
+}
+
+type WatchNetworkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*WatchNetworkResponse_Snapshot_
+	//	*WatchNetworkResponse_Event_
+	Type          isWatchNetworkResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNetworkResponse) Reset() {
+	*x = WatchNetworkResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNetworkResponse) ProtoMessage() {}
+
+func (x *WatchNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNetworkResponse.ProtoReflect.Descriptor instead.
+func (*WatchNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchNetworkResponse) GetType() isWatchNetworkResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *WatchNetworkResponse) GetSnapshot() *WatchNetworkResponse_Snapshot {
+	if x != nil {
+		if x, ok := x.Type.(*WatchNetworkResponse_Snapshot_); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *WatchNetworkResponse) GetEvent() *WatchNetworkResponse_Event {
+	if x != nil {
+		if x, ok := x.Type.(*WatchNetworkResponse_Event_); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isWatchNetworkResponse_Type interface {
+	isWatchNetworkResponse_Type()
+}
+
+type WatchNetworkResponse_Snapshot_ struct {
+	Snapshot *WatchNetworkResponse_Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchNetworkResponse_Event_ struct {
+	Event *WatchNetworkResponse_Event `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+func (*WatchNetworkResponse_Snapshot_) isWatchNetworkResponse_Type() {}
+
+func (*WatchNetworkResponse_Event_) isWatchNetworkResponse_Type() {}
+
+// Despite its name, ForwardTCP can also forward connections to unix domain sockets
+type ForwardTCPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ForwardTCPRequest_Connect_
+	//	*ForwardTCPRequest_Data
+	Type          isForwardTCPRequest_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardTCPRequest) Reset() {
+	*x = ForwardTCPRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardTCPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardTCPRequest) ProtoMessage() {}
+
+func (x *ForwardTCPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardTCPRequest.ProtoReflect.Descriptor instead.
+func (*ForwardTCPRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ForwardTCPRequest) GetType() isForwardTCPRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ForwardTCPRequest) GetConnect() *ForwardTCPRequest_Connect {
+	if x != nil {
+		if x, ok := x.Type.(*ForwardTCPRequest_Connect_); ok {
+			return x.Connect
+		}
+	}
+	return nil
+}
+
+func (x *ForwardTCPRequest) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*ForwardTCPRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isForwardTCPRequest_Type interface {
+	isForwardTCPRequest_Type()
+}
+
+type ForwardTCPRequest_Connect_ struct {
+	// Should be sent first
+	Connect *ForwardTCPRequest_Connect `protobuf:"bytes,1,opt,name=connect,proto3,oneof"`
+}
+
+type ForwardTCPRequest_Data struct {
+	// Data to write to the connection, an empty
+	// chunk half-closes the connection for writing
+	Data *IOChunk `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ForwardTCPRequest_Connect_) isForwardTCPRequest_Type() {}
+
+func (*ForwardTCPRequest_Data) isForwardTCPRequest_Type() {}
+
+type ForwardTCPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ForwardTCPResponse_Connected_
+	//	*ForwardTCPResponse_Data
+	Type          isForwardTCPResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardTCPResponse) Reset() {
+	*x = ForwardTCPResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardTCPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardTCPResponse) ProtoMessage() {}
+
+func (x *ForwardTCPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardTCPResponse.ProtoReflect.Descriptor instead.
+func (*ForwardTCPResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ForwardTCPResponse) GetType() isForwardTCPResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ForwardTCPResponse) GetConnected() *ForwardTCPResponse_Connected {
+	if x != nil {
+		if x, ok := x.Type.(*ForwardTCPResponse_Connected_); ok {
+			return x.Connected
+		}
+	}
+	return nil
+}
+
+func (x *ForwardTCPResponse) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*ForwardTCPResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isForwardTCPResponse_Type interface {
+	isForwardTCPResponse_Type()
+}
+
+type ForwardTCPResponse_Connected_ struct {
+	// Sent once the connection is established
+	Connected *ForwardTCPResponse_Connected `protobuf:"bytes,1,opt,name=connected,proto3,oneof"`
+}
+
+type ForwardTCPResponse_Data struct {
+	// Data read from the connection, an empty chunk means
+	// that the remote end has closed the connection for writing
+	Data *IOChunk `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ForwardTCPResponse_Connected_) isForwardTCPResponse_Type() {}
+
+func (*ForwardTCPResponse_Data) isForwardTCPResponse_Type() {}
+
+type ReverseForwardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The listener is closed once the stream is cancelled
+	//
+	// Types that are valid to be assigned to Target:
+	//
+	//	*ReverseForwardRequest_Address
+	//	*ReverseForwardRequest_UnixSocketPath
+	Target isReverseForwardRequest_Target `protobuf_oneof:"target"`
+	// Permissions of the socket file created for unix_socket_path
+	// (e.g. 0600), the process umask applies when unspecified
+	UnixSocketMode uint32 `protobuf:"varint,3,opt,name=unix_socket_mode,json=unixSocketMode,proto3" json:"unix_socket_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReverseForwardRequest) Reset() {
+	*x = ReverseForwardRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardRequest) ProtoMessage() {}
+
+func (x *ReverseForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardRequest.ProtoReflect.Descriptor instead.
+func (*ReverseForwardRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReverseForwardRequest) GetTarget() isReverseForwardRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ReverseForwardRequest) GetAddress() string {
+	if x != nil {
+		if x, ok := x.Target.(*ReverseForwardRequest_Address); ok {
+			return x.Address
+		}
+	}
+	return ""
+}
+
+func (x *ReverseForwardRequest) GetUnixSocketPath() string {
+	if x != nil {
+		if x, ok := x.Target.(*ReverseForwardRequest_UnixSocketPath); ok {
+			return x.UnixSocketPath
+		}
+	}
+	return ""
+}
+
+func (x *ReverseForwardRequest) GetUnixSocketMode() uint32 {
+	if x != nil {
+		return x.UnixSocketMode
+	}
+	return 0
+}
+
+type isReverseForwardRequest_Target interface {
+	isReverseForwardRequest_Target()
+}
+
+type ReverseForwardRequest_Address struct {
+	// TCP address to listen on inside the VM (e.g. "localhost:3128")
+	Address string `protobuf:"bytes,1,opt,name=address,proto3,oneof"`
+}
+
+type ReverseForwardRequest_UnixSocketPath struct {
+	// Path of a unix domain socket to create inside the VM
+	// (e.g. "/tmp/ssh-agent.sock"), a stale socket file left
+	// at this path is replaced, and the socket file is removed
+	// once the listener is closed
+	UnixSocketPath string `protobuf:"bytes,2,opt,name=unix_socket_path,json=unixSocketPath,proto3,oneof"`
+}
+
+func (*ReverseForwardRequest_Address) isReverseForwardRequest_Target() {}
+
+func (*ReverseForwardRequest_UnixSocketPath) isReverseForwardRequest_Target() {}
+
+type ReverseForwardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ReverseForwardResponse_Listening_
+	//	*ReverseForwardResponse_IncomingConnection_
+	Type          isReverseForwardResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseForwardResponse) Reset() {
+	*x = ReverseForwardResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardResponse) ProtoMessage() {}
+
+func (x *ReverseForwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardResponse.ProtoReflect.Descriptor instead.
+func (*ReverseForwardResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReverseForwardResponse) GetType() isReverseForwardResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ReverseForwardResponse) GetListening() *ReverseForwardResponse_Listening {
+	if x != nil {
+		if x, ok := x.Type.(*ReverseForwardResponse_Listening_); ok {
+			return x.Listening
+		}
+	}
+	return nil
+}
+
+func (x *ReverseForwardResponse) GetIncomingConnection() *ReverseForwardResponse_IncomingConnection {
+	if x != nil {
+		if x, ok := x.Type.(*ReverseForwardResponse_IncomingConnection_); ok {
+			return x.IncomingConnection
+		}
+	}
+	return nil
+}
+
+type isReverseForwardResponse_Type interface {
+	isReverseForwardResponse_Type()
+}
+
+type ReverseForwardResponse_Listening_ struct {
+	// Sent first, once the listener is ready
+	Listening *ReverseForwardResponse_Listening `protobuf:"bytes,1,opt,name=listening,proto3,oneof"`
+}
+
+type ReverseForwardResponse_IncomingConnection_ struct {
+	IncomingConnection *ReverseForwardResponse_IncomingConnection `protobuf:"bytes,2,opt,name=incoming_connection,json=incomingConnection,proto3,oneof"`
+}
+
+func (*ReverseForwardResponse_Listening_) isReverseForwardResponse_Type() {}
+
+func (*ReverseForwardResponse_IncomingConnection_) isReverseForwardResponse_Type() {}
+
+type ReverseForwardConnectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ReverseForwardConnectionRequest_Attach_
+	//	*ReverseForwardConnectionRequest_Data
+	Type          isReverseForwardConnectionRequest_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseForwardConnectionRequest) Reset() {
+	*x = ReverseForwardConnectionRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardConnectionRequest) ProtoMessage() {}
+
+func (x *ReverseForwardConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseForwardConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReverseForwardConnectionRequest) GetType() isReverseForwardConnectionRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ReverseForwardConnectionRequest) GetAttach() *ReverseForwardConnectionRequest_Attach {
+	if x != nil {
+		if x, ok := x.Type.(*ReverseForwardConnectionRequest_Attach_); ok {
+			return x.Attach
+		}
+	}
+	return nil
+}
+
+func (x *ReverseForwardConnectionRequest) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*ReverseForwardConnectionRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isReverseForwardConnectionRequest_Type interface {
+	isReverseForwardConnectionRequest_Type()
+}
+
+type ReverseForwardConnectionRequest_Attach_ struct {
+	// Should be sent first
+	Attach *ReverseForwardConnectionRequest_Attach `protobuf:"bytes,1,opt,name=attach,proto3,oneof"`
+}
+
+type ReverseForwardConnectionRequest_Data struct {
+	// Data to write to the connection, an empty
+	// chunk half-closes the connection for writing
+	Data *IOChunk `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ReverseForwardConnectionRequest_Attach_) isReverseForwardConnectionRequest_Type() {}
+
+func (*ReverseForwardConnectionRequest_Data) isReverseForwardConnectionRequest_Type() {}
+
+type ReverseForwardConnectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ReverseForwardConnectionResponse_Data
+	Type          isReverseForwardConnectionResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseForwardConnectionResponse) Reset() {
+	*x = ReverseForwardConnectionResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardConnectionResponse) ProtoMessage() {}
+
+func (x *ReverseForwardConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardConnectionResponse.ProtoReflect.Descriptor instead.
+func (*ReverseForwardConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReverseForwardConnectionResponse) GetType() isReverseForwardConnectionResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ReverseForwardConnectionResponse) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*ReverseForwardConnectionResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isReverseForwardConnectionResponse_Type interface {
+	isReverseForwardConnectionResponse_Type()
+}
+
+type ReverseForwardConnectionResponse_Data struct {
+	// Data read from the connection, an empty chunk means
+	// that the remote end has closed the connection for writing
+	Data *IOChunk `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+func (*ReverseForwardConnectionResponse_Data) isReverseForwardConnectionResponse_Type() {}
+
+type ListeningPort struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IP address the socket is bound to (e.g. "127.0.0.1" or "::")
+	Ip   string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Process that owns the socket, unset when it cannot be
+	// determined (e.g. due to insufficient privileges)
+	Pid           uint32 `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	ProcessName   string `protobuf:"bytes,4,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListeningPort) Reset() {
+	*x = ListeningPort{}
+	mi := &file_rpc_agent_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListeningPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListeningPort) ProtoMessage() {}
+
+func (x *ListeningPort) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListeningPort.ProtoReflect.Descriptor instead.
+func (*ListeningPort) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListeningPort) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ListeningPort) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ListeningPort) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ListeningPort) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+// On macOS, only the ports listened on by the processes of the agent's
+// user are reported, unless the agent is running as root
+type ListListeningPortsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListListeningPortsRequest) Reset() {
+	*x = ListListeningPortsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListeningPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListeningPortsRequest) ProtoMessage() {}
+
+func (x *ListListeningPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListeningPortsRequest.ProtoReflect.Descriptor instead.
+func (*ListListeningPortsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{45}
+}
+
+type ListListeningPortsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ports         []*ListeningPort       `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListListeningPortsResponse) Reset() {
+	*x = ListListeningPortsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListeningPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListeningPortsResponse) ProtoMessage() {}
+
+func (x *ListListeningPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListeningPortsResponse.ProtoReflect.Descriptor instead.
+func (*ListListeningPortsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListListeningPortsResponse) GetPorts() []*ListeningPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// Same limitations as for ListListeningPortsRequest apply
+type WatchListeningPortsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How often to scan for listening ports, 2 seconds
+	// when unspecified and at least 1 second
+	Interval      *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchListeningPortsRequest) Reset() {
+	*x = WatchListeningPortsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchListeningPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchListeningPortsRequest) ProtoMessage() {}
+
+func (x *WatchListeningPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchListeningPortsRequest.ProtoReflect.Descriptor instead.
+func (*WatchListeningPortsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WatchListeningPortsRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type WatchListeningPortsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*WatchListeningPortsResponse_Snapshot_
+	//	*WatchListeningPortsResponse_PortOpened
+	//	*WatchListeningPortsResponse_PortClosed
+	Type          isWatchListeningPortsResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchListeningPortsResponse) Reset() {
+	*x = WatchListeningPortsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchListeningPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchListeningPortsResponse) ProtoMessage() {}
+
+func (x *WatchListeningPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchListeningPortsResponse.ProtoReflect.Descriptor instead.
+func (*WatchListeningPortsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WatchListeningPortsResponse) GetType() isWatchListeningPortsResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *WatchListeningPortsResponse) GetSnapshot() *WatchListeningPortsResponse_Snapshot {
+	if x != nil {
+		if x, ok := x.Type.(*WatchListeningPortsResponse_Snapshot_); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *WatchListeningPortsResponse) GetPortOpened() *ListeningPort {
+	if x != nil {
+		if x, ok := x.Type.(*WatchListeningPortsResponse_PortOpened); ok {
+			return x.PortOpened
+		}
+	}
+	return nil
+}
+
+func (x *WatchListeningPortsResponse) GetPortClosed() *ListeningPort {
+	if x != nil {
+		if x, ok := x.Type.(*WatchListeningPortsResponse_PortClosed); ok {
+			return x.PortClosed
+		}
+	}
+	return nil
+}
+
+type isWatchListeningPortsResponse_Type interface {
+	isWatchListeningPortsResponse_Type()
+}
+
+type WatchListeningPortsResponse_Snapshot_ struct {
+	// Sent first, subsequent messages are relative to this snapshot
+	Snapshot *WatchListeningPortsResponse_Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchListeningPortsResponse_PortOpened struct {
+	PortOpened *ListeningPort `protobuf:"bytes,2,opt,name=port_opened,json=portOpened,proto3,oneof"`
+}
+
+type WatchListeningPortsResponse_PortClosed struct {
+	PortClosed *ListeningPort `protobuf:"bytes,3,opt,name=port_closed,json=portClosed,proto3,oneof"`
+}
+
+func (*WatchListeningPortsResponse_Snapshot_) isWatchListeningPortsResponse_Type() {}
+
+func (*WatchListeningPortsResponse_PortOpened) isWatchListeningPortsResponse_Type() {}
+
+func (*WatchListeningPortsResponse_PortClosed) isWatchListeningPortsResponse_Type() {}
+
+type ProxyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address to listen on inside the VM (e.g. "localhost:1080"), both SOCKS5
+	// and HTTP CONNECT clients are served, and the listener is closed once
+	// the stream is cancelled
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyRequest) Reset() {
+	*x = ProxyRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyRequest) ProtoMessage() {}
+
+func (x *ProxyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyRequest.ProtoReflect.Descriptor instead.
+func (*ProxyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProxyRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ProxyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ProxyResponse_Listening_
+	//	*ProxyResponse_IncomingConnection_
+	Type          isProxyResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyResponse) Reset() {
+	*x = ProxyResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyResponse) ProtoMessage() {}
+
+func (x *ProxyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyResponse.ProtoReflect.Descriptor instead.
+func (*ProxyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ProxyResponse) GetType() isProxyResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ProxyResponse) GetListening() *ProxyResponse_Listening {
+	if x != nil {
+		if x, ok := x.Type.(*ProxyResponse_Listening_); ok {
+			return x.Listening
+		}
+	}
+	return nil
+}
+
+func (x *ProxyResponse) GetIncomingConnection() *ProxyResponse_IncomingConnection {
+	if x != nil {
+		if x, ok := x.Type.(*ProxyResponse_IncomingConnection_); ok {
+			return x.IncomingConnection
+		}
+	}
+	return nil
+}
+
+type isProxyResponse_Type interface {
+	isProxyResponse_Type()
+}
+
+type ProxyResponse_Listening_ struct {
+	// Sent first, once the listener is ready
+	Listening *ProxyResponse_Listening `protobuf:"bytes,1,opt,name=listening,proto3,oneof"`
+}
+
+type ProxyResponse_IncomingConnection_ struct {
+	IncomingConnection *ProxyResponse_IncomingConnection `protobuf:"bytes,2,opt,name=incoming_connection,json=incomingConnection,proto3,oneof"`
+}
+
+func (*ProxyResponse_Listening_) isProxyResponse_Type() {}
+
+func (*ProxyResponse_IncomingConnection_) isProxyResponse_Type() {}
+
+type ProxyConnectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ProxyConnectionRequest_Accept_
+	//	*ProxyConnectionRequest_Reject_
+	//	*ProxyConnectionRequest_Data
+	Type          isProxyConnectionRequest_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyConnectionRequest) Reset() {
+	*x = ProxyConnectionRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConnectionRequest) ProtoMessage() {}
+
+func (x *ProxyConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConnectionRequest.ProtoReflect.Descriptor instead.
+func (*ProxyConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ProxyConnectionRequest) GetType() isProxyConnectionRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ProxyConnectionRequest) GetAccept() *ProxyConnectionRequest_Accept {
+	if x != nil {
+		if x, ok := x.Type.(*ProxyConnectionRequest_Accept_); ok {
+			return x.Accept
+		}
+	}
+	return nil
+}
+
+func (x *ProxyConnectionRequest) GetReject() *ProxyConnectionRequest_Reject {
+	if x != nil {
+		if x, ok := x.Type.(*ProxyConnectionRequest_Reject_); ok {
+			return x.Reject
+		}
+	}
+	return nil
+}
+
+func (x *ProxyConnectionRequest) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*ProxyConnectionRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isProxyConnectionRequest_Type interface {
+	isProxyConnectionRequest_Type()
+}
+
+type ProxyConnectionRequest_Accept_ struct {
+	// Either accept or reject should be sent first,
+	// the stream is finished after a rejection
+	Accept *ProxyConnectionRequest_Accept `protobuf:"bytes,1,opt,name=accept,proto3,oneof"`
+}
+
+type ProxyConnectionRequest_Reject_ struct {
+	Reject *ProxyConnectionRequest_Reject `protobuf:"bytes,2,opt,name=reject,proto3,oneof"`
+}
+
+type ProxyConnectionRequest_Data struct {
+	// Data to write to the connection, an empty
+	// chunk half-closes the connection for writing
+	Data *IOChunk `protobuf:"bytes,3,opt,name=data,proto3,oneof"`
+}
+
+func (*ProxyConnectionRequest_Accept_) isProxyConnectionRequest_Type() {}
+
+func (*ProxyConnectionRequest_Reject_) isProxyConnectionRequest_Type() {}
+
+func (*ProxyConnectionRequest_Data) isProxyConnectionRequest_Type() {}
+
+type ProxyConnectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ProxyConnectionResponse_Data
+	Type          isProxyConnectionResponse_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyConnectionResponse) Reset() {
+	*x = ProxyConnectionResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConnectionResponse) ProtoMessage() {}
+
+func (x *ProxyConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConnectionResponse.ProtoReflect.Descriptor instead.
+func (*ProxyConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ProxyConnectionResponse) GetType() isProxyConnectionResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ProxyConnectionResponse) GetData() *IOChunk {
+	if x != nil {
+		if x, ok := x.Type.(*ProxyConnectionResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isProxyConnectionResponse_Type interface {
+	isProxyConnectionResponse_Type()
+}
+
+type ProxyConnectionResponse_Data struct {
+	// Data read from the connection, an empty chunk means
+	// that the remote end has closed the connection for writing
+	Data *IOChunk `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+func (*ProxyConnectionResponse_Data) isProxyConnectionResponse_Type() {}
+
+type RenewNetworkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Interface to renew the DHCP lease for (e.g. "en0"),
+	// the one holding the IPv4 default route when unspecified
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// How long to wait for the interface to hold an IPv4 address after
+	// the renewal, which is usually the same address as before, but might
+	// be missing for a while when the old lease was released first, 30
+	// seconds when unspecified
+	WaitTimeout   *durationpb.Duration `protobuf:"bytes,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewNetworkRequest) Reset() {
+	*x = RenewNetworkRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewNetworkRequest) ProtoMessage() {}
+
+func (x *RenewNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewNetworkRequest.ProtoReflect.Descriptor instead.
+func (*RenewNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RenewNetworkRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *RenewNetworkRequest) GetWaitTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WaitTimeout
+	}
+	return nil
+}
+
+type RenewNetworkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mechanism used to renew the lease (e.g. "systemd-networkd",
+	// "NetworkManager", "dhclient" or "ipconfig")
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// State of the interface after the renewal
+	Interface     *NetworkInterface `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewNetworkResponse) Reset() {
+	*x = RenewNetworkResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewNetworkResponse) ProtoMessage() {}
+
+func (x *RenewNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewNetworkResponse.ProtoReflect.Descriptor instead.
+func (*RenewNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RenewNetworkResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RenewNetworkResponse) GetInterface() *NetworkInterface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type ConfigureNetworkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Interface to configure (e.g. "enp0s1")
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Obtain an IPv4 address via DHCP in addition to the static addresses
+	Dhcp4 bool `protobuf:"varint,2,opt,name=dhcp4,proto3" json:"dhcp4,omitempty"`
+	// Static addresses with prefix lengths (e.g. "192.168.64.10/24")
+	Addresses     []string                         `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Routes        []*ConfigureNetworkRequest_Route `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes,omitempty"`
+	DnsServers    []string                         `protobuf:"bytes,5,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
+	SearchDomains []string                         `protobuf:"bytes,6,rep,name=search_domains,json=searchDomains,proto3" json:"search_domains,omitempty"`
+	// MTU is left intact when unspecified
+	Mtu uint32 `protobuf:"varint,7,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// Mechanism to use ("netplan", "systemd-networkd" or "NetworkManager"
+	// on Linux and "networksetup" on macOS), auto-detected when unspecified
+	Backend string `protobuf:"bytes,8,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Only render the configuration without applying it
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Once the configuration is applied, the static addresses should get
+	// assigned and these TCP addresses (e.g. "192.168.64.1:22") should become
+	// reachable within the check_timeout (30 seconds when unspecified),
+	// otherwise the previous configuration is restored
+	CheckAddresses []string             `protobuf:"bytes,10,rep,name=check_addresses,json=checkAddresses,proto3" json:"check_addresses,omitempty"`
+	CheckTimeout   *durationpb.Duration `protobuf:"bytes,11,opt,name=check_timeout,json=checkTimeout,proto3" json:"check_timeout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfigureNetworkRequest) Reset() {
+	*x = ConfigureNetworkRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureNetworkRequest) ProtoMessage() {}
+
+func (x *ConfigureNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureNetworkRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ConfigureNetworkRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkRequest) GetDhcp4() bool {
+	if x != nil {
+		return x.Dhcp4
+	}
+	return false
+}
+
+func (x *ConfigureNetworkRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkRequest) GetRoutes() []*ConfigureNetworkRequest_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkRequest) GetDnsServers() []string {
+	if x != nil {
+		return x.DnsServers
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkRequest) GetSearchDomains() []string {
+	if x != nil {
+		return x.SearchDomains
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkRequest) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *ConfigureNetworkRequest) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ConfigureNetworkRequest) GetCheckAddresses() []string {
+	if x != nil {
+		return x.CheckAddresses
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkRequest) GetCheckTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.CheckTimeout
+	}
+	return nil
+}
+
+type ConfigureNetworkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mechanism that was used
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Rendered configuration files and commands that
+	// were (or would be, in case of dry_run) applied
+	Files    []*ConfigureNetworkResponse_File    `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Commands []*ConfigureNetworkResponse_Command `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Set when the configuration was rolled back
+	// because the connectivity check has failed
+	CheckError string `protobuf:"bytes,4,opt,name=check_error,json=checkError,proto3" json:"check_error,omitempty"`
+	// State of the interface after applying the configuration,
+	// unset in case of dry_run
+	Interface     *NetworkInterface `protobuf:"bytes,5,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureNetworkResponse) Reset() {
+	*x = ConfigureNetworkResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureNetworkResponse) ProtoMessage() {}
+
+func (x *ConfigureNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureNetworkResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ConfigureNetworkResponse) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkResponse) GetFiles() []*ConfigureNetworkResponse_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkResponse) GetCommands() []*ConfigureNetworkResponse_Command {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkResponse) GetCheckError() string {
+	if x != nil {
+		return x.CheckError
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkResponse) GetInterface() *NetworkInterface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type HostsEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Hostnames     []string               `protobuf:"bytes,2,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostsEntry) Reset() {
+	*x = HostsEntry{}
+	mi := &file_rpc_agent_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostsEntry) ProtoMessage() {}
+
+func (x *HostsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostsEntry.ProtoReflect.Descriptor instead.
+func (*HostsEntry) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *HostsEntry) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *HostsEntry) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+type GetHostsEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostsEntriesRequest) Reset() {
+	*x = GetHostsEntriesRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostsEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostsEntriesRequest) ProtoMessage() {}
+
+func (x *GetHostsEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostsEntriesRequest.ProtoReflect.Descriptor instead.
+func (*GetHostsEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{58}
+}
+
+type GetHostsEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only the entries managed by the agent, which are
+	// kept between the marker comments in /etc/hosts
+	Entries       []*HostsEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostsEntriesResponse) Reset() {
+	*x = GetHostsEntriesResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostsEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostsEntriesResponse) ProtoMessage() {}
+
+func (x *GetHostsEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostsEntriesResponse.ProtoReflect.Descriptor instead.
+func (*GetHostsEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetHostsEntriesResponse) GetEntries() []*HostsEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetHostsEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Replaces all the entries managed by the agent,
+	// specifying no entries removes them altogether
+	Entries       []*HostsEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHostsEntriesRequest) Reset() {
+	*x = SetHostsEntriesRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHostsEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHostsEntriesRequest) ProtoMessage() {}
+
+func (x *SetHostsEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHostsEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SetHostsEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetHostsEntriesRequest) GetEntries() []*HostsEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetHostsEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHostsEntriesResponse) Reset() {
+	*x = SetHostsEntriesResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHostsEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHostsEntriesResponse) ProtoMessage() {}
+
+func (x *SetHostsEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHostsEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SetHostsEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{61}
+}
+
+type ResolverConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nameservers   []string               `protobuf:"bytes,1,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	SearchDomains []string               `protobuf:"bytes,2,rep,name=search_domains,json=searchDomains,proto3" json:"search_domains,omitempty"`
+	// resolv.conf(5) options (e.g. "ndots:2"), Linux-only
+	Options       []string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolverConfig) Reset() {
+	*x = ResolverConfig{}
+	mi := &file_rpc_agent_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolverConfig) ProtoMessage() {}
+
+func (x *ResolverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolverConfig.ProtoReflect.Descriptor instead.
+func (*ResolverConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ResolverConfig) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+func (x *ResolverConfig) GetSearchDomains() []string {
+	if x != nil {
+		return x.SearchDomains
+	}
+	return nil
+}
+
+func (x *ResolverConfig) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Resolver configuration that only applies to the names within a domain,
+// stored in /etc/resolver (see resolver(5)), macOS-only
+type ScopedResolverConfig struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Domain      string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Nameservers []string               `protobuf:"bytes,2,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	// Default port is used when unspecified
+	Port          uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScopedResolverConfig) Reset() {
+	*x = ScopedResolverConfig{}
+	mi := &file_rpc_agent_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScopedResolverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScopedResolverConfig) ProtoMessage() {}
+
+func (x *ScopedResolverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScopedResolverConfig.ProtoReflect.Descriptor instead.
+func (*ScopedResolverConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ScopedResolverConfig) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScopedResolverConfig) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+func (x *ScopedResolverConfig) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type GetResolverConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResolverConfigRequest) Reset() {
+	*x = GetResolverConfigRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResolverConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResolverConfigRequest) ProtoMessage() {}
+
+func (x *GetResolverConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResolverConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetResolverConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{64}
+}
+
+type GetResolverConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Read through the same mechanism SetResolverConfig uses, so that it can
+	// be modified and written back: the configuration set via systemd-resolved
+	// or its upstream servers instead of the stub resolver on Linux, and the DNS
+	// settings of the first network service on macOS, falling back to the ones
+	// obtained via DHCP when none are set
+	Global        *ResolverConfig         `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	Scoped        []*ScopedResolverConfig `protobuf:"bytes,2,rep,name=scoped,proto3" json:"scoped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResolverConfigResponse) Reset() {
+	*x = GetResolverConfigResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResolverConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResolverConfigResponse) ProtoMessage() {}
+
+func (x *GetResolverConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResolverConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetResolverConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetResolverConfigResponse) GetGlobal() *ResolverConfig {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *GetResolverConfigResponse) GetScoped() []*ScopedResolverConfig {
+	if x != nil {
+		return x.Scoped
+	}
+	return nil
+}
+
+type SetResolverConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*SetResolverConfigRequest_Global
+	//	*SetResolverConfigRequest_Scoped
+	//	*SetResolverConfigRequest_RemoveScopedDomain
+	Type          isSetResolverConfigRequest_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResolverConfigRequest) Reset() {
+	*x = SetResolverConfigRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResolverConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResolverConfigRequest) ProtoMessage() {}
+
+func (x *SetResolverConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResolverConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetResolverConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetResolverConfigRequest) GetType() isSetResolverConfigRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *SetResolverConfigRequest) GetGlobal() *ResolverConfig {
+	if x != nil {
+		if x, ok := x.Type.(*SetResolverConfigRequest_Global); ok {
+			return x.Global
+		}
+	}
+	return nil
+}
+
+func (x *SetResolverConfigRequest) GetScoped() *ScopedResolverConfig {
+	if x != nil {
+		if x, ok := x.Type.(*SetResolverConfigRequest_Scoped); ok {
+			return x.Scoped
+		}
+	}
+	return nil
+}
+
+func (x *SetResolverConfigRequest) GetRemoveScopedDomain() string {
+	if x != nil {
+		if x, ok := x.Type.(*SetResolverConfigRequest_RemoveScopedDomain); ok {
+			return x.RemoveScopedDomain
+		}
+	}
+	return ""
+}
+
+type isSetResolverConfigRequest_Type interface {
+	isSetResolverConfigRequest_Type()
+}
+
+type SetResolverConfigRequest_Global struct {
+	// Replaces the system-wide configuration: /etc/resolv.conf or
+	// systemd-resolved's global settings on Linux, and the DNS
+	// settings of all network services on macOS
+	Global *ResolverConfig `protobuf:"bytes,1,opt,name=global,proto3,oneof"`
+}
+
+type SetResolverConfigRequest_Scoped struct {
+	// Creates or replaces the configuration for a domain
+	Scoped *ScopedResolverConfig `protobuf:"bytes,2,opt,name=scoped,proto3,oneof"`
+}
+
+type SetResolverConfigRequest_RemoveScopedDomain struct {
+	// Removes the configuration for a domain
+	RemoveScopedDomain string `protobuf:"bytes,3,opt,name=remove_scoped_domain,json=removeScopedDomain,proto3,oneof"`
+}
+
+func (*SetResolverConfigRequest_Global) isSetResolverConfigRequest_Type() {}
+
+func (*SetResolverConfigRequest_Scoped) isSetResolverConfigRequest_Type() {}
+
+func (*SetResolverConfigRequest_RemoveScopedDomain) isSetResolverConfigRequest_Type() {}
+
+type SetResolverConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResolverConfigResponse) Reset() {
+	*x = SetResolverConfigResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResolverConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResolverConfigResponse) ProtoMessage() {}
+
+func (x *SetResolverConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResolverConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetResolverConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{67}
+}
+
+type GetSystemInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoRequest) Reset() {
+	*x = GetSystemInfoRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoRequest) ProtoMessage() {}
+
+func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{68}
+}
+
+type GetSystemInfoResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Os            *GetSystemInfoResponse_OS     `protobuf:"bytes,1,opt,name=os,proto3" json:"os,omitempty"`
+	KernelVersion string                        `protobuf:"bytes,2,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	Architecture  string                        `protobuf:"bytes,3,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Hostname      string                        `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	BootTime      *timestamppb.Timestamp        `protobuf:"bytes,5,opt,name=boot_time,json=bootTime,proto3" json:"boot_time,omitempty"`
+	Uptime        *durationpb.Duration          `protobuf:"bytes,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Cpu           *GetSystemInfoResponse_CPU    `protobuf:"bytes,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        *GetSystemInfoResponse_Memory `protobuf:"bytes,8,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Empty when nobody is logged in on the console
+	ConsoleUser string                       `protobuf:"bytes,9,opt,name=console_user,json=consoleUser,proto3" json:"console_user,omitempty"`
+	Agent       *GetSystemInfoResponse_Agent `protobuf:"bytes,10,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Empty when the Tart version cannot be detected
+	TartVersion   string `protobuf:"bytes,11,opt,name=tart_version,json=tartVersion,proto3" json:"tart_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse) Reset() {
+	*x = GetSystemInfoResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetSystemInfoResponse) GetOs() *GetSystemInfoResponse_OS {
+	if x != nil {
+		return x.Os
+	}
+	return nil
+}
+
+func (x *GetSystemInfoResponse) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse) GetBootTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BootTime
+	}
+	return nil
+}
+
+func (x *GetSystemInfoResponse) GetUptime() *durationpb.Duration {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+func (x *GetSystemInfoResponse) GetCpu() *GetSystemInfoResponse_CPU {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *GetSystemInfoResponse) GetMemory() *GetSystemInfoResponse_Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *GetSystemInfoResponse) GetConsoleUser() string {
+	if x != nil {
+		return x.ConsoleUser
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse) GetAgent() *GetSystemInfoResponse_Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *GetSystemInfoResponse) GetTartVersion() string {
+	if x != nil {
+		return x.TartVersion
+	}
+	return ""
+}
+
+type GetCapabilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{70}
+}
+
+type GetCapabilitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of this response's schema
+	Version      uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	AgentVersion string `protobuf:"bytes,2,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	// Names of the RPCs implemented by the agent, e.g. "Exec"
+	Rpcs []string `protobuf:"bytes,3,rep,name=rpcs,proto3" json:"rpcs,omitempty"`
+	// Feature versions, each of which is incremented whenever
+	// new fields or behaviors are added to the feature, only
+	// the features available on the guest's platform and to
+	// the agent's user are listed, e.g. "shutdown", "clock"
+	// and "identity-reset" are only listed when running as root
+	Features map[string]uint32 `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Components the agent was started with, e.g. "rpc" and "vdagent"
+	Components    []string                        `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+	Limits        *GetCapabilitiesResponse_Limits `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetCapabilitiesResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse) GetAgentVersion() string {
+	if x != nil {
+		return x.AgentVersion
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesResponse) GetRpcs() []string {
+	if x != nil {
+		return x.Rpcs
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetFeatures() map[string]uint32 {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetLimits() *GetCapabilitiesResponse_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type StreamMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 5 seconds, intervals shorter
+	// than a second are rounded up to a second
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Number of the top processes by CPU and memory usage to report, defaults to 5
+	TopProcesses  uint32 `protobuf:"varint,2,opt,name=top_processes,json=topProcesses,proto3" json:"top_processes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMetricsRequest) Reset() {
+	*x = StreamMetricsRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsRequest) ProtoMessage() {}
+
+func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StreamMetricsRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *StreamMetricsRequest) GetTopProcesses() uint32 {
+	if x != nil {
+		return x.TopProcesses
+	}
+	return 0
+}
+
+type StreamMetricsResponse struct {
+	state                protoimpl.MessageState                    `protogen:"open.v1"`
+	Time                 *timestamppb.Timestamp                    `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Cpu                  *StreamMetricsResponse_CPU                `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	LoadAverage          *StreamMetricsResponse_LoadAverage        `protobuf:"bytes,3,opt,name=load_average,json=loadAverage,proto3" json:"load_average,omitempty"`
+	Memory               *StreamMetricsResponse_Memory             `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Volumes              []*StreamMetricsResponse_Volume           `protobuf:"bytes,5,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Disks                []*StreamMetricsResponse_Disk             `protobuf:"bytes,6,rep,name=disks,proto3" json:"disks,omitempty"`
+	NetworkInterfaces    []*StreamMetricsResponse_NetworkInterface `protobuf:"bytes,7,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty"`
+	TopProcessesByCpu    []*StreamMetricsResponse_Process          `protobuf:"bytes,8,rep,name=top_processes_by_cpu,json=topProcessesByCpu,proto3" json:"top_processes_by_cpu,omitempty"`
+	TopProcessesByMemory []*StreamMetricsResponse_Process          `protobuf:"bytes,9,rep,name=top_processes_by_memory,json=topProcessesByMemory,proto3" json:"top_processes_by_memory,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse) Reset() {
+	*x = StreamMetricsResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse) ProtoMessage() {}
+
+func (x *StreamMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StreamMetricsResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetCpu() *StreamMetricsResponse_CPU {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetLoadAverage() *StreamMetricsResponse_LoadAverage {
+	if x != nil {
+		return x.LoadAverage
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetMemory() *StreamMetricsResponse_Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetVolumes() []*StreamMetricsResponse_Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetDisks() []*StreamMetricsResponse_Disk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetNetworkInterfaces() []*StreamMetricsResponse_NetworkInterface {
+	if x != nil {
+		return x.NetworkInterfaces
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetTopProcessesByCpu() []*StreamMetricsResponse_Process {
+	if x != nil {
+		return x.TopProcessesByCpu
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetTopProcessesByMemory() []*StreamMetricsResponse_Process {
+	if x != nil {
+		return x.TopProcessesByMemory
+	}
+	return nil
+}
+
+type Process struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pid   int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid  int32                  `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Uid   uint32                 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	User  string                 `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	// Basename of the first argument (e.g. "sshd" for "/usr/sbin/sshd"),
+	// or the kernel's process name, which is truncated to 15 characters
+	// on Linux and 16 on macOS, when the arguments are not available or
+	// the process has replaced its first argument with a title
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// Might be empty when the agent has no permission
+	// to inspect the process or for kernel threads
+	Args []string `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"`
+	// E.g. "running", "sleeping", "stopped" or "zombie"
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CpuTime       *durationpb.Duration   `protobuf:"bytes,9,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	ResidentBytes uint64                 `protobuf:"varint,10,opt,name=resident_bytes,json=residentBytes,proto3" json:"resident_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Process) Reset() {
+	*x = Process{}
+	mi := &file_rpc_agent_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Process) ProtoMessage() {}
+
+func (x *Process) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Process.ProtoReflect.Descriptor instead.
+func (*Process) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Process) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Process) GetPpid() int32 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *Process) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *Process) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Process) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Process) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Process) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Process) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Process) GetCpuTime() *durationpb.Duration {
+	if x != nil {
+		return x.CpuTime
+	}
+	return nil
+}
+
+func (x *Process) GetResidentBytes() uint64 {
+	if x != nil {
+		return x.ResidentBytes
+	}
+	return 0
+}
+
+type ListProcessesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return processes owned by these users, all users when empty
+	Users []string `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Only return processes with these names, all processes when empty,
+	// see Process.name for what the name is
+	Names         []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProcessesRequest) Reset() {
+	*x = ListProcessesRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProcessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessesRequest) ProtoMessage() {}
+
+func (x *ListProcessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessesRequest.ProtoReflect.Descriptor instead.
+func (*ListProcessesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListProcessesRequest) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListProcessesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ListProcessesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Processes     []*Process             `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProcessesResponse) Reset() {
+	*x = ListProcessesResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProcessesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessesResponse) ProtoMessage() {}
+
+func (x *ListProcessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessesResponse.ProtoReflect.Descriptor instead.
+func (*ListProcessesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListProcessesResponse) GetProcesses() []*Process {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type SignalProcessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PID 1 and the agent itself cannot be signalled
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Signal name, e.g. "SIGKILL" or "KILL", defaults to "SIGTERM"
+	Signal string `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	// When specified, the process is only signalled if it was started
+	// at this time (e.g. as reported by ListProcesses), otherwise
+	// FAILED_PRECONDITION is returned, which protects against
+	// signalling an unrelated process that has reused the PID
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalProcessRequest) Reset() {
+	*x = SignalProcessRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalProcessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalProcessRequest) ProtoMessage() {}
+
+func (x *SignalProcessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalProcessRequest.ProtoReflect.Descriptor instead.
+func (*SignalProcessRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SignalProcessRequest) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *SignalProcessRequest) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *SignalProcessRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+type SignalProcessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalProcessResponse) Reset() {
+	*x = SignalProcessResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalProcessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalProcessResponse) ProtoMessage() {}
+
+func (x *SignalProcessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalProcessResponse.ProtoReflect.Descriptor instead.
+func (*SignalProcessResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{78}
+}
+
+type WaitForCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*WaitForCondition_PathExists
+	//	*WaitForCondition_PortListening
+	//	*WaitForCondition_ProcessRunning
+	//	*WaitForCondition_AddressAssigned_
+	//	*WaitForCondition_UserLoggedIn
+	//	*WaitForCondition_ServiceActive
+	Type          isWaitForCondition_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForCondition) Reset() {
+	*x = WaitForCondition{}
+	mi := &file_rpc_agent_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForCondition) ProtoMessage() {}
+
+func (x *WaitForCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForCondition.ProtoReflect.Descriptor instead.
+func (*WaitForCondition) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WaitForCondition) GetType() isWaitForCondition_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *WaitForCondition) GetPathExists() string {
+	if x != nil {
+		if x, ok := x.Type.(*WaitForCondition_PathExists); ok {
+			return x.PathExists
+		}
+	}
+	return ""
+}
+
+func (x *WaitForCondition) GetPortListening() uint32 {
+	if x != nil {
+		if x, ok := x.Type.(*WaitForCondition_PortListening); ok {
+			return x.PortListening
+		}
+	}
+	return 0
+}
+
+func (x *WaitForCondition) GetProcessRunning() string {
+	if x != nil {
+		if x, ok := x.Type.(*WaitForCondition_ProcessRunning); ok {
+			return x.ProcessRunning
+		}
+	}
+	return ""
+}
+
+func (x *WaitForCondition) GetAddressAssigned() *WaitForCondition_AddressAssigned {
+	if x != nil {
+		if x, ok := x.Type.(*WaitForCondition_AddressAssigned_); ok {
+			return x.AddressAssigned
+		}
+	}
+	return nil
+}
+
+func (x *WaitForCondition) GetUserLoggedIn() string {
+	if x != nil {
+		if x, ok := x.Type.(*WaitForCondition_UserLoggedIn); ok {
+			return x.UserLoggedIn
+		}
+	}
+	return ""
+}
+
+func (x *WaitForCondition) GetServiceActive() string {
+	if x != nil {
+		if x, ok := x.Type.(*WaitForCondition_ServiceActive); ok {
+			return x.ServiceActive
+		}
+	}
+	return ""
+}
+
+type isWaitForCondition_Type interface {
+	isWaitForCondition_Type()
+}
+
+type WaitForCondition_PathExists struct {
+	// A file, a directory or a socket exists at the path
+	PathExists string `protobuf:"bytes,1,opt,name=path_exists,json=pathExists,proto3,oneof"`
+}
+
+type WaitForCondition_PortListening struct {
+	// A TCP port is listened on, on macOS, the ports listened
+	// on by other users' processes are only visible when
+	// the agent runs as root
+	PortListening uint32 `protobuf:"varint,2,opt,name=port_listening,json=portListening,proto3,oneof"`
+}
+
+type WaitForCondition_ProcessRunning struct {
+	// A process with the name (see Process.name) is running
+	ProcessRunning string `protobuf:"bytes,3,opt,name=process_running,json=processRunning,proto3,oneof"`
+}
+
+type WaitForCondition_AddressAssigned_ struct {
+	// A global unicast address is assigned
+	AddressAssigned *WaitForCondition_AddressAssigned `protobuf:"bytes,4,opt,name=address_assigned,json=addressAssigned,proto3,oneof"`
+}
+
+type WaitForCondition_UserLoggedIn struct {
+	// A user is logged in to the GUI session, any user when empty
+	UserLoggedIn string `protobuf:"bytes,5,opt,name=user_logged_in,json=userLoggedIn,proto3,oneof"`
+}
+
+type WaitForCondition_ServiceActive struct {
+	// A systemd unit (Linux) or a launchd job (macOS) is active, launchd
+	// jobs are looked up in the system domain unless a service target
+	// is specified, e.g. "gui/501/com.example.job"
+	ServiceActive string `protobuf:"bytes,6,opt,name=service_active,json=serviceActive,proto3,oneof"`
+}
+
+func (*WaitForCondition_PathExists) isWaitForCondition_Type() {}
+
+func (*WaitForCondition_PortListening) isWaitForCondition_Type() {}
+
+func (*WaitForCondition_ProcessRunning) isWaitForCondition_Type() {}
+
+func (*WaitForCondition_AddressAssigned_) isWaitForCondition_Type() {}
+
+func (*WaitForCondition_UserLoggedIn) isWaitForCondition_Type() {}
+
+func (*WaitForCondition_ServiceActive) isWaitForCondition_Type() {}
+
+type WaitForRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Conditions []*WaitForCondition    `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// Waits until the RPC is cancelled when unspecified
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Defaults to 1 second, intervals shorter
+	// than 250 milliseconds are rounded up
+	PollInterval  *durationpb.Duration `protobuf:"bytes,3,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForRequest) Reset() {
+	*x = WaitForRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForRequest) ProtoMessage() {}
+
+func (x *WaitForRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForRequest.ProtoReflect.Descriptor instead.
+func (*WaitForRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WaitForRequest) GetConditions() []*WaitForCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *WaitForRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *WaitForRequest) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+type WaitForResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether all of the conditions hold, false if the timeout has expired
+	Satisfied     bool                               `protobuf:"varint,1,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	Conditions    []*WaitForResponse_ConditionStatus `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForResponse) Reset() {
+	*x = WaitForResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForResponse) ProtoMessage() {}
+
+func (x *WaitForResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForResponse.ProtoReflect.Descriptor instead.
+func (*WaitForResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *WaitForResponse) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *WaitForResponse) GetConditions() []*WaitForResponse_ConditionStatus {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type ShutdownRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All of the modes except for SHUTDOWN_MODE_LOGOUT_CONSOLE_USER require
+	// the agent to run as root, otherwise PERMISSION_DENIED is returned and
+	// the "shutdown" feature is not reported by GetCapabilities
+	Mode ShutdownMode `protobuf:"varint,1,opt,name=mode,proto3,enum=ShutdownMode" json:"mode,omitempty"`
+	// How long to wait before running the pre-shutdown hooks and
+	// shutting down, at least a second, so that the response
+	// reaches the host before the agent goes away
+	Delay *durationpb.Duration `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	// Broadcasted to the logged-in users when the shutdown is accepted
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Do not run the pre-shutdown hooks
+	SkipHooks     bool `protobuf:"varint,4,opt,name=skip_hooks,json=skipHooks,proto3" json:"skip_hooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownRequest) ProtoMessage() {}
+
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ShutdownRequest) GetMode() ShutdownMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ShutdownMode_SHUTDOWN_MODE_UNSPECIFIED
+}
+
+func (x *ShutdownRequest) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *ShutdownRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ShutdownRequest) GetSkipHooks() bool {
+	if x != nil {
+		return x.SkipHooks
+	}
+	return false
+}
+
+type ShutdownResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the pre-shutdown hooks will be run and the system will be
+	// shut down, the response is sent before that, so the host can tell
+	// the accepted shutdown from the agent becoming unreachable
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownResponse) ProtoMessage() {}
+
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ShutdownResponse) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type CancelShutdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelShutdownRequest) Reset() {
+	*x = CancelShutdownRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShutdownRequest) ProtoMessage() {}
+
+func (x *CancelShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShutdownRequest.ProtoReflect.Descriptor instead.
+func (*CancelShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{84}
+}
+
+type CancelShutdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelShutdownResponse) Reset() {
+	*x = CancelShutdownResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShutdownResponse) ProtoMessage() {}
+
+func (x *CancelShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShutdownResponse.ProtoReflect.Descriptor instead.
+func (*CancelShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{85}
+}
+
+// Setting the time requires the agent to run as root, otherwise
+// PERMISSION_DENIED is returned and the "clock" feature is not
+// reported by GetCapabilities, unlike the "watch-clock" one.
+type SetTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Host's current time
+	Time   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Policy SetTimePolicy          `protobuf:"varint,2,opt,name=policy,proto3,enum=SetTimePolicy" json:"policy,omitempty"`
+	// Defaults to 500 milliseconds
+	StepThreshold *durationpb.Duration `protobuf:"bytes,3,opt,name=step_threshold,json=stepThreshold,proto3" json:"step_threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimeRequest) Reset() {
+	*x = SetTimeRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimeRequest) ProtoMessage() {}
+
+func (x *SetTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimeRequest.ProtoReflect.Descriptor instead.
+func (*SetTimeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetTimeRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SetTimeRequest) GetPolicy() SetTimePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return SetTimePolicy_SET_TIME_POLICY_UNSPECIFIED
+}
+
+func (x *SetTimeRequest) GetStepThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.StepThreshold
+	}
+	return nil
+}
+
+type SetTimeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How far the guest's clock was behind (positive)
+	// or ahead (negative) of the requested time
+	Offset *durationpb.Duration `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Whether the clock was stepped rather than slewed
+	Stepped       bool `protobuf:"varint,2,opt,name=stepped,proto3" json:"stepped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimeResponse) Reset() {
+	*x = SetTimeResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimeResponse) ProtoMessage() {}
+
+func (x *SetTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimeResponse.ProtoReflect.Descriptor instead.
+func (*SetTimeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetTimeResponse) GetOffset() *durationpb.Duration {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *SetTimeResponse) GetStepped() bool {
+	if x != nil {
+		return x.Stepped
+	}
+	return false
+}
+
+type WatchClockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 1 second, intervals shorter than
+	// 100 milliseconds are rounded up
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Defaults to 1 second
+	Threshold     *durationpb.Duration `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchClockRequest) Reset() {
+	*x = WatchClockRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchClockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchClockRequest) ProtoMessage() {}
+
+func (x *WatchClockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchClockRequest.ProtoReflect.Descriptor instead.
+func (*WatchClockRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *WatchClockRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *WatchClockRequest) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+type WatchClockResponse struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Kind  WatchClockResponse_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=WatchClockResponse_Kind" json:"kind,omitempty"`
+	Time  *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// How far the wall clock has moved relative to the
+	// monotonic clock, excluding the SetTime adjustments
+	Jump *durationpb.Duration `protobuf:"bytes,3,opt,name=jump,proto3" json:"jump,omitempty"`
+	// How long the guest was suspended for
+	Suspended     *durationpb.Duration `protobuf:"bytes,4,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchClockResponse) Reset() {
+	*x = WatchClockResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchClockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchClockResponse) ProtoMessage() {}
+
+func (x *WatchClockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchClockResponse.ProtoReflect.Descriptor instead.
+func (*WatchClockResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *WatchClockResponse) GetKind() WatchClockResponse_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return WatchClockResponse_KIND_UNSPECIFIED
+}
+
+func (x *WatchClockResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *WatchClockResponse) GetJump() *durationpb.Duration {
+	if x != nil {
+		return x.Jump
+	}
+	return nil
+}
+
+func (x *WatchClockResponse) GetSuspended() *durationpb.Duration {
+	if x != nil {
+		return x.Suspended
+	}
+	return nil
+}
+
+// Resetting the identity requires the agent to run as root, otherwise
+// PERMISSION_DENIED is returned and the "identity-reset" feature is
+// not reported by GetCapabilities.
+type ResetIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hostname to set, derived from the agent's "--hostname-template"
+	// when empty, and left as is if the latter is not specified either.
+	//
+	// It is set even if the identity was already reset for this VM,
+	// e.g. on startup, in which case the rest of the identity is kept.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Reset the identity even if it was already reset for this VM
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetIdentityRequest) Reset() {
+	*x = ResetIdentityRequest{}
+	mi := &file_rpc_agent_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetIdentityRequest) ProtoMessage() {}
+
+func (x *ResetIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetIdentityRequest.ProtoReflect.Descriptor instead.
+func (*ResetIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ResetIdentityRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ResetIdentityRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ResetIdentityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False if the identity was already reset for this VM and the
+	// reset was not forced, even if the explicit hostname was set
+	Performed bool   `protobuf:"varint,1,opt,name=performed,proto3" json:"performed,omitempty"`
+	Hostname  string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// New machine ID, empty on macOS, which has none
+	MachineId     string `protobuf:"bytes,3,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetIdentityResponse) Reset() {
+	*x = ResetIdentityResponse{}
+	mi := &file_rpc_agent_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetIdentityResponse) ProtoMessage() {}
+
+func (x *ResetIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetIdentityResponse.ProtoReflect.Descriptor instead.
+func (*ResetIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ResetIdentityResponse) GetPerformed() bool {
+	if x != nil {
+		return x.Performed
+	}
+	return false
+}
+
+func (x *ResetIdentityResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ResetIdentityResponse) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type ExecRequest_Command struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Interactive   bool                   `protobuf:"varint,3,opt,name=interactive,proto3" json:"interactive,omitempty"`
+	Tty           bool                   `protobuf:"varint,4,opt,name=tty,proto3" json:"tty,omitempty"`
+	TerminalSize  *TerminalSize          `protobuf:"bytes,5,opt,name=terminal_size,json=terminalSize,proto3" json:"terminal_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRequest_Command) Reset() {
+	*x = ExecRequest_Command{}
+	mi := &file_rpc_agent_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRequest_Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRequest_Command) ProtoMessage() {}
+
+func (x *ExecRequest_Command) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRequest_Command.ProtoReflect.Descriptor instead.
+func (*ExecRequest_Command) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ExecRequest_Command) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecRequest_Command) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExecRequest_Command) GetInteractive() bool {
+	if x != nil {
+		return x.Interactive
+	}
+	return false
+}
+
+func (x *ExecRequest_Command) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ExecRequest_Command) GetTerminalSize() *TerminalSize {
+	if x != nil {
+		return x.TerminalSize
+	}
+	return nil
+}
+
+type ExecResponse_Exit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecResponse_Exit) Reset() {
+	*x = ExecResponse_Exit{}
+	mi := &file_rpc_agent_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecResponse_Exit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResponse_Exit) ProtoMessage() {}
+
+func (x *ExecResponse_Exit) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResponse_Exit.ProtoReflect.Descriptor instead.
+func (*ExecResponse_Exit) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ExecResponse_Exit) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type NetworkInterface_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	PrefixLength  uint32                 `protobuf:"varint,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	Family        AddressFamily          `protobuf:"varint,3,opt,name=family,proto3,enum=AddressFamily" json:"family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkInterface_Address) Reset() {
+	*x = NetworkInterface_Address{}
+	mi := &file_rpc_agent_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInterface_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterface_Address) ProtoMessage() {}
+
+func (x *NetworkInterface_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterface_Address.ProtoReflect.Descriptor instead.
+func (*NetworkInterface_Address) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *NetworkInterface_Address) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *NetworkInterface_Address) GetPrefixLength() uint32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+func (x *NetworkInterface_Address) GetFamily() AddressFamily {
+	if x != nil {
+		return x.Family
+	}
+	return AddressFamily_ADDRESS_FAMILY_UNSPECIFIED
+}
+
+// Sent once all the watches are established
+type WatchPathResponse_Ready struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPathResponse_Ready) Reset() {
+	*x = WatchPathResponse_Ready{}
+	mi := &file_rpc_agent_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPathResponse_Ready) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPathResponse_Ready) ProtoMessage() {}
+
+func (x *WatchPathResponse_Ready) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPathResponse_Ready.ProtoReflect.Descriptor instead.
+func (*WatchPathResponse_Ready) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type WatchPathResponse_Event struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Type          WatchPathResponse_Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=WatchPathResponse_Event_Type" json:"type,omitempty"`
+	Path          string                       `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir         bool                         `protobuf:"varint,3,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPathResponse_Event) Reset() {
+	*x = WatchPathResponse_Event{}
+	mi := &file_rpc_agent_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPathResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPathResponse_Event) ProtoMessage() {}
+
+func (x *WatchPathResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPathResponse_Event.ProtoReflect.Descriptor instead.
+func (*WatchPathResponse_Event) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *WatchPathResponse_Event) GetType() WatchPathResponse_Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WatchPathResponse_Event_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPathResponse_Event) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WatchPathResponse_Event) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+type SyncFileRequest_Begin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Size of the blocks into which the existing file is split,
+	// defaults to 64 KiB when not specified, can't exceed 4 MiB
+	BlockSize uint32 `protobuf:"varint,2,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	// Permissions to use when the file doesn't exist yet,
+	// defaults to 0644 when not specified, otherwise
+	// the existing file's permissions are preserved
+	Mode          uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileRequest_Begin) Reset() {
+	*x = SyncFileRequest_Begin{}
+	mi := &file_rpc_agent_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileRequest_Begin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileRequest_Begin) ProtoMessage() {}
+
+func (x *SyncFileRequest_Begin) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileRequest_Begin.ProtoReflect.Descriptor instead.
+func (*SyncFileRequest_Begin) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *SyncFileRequest_Begin) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SyncFileRequest_Begin) GetBlockSize() uint32 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *SyncFileRequest_Begin) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+// Copies a run of blocks from the existing file
+type SyncFileRequest_CopyBlocks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint64                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileRequest_CopyBlocks) Reset() {
+	*x = SyncFileRequest_CopyBlocks{}
+	mi := &file_rpc_agent_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileRequest_CopyBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileRequest_CopyBlocks) ProtoMessage() {}
+
+func (x *SyncFileRequest_CopyBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileRequest_CopyBlocks.ProtoReflect.Descriptor instead.
+func (*SyncFileRequest_CopyBlocks) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *SyncFileRequest_CopyBlocks) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SyncFileRequest_CopyBlocks) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SyncFileRequest_Commit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SHA-256 checksum of the reconstructed file
+	Sha256        []byte `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileRequest_Commit) Reset() {
+	*x = SyncFileRequest_Commit{}
+	mi := &file_rpc_agent_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileRequest_Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileRequest_Commit) ProtoMessage() {}
+
+func (x *SyncFileRequest_Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileRequest_Commit.ProtoReflect.Descriptor instead.
+func (*SyncFileRequest_Commit) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *SyncFileRequest_Commit) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+// Signatures of the existing file's consecutive blocks,
+// each message continues where the previous one left off
+type SyncFileResponse_Signatures struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Blocks        []*SyncFileResponse_Signatures_Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileResponse_Signatures) Reset() {
+	*x = SyncFileResponse_Signatures{}
+	mi := &file_rpc_agent_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileResponse_Signatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileResponse_Signatures) ProtoMessage() {}
+
+func (x *SyncFileResponse_Signatures) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileResponse_Signatures.ProtoReflect.Descriptor instead.
+func (*SyncFileResponse_Signatures) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *SyncFileResponse_Signatures) GetBlocks() []*SyncFileResponse_Signatures_Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+// Sent after all the signatures
+type SyncFileResponse_SignaturesEnd struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BlockSize uint32                 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	// Size of the existing file, the last block may be shorter than the block size
+	Size          uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileResponse_SignaturesEnd) Reset() {
+	*x = SyncFileResponse_SignaturesEnd{}
+	mi := &file_rpc_agent_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileResponse_SignaturesEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileResponse_SignaturesEnd) ProtoMessage() {}
+
+func (x *SyncFileResponse_SignaturesEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileResponse_SignaturesEnd.ProtoReflect.Descriptor instead.
+func (*SyncFileResponse_SignaturesEnd) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *SyncFileResponse_SignaturesEnd) GetBlockSize() uint32 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *SyncFileResponse_SignaturesEnd) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SyncFileResponse_Committed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          uint64                 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileResponse_Committed) Reset() {
+	*x = SyncFileResponse_Committed{}
+	mi := &file_rpc_agent_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileResponse_Committed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileResponse_Committed) ProtoMessage() {}
+
+func (x *SyncFileResponse_Committed) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileResponse_Committed.ProtoReflect.Descriptor instead.
+func (*SyncFileResponse_Committed) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *SyncFileResponse_Committed) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SyncFileResponse_Signatures_Block struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rsync rolling checksum
+	Weak uint32 `protobuf:"varint,1,opt,name=weak,proto3" json:"weak,omitempty"`
+	// SHA-256 checksum
+	Strong        []byte `protobuf:"bytes,2,opt,name=strong,proto3" json:"strong,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFileResponse_Signatures_Block) Reset() {
+	*x = SyncFileResponse_Signatures_Block{}
+	mi := &file_rpc_agent_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFileResponse_Signatures_Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFileResponse_Signatures_Block) ProtoMessage() {}
+
+func (x *SyncFileResponse_Signatures_Block) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFileResponse_Signatures_Block.ProtoReflect.Descriptor instead.
+func (*SyncFileResponse_Signatures_Block) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{10, 0, 0}
+}
+
+func (x *SyncFileResponse_Signatures_Block) GetWeak() uint32 {
+	if x != nil {
+		return x.Weak
+	}
+	return 0
+}
+
+func (x *SyncFileResponse_Signatures_Block) GetStrong() []byte {
+	if x != nil {
+		return x.Strong
+	}
+	return nil
+}
+
+type UploadRequest_Begin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Client-chosen transfer ID that makes the upload resumable,
+	// when empty, the upload is not resumable
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	// Destination path in the guest
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Total size of the file
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Permissions of the file, defaults to 0644 when not specified
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Offset from which to resume the upload, should not exceed
+	// the committed offset returned by QueryTransfer
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Extended attributes to set on the file once it's committed
+	Xattrs        []*ExtendedAttribute `protobuf:"bytes,6,rep,name=xattrs,proto3" json:"xattrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadRequest_Begin) Reset() {
+	*x = UploadRequest_Begin{}
+	mi := &file_rpc_agent_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRequest_Begin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRequest_Begin) ProtoMessage() {}
+
+func (x *UploadRequest_Begin) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRequest_Begin.ProtoReflect.Descriptor instead.
+func (*UploadRequest_Begin) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *UploadRequest_Begin) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *UploadRequest_Begin) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UploadRequest_Begin) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadRequest_Begin) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *UploadRequest_Begin) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadRequest_Begin) GetXattrs() []*ExtendedAttribute {
+	if x != nil {
+		return x.Xattrs
+	}
+	return nil
+}
+
+type UploadRequest_Commit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional SHA-256 checksum of the whole file to verify
+	Sha256        []byte `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadRequest_Commit) Reset() {
+	*x = UploadRequest_Commit{}
+	mi := &file_rpc_agent_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRequest_Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRequest_Commit) ProtoMessage() {}
+
+func (x *UploadRequest_Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRequest_Commit.ProtoReflect.Descriptor instead.
+func (*UploadRequest_Commit) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *UploadRequest_Commit) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+// Sent first
+type DownloadResponse_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          uint64                 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Mode          uint32                 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Xattrs        []*ExtendedAttribute   `protobuf:"bytes,3,rep,name=xattrs,proto3" json:"xattrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadResponse_Metadata) Reset() {
+	*x = DownloadResponse_Metadata{}
+	mi := &file_rpc_agent_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadResponse_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadResponse_Metadata) ProtoMessage() {}
+
+func (x *DownloadResponse_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadResponse_Metadata.ProtoReflect.Descriptor instead.
+func (*DownloadResponse_Metadata) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *DownloadResponse_Metadata) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadResponse_Metadata) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *DownloadResponse_Metadata) GetXattrs() []*ExtendedAttribute {
+	if x != nil {
+		return x.Xattrs
+	}
+	return nil
+}
+
+// Sent after the archive is complete
+type CollectArtifactsResponse_Manifest struct {
+	state             protoimpl.MessageState                       `protogen:"open.v1"`
+	Included          []*CollectArtifactsResponse_Manifest_Entry   `protobuf:"bytes,1,rep,name=included,proto3" json:"included,omitempty"`
+	Skipped           []*CollectArtifactsResponse_Manifest_Skipped `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	UnmatchedPatterns []string                                     `protobuf:"bytes,3,rep,name=unmatched_patterns,json=unmatchedPatterns,proto3" json:"unmatched_patterns,omitempty"`
+	TotalSize         uint64                                       `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CollectArtifactsResponse_Manifest) Reset() {
+	*x = CollectArtifactsResponse_Manifest{}
+	mi := &file_rpc_agent_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectArtifactsResponse_Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectArtifactsResponse_Manifest) ProtoMessage() {}
+
+func (x *CollectArtifactsResponse_Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectArtifactsResponse_Manifest.ProtoReflect.Descriptor instead.
+func (*CollectArtifactsResponse_Manifest) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *CollectArtifactsResponse_Manifest) GetIncluded() []*CollectArtifactsResponse_Manifest_Entry {
+	if x != nil {
+		return x.Included
+	}
+	return nil
+}
+
+func (x *CollectArtifactsResponse_Manifest) GetSkipped() []*CollectArtifactsResponse_Manifest_Skipped {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *CollectArtifactsResponse_Manifest) GetUnmatchedPatterns() []string {
+	if x != nil {
+		return x.UnmatchedPatterns
+	}
+	return nil
+}
+
+func (x *CollectArtifactsResponse_Manifest) GetTotalSize() uint64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CollectArtifactsResponse_Manifest_Entry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size          uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectArtifactsResponse_Manifest_Entry) Reset() {
+	*x = CollectArtifactsResponse_Manifest_Entry{}
+	mi := &file_rpc_agent_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectArtifactsResponse_Manifest_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectArtifactsResponse_Manifest_Entry) ProtoMessage() {}
+
+func (x *CollectArtifactsResponse_Manifest_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectArtifactsResponse_Manifest_Entry.ProtoReflect.Descriptor instead.
+func (*CollectArtifactsResponse_Manifest_Entry) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{35, 0, 0}
+}
+
+func (x *CollectArtifactsResponse_Manifest_Entry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CollectArtifactsResponse_Manifest_Entry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectArtifactsResponse_Manifest_Entry) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CollectArtifactsResponse_Manifest_Skipped struct {
+	state         protoimpl.MessageState                           `protogen:"open.v1"`
+	Path          string                                           `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size          uint64                                           `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Reason        CollectArtifactsResponse_Manifest_Skipped_Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=CollectArtifactsResponse_Manifest_Skipped_Reason" json:"reason,omitempty"`
+	Message       string                                           `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) Reset() {
+	*x = CollectArtifactsResponse_Manifest_Skipped{}
+	mi := &file_rpc_agent_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectArtifactsResponse_Manifest_Skipped) ProtoMessage() {}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectArtifactsResponse_Manifest_Skipped.ProtoReflect.Descriptor instead.
+func (*CollectArtifactsResponse_Manifest_Skipped) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{35, 0, 1}
+}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) GetReason() CollectArtifactsResponse_Manifest_Skipped_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return CollectArtifactsResponse_Manifest_Skipped_REASON_UNSPECIFIED
+}
+
+func (x *CollectArtifactsResponse_Manifest_Skipped) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Sent first, describes the network state at the start of the watch
+type WatchNetworkResponse_Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces    []*NetworkInterface    `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNetworkResponse_Snapshot) Reset() {
+	*x = WatchNetworkResponse_Snapshot{}
+	mi := &file_rpc_agent_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNetworkResponse_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNetworkResponse_Snapshot) ProtoMessage() {}
+
+func (x *WatchNetworkResponse_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNetworkResponse_Snapshot.ProtoReflect.Descriptor instead.
+func (*WatchNetworkResponse_Snapshot) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *WatchNetworkResponse_Snapshot) GetInterfaces() []*NetworkInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type WatchNetworkResponse_Event struct {
+	state protoimpl.MessageState          `protogen:"open.v1"`
+	Type  WatchNetworkResponse_Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=WatchNetworkResponse_Event_Type" json:"type,omitempty"`
+	// Current state of the interface, or its last known state for
+	// TYPE_INTERFACE_REMOVED, for TYPE_DEFAULT_ROUTE_CHANGED this is
+	// the interface that now holds the default route, if any
+	Interface *NetworkInterface `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Set for TYPE_ADDRESS_ADDED and TYPE_ADDRESS_REMOVED
+	Address *NetworkInterface_Address `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Set for TYPE_DEFAULT_ROUTE_CHANGED
+	Family        AddressFamily `protobuf:"varint,4,opt,name=family,proto3,enum=AddressFamily" json:"family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNetworkResponse_Event) Reset() {
+	*x = WatchNetworkResponse_Event{}
+	mi := &file_rpc_agent_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNetworkResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNetworkResponse_Event) ProtoMessage() {}
+
+func (x *WatchNetworkResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNetworkResponse_Event.ProtoReflect.Descriptor instead.
+func (*WatchNetworkResponse_Event) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *WatchNetworkResponse_Event) GetType() WatchNetworkResponse_Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WatchNetworkResponse_Event_TYPE_UNSPECIFIED
+}
+
+func (x *WatchNetworkResponse_Event) GetInterface() *NetworkInterface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+func (x *WatchNetworkResponse_Event) GetAddress() *NetworkInterface_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *WatchNetworkResponse_Event) GetFamily() AddressFamily {
+	if x != nil {
+		return x.Family
+	}
+	return AddressFamily_ADDRESS_FAMILY_UNSPECIFIED
+}
+
+type ForwardTCPRequest_Connect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*ForwardTCPRequest_Connect_Address
+	//	*ForwardTCPRequest_Connect_UnixSocketPath
+	Target isForwardTCPRequest_Connect_Target `protobuf_oneof:"target"`
+	// How long to wait for the connection to be established,
+	// the operating system's default is used when unspecified
+	Timeout       *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardTCPRequest_Connect) Reset() {
+	*x = ForwardTCPRequest_Connect{}
+	mi := &file_rpc_agent_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardTCPRequest_Connect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardTCPRequest_Connect) ProtoMessage() {}
+
+func (x *ForwardTCPRequest_Connect) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardTCPRequest_Connect.ProtoReflect.Descriptor instead.
+func (*ForwardTCPRequest_Connect) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *ForwardTCPRequest_Connect) GetTarget() isForwardTCPRequest_Connect_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ForwardTCPRequest_Connect) GetAddress() string {
+	if x != nil {
+		if x, ok := x.Target.(*ForwardTCPRequest_Connect_Address); ok {
+			return x.Address
+		}
+	}
+	return ""
+}
+
+func (x *ForwardTCPRequest_Connect) GetUnixSocketPath() string {
+	if x != nil {
+		if x, ok := x.Target.(*ForwardTCPRequest_Connect_UnixSocketPath); ok {
+			return x.UnixSocketPath
+		}
+	}
+	return ""
+}
+
+func (x *ForwardTCPRequest_Connect) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type isForwardTCPRequest_Connect_Target interface {
+	isForwardTCPRequest_Connect_Target()
+}
+
+type ForwardTCPRequest_Connect_Address struct {
+	// TCP address to connect to inside the VM (e.g. "localhost:8080")
+	Address string `protobuf:"bytes,1,opt,name=address,proto3,oneof"`
+}
+
+type ForwardTCPRequest_Connect_UnixSocketPath struct {
+	// Path of a unix domain socket to connect to inside
+	// the VM (e.g. "/var/run/docker.sock")
+	UnixSocketPath string `protobuf:"bytes,3,opt,name=unix_socket_path,json=unixSocketPath,proto3,oneof"`
+}
+
+func (*ForwardTCPRequest_Connect_Address) isForwardTCPRequest_Connect_Target() {}
+
+func (*ForwardTCPRequest_Connect_UnixSocketPath) isForwardTCPRequest_Connect_Target() {}
+
+type ForwardTCPResponse_Connected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocalAddress  string                 `protobuf:"bytes,1,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteAddress string                 `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardTCPResponse_Connected) Reset() {
+	*x = ForwardTCPResponse_Connected{}
+	mi := &file_rpc_agent_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardTCPResponse_Connected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardTCPResponse_Connected) ProtoMessage() {}
+
+func (x *ForwardTCPResponse_Connected) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardTCPResponse_Connected.ProtoReflect.Descriptor instead.
+func (*ForwardTCPResponse_Connected) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *ForwardTCPResponse_Connected) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *ForwardTCPResponse_Connected) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+type ReverseForwardResponse_Listening struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Actual address the listener is bound to, useful
+	// when listening on a system-assigned port
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseForwardResponse_Listening) Reset() {
+	*x = ReverseForwardResponse_Listening{}
+	mi := &file_rpc_agent_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardResponse_Listening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardResponse_Listening) ProtoMessage() {}
+
+func (x *ReverseForwardResponse_Listening) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardResponse_Listening.ProtoReflect.Descriptor instead.
+func (*ReverseForwardResponse_Listening) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *ReverseForwardResponse_Listening) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ReverseForwardResponse_IncomingConnection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pass this to ReverseForwardConnection to pick up the connection,
+	// otherwise it will be closed after a while
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RemoteAddress string `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseForwardResponse_IncomingConnection) Reset() {
+	*x = ReverseForwardResponse_IncomingConnection{}
+	mi := &file_rpc_agent_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardResponse_IncomingConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardResponse_IncomingConnection) ProtoMessage() {}
+
+func (x *ReverseForwardResponse_IncomingConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardResponse_IncomingConnection.ProtoReflect.Descriptor instead.
+func (*ReverseForwardResponse_IncomingConnection) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{41, 1}
+}
+
+func (x *ReverseForwardResponse_IncomingConnection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReverseForwardResponse_IncomingConnection) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+type ReverseForwardConnectionRequest_Attach struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseForwardConnectionRequest_Attach) Reset() {
+	*x = ReverseForwardConnectionRequest_Attach{}
+	mi := &file_rpc_agent_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseForwardConnectionRequest_Attach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseForwardConnectionRequest_Attach) ProtoMessage() {}
+
+func (x *ReverseForwardConnectionRequest_Attach) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseForwardConnectionRequest_Attach.ProtoReflect.Descriptor instead.
+func (*ReverseForwardConnectionRequest_Attach) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *ReverseForwardConnectionRequest_Attach) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WatchListeningPortsResponse_Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ports         []*ListeningPort       `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchListeningPortsResponse_Snapshot) Reset() {
+	*x = WatchListeningPortsResponse_Snapshot{}
+	mi := &file_rpc_agent_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchListeningPortsResponse_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchListeningPortsResponse_Snapshot) ProtoMessage() {}
+
+func (x *WatchListeningPortsResponse_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchListeningPortsResponse_Snapshot.ProtoReflect.Descriptor instead.
+func (*WatchListeningPortsResponse_Snapshot) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *WatchListeningPortsResponse_Snapshot) GetPorts() []*ListeningPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type ProxyResponse_Listening struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Actual address the listener is bound to, useful
+	// when listening on a system-assigned port
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyResponse_Listening) Reset() {
+	*x = ProxyResponse_Listening{}
+	mi := &file_rpc_agent_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyResponse_Listening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyResponse_Listening) ProtoMessage() {}
+
+func (x *ProxyResponse_Listening) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyResponse_Listening.ProtoReflect.Descriptor instead.
+func (*ProxyResponse_Listening) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *ProxyResponse_Listening) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ProxyResponse_IncomingConnection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pass this to ProxyConnection to either accept or reject
+	// the connection, otherwise it will be closed after a while
+	Id       string                                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Protocol ProxyResponse_IncomingConnection_Protocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=ProxyResponse_IncomingConnection_Protocol" json:"protocol,omitempty"`
+	// Host and port the client wants to connect to (e.g. "example.com:443"),
+	// it's up to the host to check it against an allowlist and dial it
+	Target        string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	RemoteAddress string `protobuf:"bytes,4,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyResponse_IncomingConnection) Reset() {
+	*x = ProxyResponse_IncomingConnection{}
+	mi := &file_rpc_agent_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyResponse_IncomingConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyResponse_IncomingConnection) ProtoMessage() {}
+
+func (x *ProxyResponse_IncomingConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyResponse_IncomingConnection.ProtoReflect.Descriptor instead.
+func (*ProxyResponse_IncomingConnection) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{50, 1}
+}
+
+func (x *ProxyResponse_IncomingConnection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProxyResponse_IncomingConnection) GetProtocol() ProxyResponse_IncomingConnection_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return ProxyResponse_IncomingConnection_PROTOCOL_UNSPECIFIED
+}
+
+func (x *ProxyResponse_IncomingConnection) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ProxyResponse_IncomingConnection) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+type ProxyConnectionRequest_Accept struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Should be sent once the connection to the target is established
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyConnectionRequest_Accept) Reset() {
+	*x = ProxyConnectionRequest_Accept{}
+	mi := &file_rpc_agent_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyConnectionRequest_Accept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConnectionRequest_Accept) ProtoMessage() {}
+
+func (x *ProxyConnectionRequest_Accept) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConnectionRequest_Accept.ProtoReflect.Descriptor instead.
+func (*ProxyConnectionRequest_Accept) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *ProxyConnectionRequest_Accept) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProxyConnectionRequest_Reject struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Id            string                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        ProxyConnectionRequest_Reject_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=ProxyConnectionRequest_Reject_Reason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyConnectionRequest_Reject) Reset() {
+	*x = ProxyConnectionRequest_Reject{}
+	mi := &file_rpc_agent_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyConnectionRequest_Reject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConnectionRequest_Reject) ProtoMessage() {}
+
+func (x *ProxyConnectionRequest_Reject) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConnectionRequest_Reject.ProtoReflect.Descriptor instead.
+func (*ProxyConnectionRequest_Reject) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{51, 1}
+}
+
+func (x *ProxyConnectionRequest_Reject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProxyConnectionRequest_Reject) GetReason() ProxyConnectionRequest_Reject_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return ProxyConnectionRequest_Reject_REASON_UNSPECIFIED
+}
+
+type ConfigureNetworkRequest_Route struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "0.0.0.0/0" or "::/0" for the default route
+	Destination   string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Gateway       string `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Metric        uint32 `protobuf:"varint,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureNetworkRequest_Route) Reset() {
+	*x = ConfigureNetworkRequest_Route{}
+	mi := &file_rpc_agent_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureNetworkRequest_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureNetworkRequest_Route) ProtoMessage() {}
+
+func (x *ConfigureNetworkRequest_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureNetworkRequest_Route.ProtoReflect.Descriptor instead.
+func (*ConfigureNetworkRequest_Route) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *ConfigureNetworkRequest_Route) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkRequest_Route) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkRequest_Route) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+type ConfigureNetworkResponse_File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Mode          uint32                 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureNetworkResponse_File) Reset() {
+	*x = ConfigureNetworkResponse_File{}
+	mi := &file_rpc_agent_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureNetworkResponse_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureNetworkResponse_File) ProtoMessage() {}
+
+func (x *ConfigureNetworkResponse_File) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureNetworkResponse_File.ProtoReflect.Descriptor instead.
+func (*ConfigureNetworkResponse_File) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *ConfigureNetworkResponse_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigureNetworkResponse_File) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ConfigureNetworkResponse_File) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type ConfigureNetworkResponse_Command struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Args          []string               `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureNetworkResponse_Command) Reset() {
+	*x = ConfigureNetworkResponse_Command{}
+	mi := &file_rpc_agent_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureNetworkResponse_Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureNetworkResponse_Command) ProtoMessage() {}
+
+func (x *ConfigureNetworkResponse_Command) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureNetworkResponse_Command.ProtoReflect.Descriptor instead.
+func (*ConfigureNetworkResponse_Command) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{56, 1}
+}
+
+func (x *ConfigureNetworkResponse_Command) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type GetSystemInfoResponse_OS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// E.g. "macOS" or "Ubuntu"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// E.g. "15.3.1" or "24.04"
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// E.g. "24D70", might be empty on Linux
+	Build         string `protobuf:"bytes,3,opt,name=build,proto3" json:"build,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse_OS) Reset() {
+	*x = GetSystemInfoResponse_OS{}
+	mi := &file_rpc_agent_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse_OS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse_OS) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse_OS) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse_OS.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse_OS) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *GetSystemInfoResponse_OS) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse_OS) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse_OS) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+type GetSystemInfoResponse_CPU struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Count uint32                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Might be empty on Linux, depending on the architecture
+	Model         string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse_CPU) Reset() {
+	*x = GetSystemInfoResponse_CPU{}
+	mi := &file_rpc_agent_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse_CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse_CPU) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse_CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse_CPU.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse_CPU) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{69, 1}
+}
+
+func (x *GetSystemInfoResponse_CPU) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetSystemInfoResponse_CPU) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type GetSystemInfoResponse_Memory struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalBytes     uint64                 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	AvailableBytes uint64                 `protobuf:"varint,2,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse_Memory) Reset() {
+	*x = GetSystemInfoResponse_Memory{}
+	mi := &file_rpc_agent_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse_Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse_Memory) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse_Memory.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse_Memory) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{69, 2}
+}
+
+func (x *GetSystemInfoResponse_Memory) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetSystemInfoResponse_Memory) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+type GetSystemInfoResponse_Agent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemInfoResponse_Agent) Reset() {
+	*x = GetSystemInfoResponse_Agent{}
+	mi := &file_rpc_agent_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemInfoResponse_Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoResponse_Agent) ProtoMessage() {}
+
+func (x *GetSystemInfoResponse_Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoResponse_Agent.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse_Agent) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{69, 3}
+}
+
+func (x *GetSystemInfoResponse_Agent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetSystemInfoResponse_Agent) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+type GetCapabilitiesResponse_Limits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum size of a single message accepted by the agent
+	MaxMessageSize uint32 `protobuf:"varint,1,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"`
+	// Maximum number of concurrent RPCs per connection, zero means no limit
+	MaxConcurrentStreams uint32 `protobuf:"varint,2,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// Maximum number of concurrent streaming RPCs (e.g. Exec or
+	// ForwardTCP sessions) across all connections, the ones above
+	// the limit fail with RESOURCE_EXHAUSTED
+	MaxSessions   uint32 `protobuf:"varint,3,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse_Limits) Reset() {
+	*x = GetCapabilitiesResponse_Limits{}
+	mi := &file_rpc_agent_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse_Limits) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse_Limits.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse_Limits) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (x *GetCapabilitiesResponse_Limits) GetMaxMessageSize() uint32 {
+	if x != nil {
+		return x.MaxMessageSize
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse_Limits) GetMaxConcurrentStreams() uint32 {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse_Limits) GetMaxSessions() uint32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+type StreamMetricsResponse_CPU struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Percentage of all cores
+	UsagePercent        float64   `protobuf:"fixed64,1,opt,name=usage_percent,json=usagePercent,proto3" json:"usage_percent,omitempty"`
+	PerCoreUsagePercent []float64 `protobuf:"fixed64,2,rep,packed,name=per_core_usage_percent,json=perCoreUsagePercent,proto3" json:"per_core_usage_percent,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse_CPU) Reset() {
+	*x = StreamMetricsResponse_CPU{}
+	mi := &file_rpc_agent_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse_CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse_CPU) ProtoMessage() {}
+
+func (x *StreamMetricsResponse_CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse_CPU.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_CPU) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 0}
+}
+
+func (x *StreamMetricsResponse_CPU) GetUsagePercent() float64 {
+	if x != nil {
+		return x.UsagePercent
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_CPU) GetPerCoreUsagePercent() []float64 {
+	if x != nil {
+		return x.PerCoreUsagePercent
+	}
+	return nil
+}
+
+type StreamMetricsResponse_LoadAverage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OneMinute      float64                `protobuf:"fixed64,1,opt,name=one_minute,json=oneMinute,proto3" json:"one_minute,omitempty"`
+	FiveMinutes    float64                `protobuf:"fixed64,2,opt,name=five_minutes,json=fiveMinutes,proto3" json:"five_minutes,omitempty"`
+	FifteenMinutes float64                `protobuf:"fixed64,3,opt,name=fifteen_minutes,json=fifteenMinutes,proto3" json:"fifteen_minutes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse_LoadAverage) Reset() {
+	*x = StreamMetricsResponse_LoadAverage{}
+	mi := &file_rpc_agent_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse_LoadAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse_LoadAverage) ProtoMessage() {}
+
+func (x *StreamMetricsResponse_LoadAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse_LoadAverage.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_LoadAverage) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 1}
+}
+
+func (x *StreamMetricsResponse_LoadAverage) GetOneMinute() float64 {
+	if x != nil {
+		return x.OneMinute
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_LoadAverage) GetFiveMinutes() float64 {
+	if x != nil {
+		return x.FiveMinutes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_LoadAverage) GetFifteenMinutes() float64 {
+	if x != nil {
+		return x.FifteenMinutes
+	}
+	return 0
+}
+
+type StreamMetricsResponse_Memory struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalBytes     uint64                 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	UsedBytes      uint64                 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	AvailableBytes uint64                 `protobuf:"varint,3,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	SwapTotalBytes uint64                 `protobuf:"varint,4,opt,name=swap_total_bytes,json=swapTotalBytes,proto3" json:"swap_total_bytes,omitempty"`
+	SwapUsedBytes  uint64                 `protobuf:"varint,5,opt,name=swap_used_bytes,json=swapUsedBytes,proto3" json:"swap_used_bytes,omitempty"`
+	// The higher the worse: on Linux, the share of the last 10 seconds some
+	// of the tasks were stalled on memory (PSI "some avg10"), and on macOS,
+	// the inverse of the share of memory available (kern.memorystatus_level)
+	PressurePercent float64 `protobuf:"fixed64,6,opt,name=pressure_percent,json=pressurePercent,proto3" json:"pressure_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse_Memory) Reset() {
+	*x = StreamMetricsResponse_Memory{}
+	mi := &file_rpc_agent_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse_Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse_Memory) ProtoMessage() {}
+
+func (x *StreamMetricsResponse_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse_Memory.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_Memory) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 2}
+}
+
+func (x *StreamMetricsResponse_Memory) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Memory) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Memory) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Memory) GetSwapTotalBytes() uint64 {
+	if x != nil {
+		return x.SwapTotalBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Memory) GetSwapUsedBytes() uint64 {
+	if x != nil {
+		return x.SwapUsedBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Memory) GetPressurePercent() float64 {
+	if x != nil {
+		return x.PressurePercent
+	}
+	return 0
+}
+
+type StreamMetricsResponse_Volume struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MountPoint     string                 `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	Device         string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	FileSystem     string                 `protobuf:"bytes,3,opt,name=file_system,json=fileSystem,proto3" json:"file_system,omitempty"`
+	TotalBytes     uint64                 `protobuf:"varint,4,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	UsedBytes      uint64                 `protobuf:"varint,5,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	AvailableBytes uint64                 `protobuf:"varint,6,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	// Name of the disk in the disks list whose throughput includes this
+	// volume's IO: the partition or the device mapper device on Linux
+	// and the whole disk on macOS, where APFS volumes share the disk.
+	// Empty when the disk cannot be determined.
+	Disk          string `protobuf:"bytes,7,opt,name=disk,proto3" json:"disk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecRequest_Command) Reset() {
-	*x = ExecRequest_Command{}
-	mi := &file_rpc_agent_proto_msgTypes[6]
+func (x *StreamMetricsResponse_Volume) Reset() {
+	*x = StreamMetricsResponse_Volume{}
+	mi := &file_rpc_agent_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse_Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse_Volume) ProtoMessage() {}
+
+func (x *StreamMetricsResponse_Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse_Volume.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_Volume) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 3}
+}
+
+func (x *StreamMetricsResponse_Volume) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *StreamMetricsResponse_Volume) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *StreamMetricsResponse_Volume) GetFileSystem() string {
+	if x != nil {
+		return x.FileSystem
+	}
+	return ""
+}
+
+func (x *StreamMetricsResponse_Volume) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Volume) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Volume) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Volume) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+type StreamMetricsResponse_Disk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Block device name, e.g. "vda" on Linux or "disk0" on macOS
+	Name                string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReadBytesPerSecond  float64 `protobuf:"fixed64,2,opt,name=read_bytes_per_second,json=readBytesPerSecond,proto3" json:"read_bytes_per_second,omitempty"`
+	WriteBytesPerSecond float64 `protobuf:"fixed64,3,opt,name=write_bytes_per_second,json=writeBytesPerSecond,proto3" json:"write_bytes_per_second,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse_Disk) Reset() {
+	*x = StreamMetricsResponse_Disk{}
+	mi := &file_rpc_agent_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecRequest_Command) String() string {
+func (x *StreamMetricsResponse_Disk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRequest_Command) ProtoMessage() {}
+func (*StreamMetricsResponse_Disk) ProtoMessage() {}
 
-func (x *ExecRequest_Command) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_agent_proto_msgTypes[6]
+func (x *StreamMetricsResponse_Disk) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,68 +9160,264 @@ func (x *ExecRequest_Command) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRequest_Command.ProtoReflect.Descriptor instead.
-func (*ExecRequest_Command) Descriptor() ([]byte, []int) {
-	return file_rpc_agent_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use StreamMetricsResponse_Disk.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_Disk) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 4}
 }
 
-func (x *ExecRequest_Command) GetName() string {
+func (x *StreamMetricsResponse_Disk) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ExecRequest_Command) GetArgs() []string {
+func (x *StreamMetricsResponse_Disk) GetReadBytesPerSecond() float64 {
 	if x != nil {
-		return x.Args
+		return x.ReadBytesPerSecond
 	}
-	return nil
+	return 0
 }
 
-func (x *ExecRequest_Command) GetInteractive() bool {
+func (x *StreamMetricsResponse_Disk) GetWriteBytesPerSecond() float64 {
 	if x != nil {
-		return x.Interactive
+		return x.WriteBytesPerSecond
 	}
-	return false
+	return 0
 }
 
-func (x *ExecRequest_Command) GetTty() bool {
+type StreamMetricsResponse_NetworkInterface struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReceiveBytesPerSecond  float64                `protobuf:"fixed64,2,opt,name=receive_bytes_per_second,json=receiveBytesPerSecond,proto3" json:"receive_bytes_per_second,omitempty"`
+	TransmitBytesPerSecond float64                `protobuf:"fixed64,3,opt,name=transmit_bytes_per_second,json=transmitBytesPerSecond,proto3" json:"transmit_bytes_per_second,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse_NetworkInterface) Reset() {
+	*x = StreamMetricsResponse_NetworkInterface{}
+	mi := &file_rpc_agent_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse_NetworkInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse_NetworkInterface) ProtoMessage() {}
+
+func (x *StreamMetricsResponse_NetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[136]
 	if x != nil {
-		return x.Tty
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ExecRequest_Command) GetTerminalSize() *TerminalSize {
+// Deprecated: Use StreamMetricsResponse_NetworkInterface.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_NetworkInterface) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 5}
+}
+
+func (x *StreamMetricsResponse_NetworkInterface) GetName() string {
 	if x != nil {
-		return x.TerminalSize
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamMetricsResponse_NetworkInterface) GetReceiveBytesPerSecond() float64 {
+	if x != nil {
+		return x.ReceiveBytesPerSecond
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_NetworkInterface) GetTransmitBytesPerSecond() float64 {
+	if x != nil {
+		return x.TransmitBytesPerSecond
+	}
+	return 0
+}
+
+type StreamMetricsResponse_Process struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pid   int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	User  string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Percentage of a single core, so it can
+	// exceed 100% for multi-threaded processes
+	CpuUsagePercent float64 `protobuf:"fixed64,4,opt,name=cpu_usage_percent,json=cpuUsagePercent,proto3" json:"cpu_usage_percent,omitempty"`
+	ResidentBytes   uint64  `protobuf:"varint,5,opt,name=resident_bytes,json=residentBytes,proto3" json:"resident_bytes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse_Process) Reset() {
+	*x = StreamMetricsResponse_Process{}
+	mi := &file_rpc_agent_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse_Process) ProtoMessage() {}
+
+func (x *StreamMetricsResponse_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse_Process.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse_Process) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{73, 6}
+}
+
+func (x *StreamMetricsResponse_Process) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Process) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamMetricsResponse_Process) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *StreamMetricsResponse_Process) GetCpuUsagePercent() float64 {
+	if x != nil {
+		return x.CpuUsagePercent
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse_Process) GetResidentBytes() uint64 {
+	if x != nil {
+		return x.ResidentBytes
+	}
+	return 0
+}
+
+// Same semantics as in ResolveIPRequest
+type WaitForCondition_AddressAssigned struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Family           AddressFamily          `protobuf:"varint,1,opt,name=family,proto3,enum=AddressFamily" json:"family,omitempty"`
+	Interfaces       []string               `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Subnets          []string               `protobuf:"bytes,3,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	DefaultRouteOnly bool                   `protobuf:"varint,4,opt,name=default_route_only,json=defaultRouteOnly,proto3" json:"default_route_only,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WaitForCondition_AddressAssigned) Reset() {
+	*x = WaitForCondition_AddressAssigned{}
+	mi := &file_rpc_agent_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForCondition_AddressAssigned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForCondition_AddressAssigned) ProtoMessage() {}
+
+func (x *WaitForCondition_AddressAssigned) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForCondition_AddressAssigned.ProtoReflect.Descriptor instead.
+func (*WaitForCondition_AddressAssigned) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *WaitForCondition_AddressAssigned) GetFamily() AddressFamily {
+	if x != nil {
+		return x.Family
+	}
+	return AddressFamily_ADDRESS_FAMILY_UNSPECIFIED
+}
+
+func (x *WaitForCondition_AddressAssigned) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
 	}
 	return nil
 }
 
-type ExecResponse_Exit struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+func (x *WaitForCondition_AddressAssigned) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *WaitForCondition_AddressAssigned) GetDefaultRouteOnly() bool {
+	if x != nil {
+		return x.DefaultRouteOnly
+	}
+	return false
+}
+
+type WaitForResponse_ConditionStatus struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Condition *WaitForCondition      `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Satisfied bool                   `protobuf:"varint,2,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	// The error encountered when checking the condition, if any
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecResponse_Exit) Reset() {
-	*x = ExecResponse_Exit{}
-	mi := &file_rpc_agent_proto_msgTypes[7]
+func (x *WaitForResponse_ConditionStatus) Reset() {
+	*x = WaitForResponse_ConditionStatus{}
+	mi := &file_rpc_agent_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecResponse_Exit) String() string {
+func (x *WaitForResponse_ConditionStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecResponse_Exit) ProtoMessage() {}
+func (*WaitForResponse_ConditionStatus) ProtoMessage() {}
 
-func (x *ExecResponse_Exit) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_agent_proto_msgTypes[7]
+func (x *WaitForResponse_ConditionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_agent_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,23 +9428,37 @@ func (x *ExecResponse_Exit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecResponse_Exit.ProtoReflect.Descriptor instead.
-func (*ExecResponse_Exit) Descriptor() ([]byte, []int) {
-	return file_rpc_agent_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use WaitForResponse_ConditionStatus.ProtoReflect.Descriptor instead.
+func (*WaitForResponse_ConditionStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_agent_proto_rawDescGZIP(), []int{81, 0}
 }
 
-func (x *ExecResponse_Exit) GetCode() int32 {
+func (x *WaitForResponse_ConditionStatus) GetCondition() *WaitForCondition {
 	if x != nil {
-		return x.Code
+		return x.Condition
 	}
-	return 0
+	return nil
+}
+
+func (x *WaitForResponse_ConditionStatus) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *WaitForResponse_ConditionStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
 var File_rpc_agent_proto protoreflect.FileDescriptor
 
 const file_rpc_agent_proto_rawDesc = "" +
 	"\n" +
-	"\x0frpc/agent.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd0\x02\n" +
+	"\x0frpc/agent.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x02\n" +
 	"\vExecRequest\x120\n" +
 	"\acommand\x18\x01 \x01(\v2\x14.ExecRequest.CommandH\x00R\acommand\x121\n" +
 	"\x0estandard_input\x18\x02 \x01(\v2\b.IOChunkH\x00R\rstandardInput\x128\n" +
@@ -541,13 +9481,649 @@ const file_rpc_agent_proto_rawDesc = "" +
 	"\x04rows\x18\x01 \x01(\rR\x04rows\x12\x12\n" +
 	"\x04cols\x18\x02 \x01(\rR\x04cols\"\x1d\n" +
 	"\aIOChunk\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data\"\x12\n" +
-	"\x10ResolveIPRequest\"#\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xf4\x01\n" +
+	"\x10ResolveIPRequest\x12&\n" +
+	"\x06family\x18\x01 \x01(\x0e2\x0e.AddressFamilyR\x06family\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x02 \x03(\tR\n" +
+	"interfaces\x12\x18\n" +
+	"\asubnets\x18\x03 \x03(\tR\asubnets\x12,\n" +
+	"\x12default_route_only\x18\x04 \x01(\bR\x10defaultRouteOnly\x12\x12\n" +
+	"\x04wait\x18\x05 \x01(\bR\x04wait\x12<\n" +
+	"\fwait_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vwaitTimeout\"V\n" +
 	"\x11ResolveIPResponse\x12\x0e\n" +
-	"\x02ip\x18\x01 \x01(\tR\x02ip2d\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x121\n" +
+	"\n" +
+	"interfaces\x18\x02 \x03(\v2\x11.NetworkInterfaceR\n" +
+	"interfaces\"\xf3\x02\n" +
+	"\x10NetworkInterface\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\x12\x10\n" +
+	"\x03mac\x18\x03 \x01(\tR\x03mac\x12\x14\n" +
+	"\x05flags\x18\x04 \x03(\tR\x05flags\x12\x10\n" +
+	"\x03mtu\x18\x05 \x01(\rR\x03mtu\x127\n" +
+	"\taddresses\x18\x06 \x03(\v2\x19.NetworkInterface.AddressR\taddresses\x12,\n" +
+	"\x12default_route_ipv4\x18\a \x01(\bR\x10defaultRouteIpv4\x12,\n" +
+	"\x12default_route_ipv6\x18\b \x01(\bR\x10defaultRouteIpv6\x1af\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12#\n" +
+	"\rprefix_length\x18\x02 \x01(\rR\fprefixLength\x12&\n" +
+	"\x06family\x18\x03 \x01(\x0e2\x0e.AddressFamilyR\x06family\"z\n" +
+	"\x10WatchPathRequest\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\x12\x18\n" +
+	"\ainclude\x18\x03 \x03(\tR\ainclude\x12\x18\n" +
+	"\aexclude\x18\x04 \x03(\tR\aexclude\"\xd2\x02\n" +
+	"\x11WatchPathResponse\x120\n" +
+	"\x05ready\x18\x01 \x01(\v2\x18.WatchPathResponse.ReadyH\x00R\x05ready\x120\n" +
+	"\x05event\x18\x02 \x01(\v2\x18.WatchPathResponse.EventH\x00R\x05event\x1a\a\n" +
+	"\x05Ready\x1a\xc7\x01\n" +
+	"\x05Event\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.WatchPathResponse.Event.TypeR\x04type\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x15\n" +
+	"\x06is_dir\x18\x03 \x01(\bR\x05isDir\"`\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vTYPE_CREATE\x10\x01\x12\x0f\n" +
+	"\vTYPE_MODIFY\x10\x02\x12\x0f\n" +
+	"\vTYPE_DELETE\x10\x03\x12\x0f\n" +
+	"\vTYPE_RENAME\x10\x04B\x06\n" +
+	"\x04type\"\x8e\x03\n" +
+	"\x0fSyncFileRequest\x12.\n" +
+	"\x05begin\x18\x01 \x01(\v2\x16.SyncFileRequest.BeginH\x00R\x05begin\x12>\n" +
+	"\vcopy_blocks\x18\x02 \x01(\v2\x1b.SyncFileRequest.CopyBlocksH\x00R\n" +
+	"copyBlocks\x12$\n" +
+	"\aliteral\x18\x03 \x01(\v2\b.IOChunkH\x00R\aliteral\x121\n" +
+	"\x06commit\x18\x04 \x01(\v2\x17.SyncFileRequest.CommitH\x00R\x06commit\x1aN\n" +
+	"\x05Begin\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"block_size\x18\x02 \x01(\rR\tblockSize\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\rR\x04mode\x1a8\n" +
+	"\n" +
+	"CopyBlocks\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x04R\x05index\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\x1a \n" +
+	"\x06Commit\x12\x16\n" +
+	"\x06sha256\x18\x01 \x01(\fR\x06sha256B\x06\n" +
+	"\x04type\"\xc5\x03\n" +
+	"\x10SyncFileResponse\x12>\n" +
+	"\n" +
+	"signatures\x18\x01 \x01(\v2\x1c.SyncFileResponse.SignaturesH\x00R\n" +
+	"signatures\x12H\n" +
+	"\x0esignatures_end\x18\x02 \x01(\v2\x1f.SyncFileResponse.SignaturesEndH\x00R\rsignaturesEnd\x12;\n" +
+	"\tcommitted\x18\x03 \x01(\v2\x1b.SyncFileResponse.CommittedH\x00R\tcommitted\x1a}\n" +
+	"\n" +
+	"Signatures\x12:\n" +
+	"\x06blocks\x18\x01 \x03(\v2\".SyncFileResponse.Signatures.BlockR\x06blocks\x1a3\n" +
+	"\x05Block\x12\x12\n" +
+	"\x04weak\x18\x01 \x01(\rR\x04weak\x12\x16\n" +
+	"\x06strong\x18\x02 \x01(\fR\x06strong\x1aB\n" +
+	"\rSignaturesEnd\x12\x1d\n" +
+	"\n" +
+	"block_size\x18\x01 \x01(\rR\tblockSize\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\x1a\x1f\n" +
+	"\tCommitted\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x04R\x04sizeB\x06\n" +
+	"\x04type\"\xe3\x02\n" +
+	"\rUploadRequest\x12,\n" +
+	"\x05begin\x18\x01 \x01(\v2\x14.UploadRequest.BeginH\x00R\x05begin\x12\x1e\n" +
+	"\x04data\x18\x02 \x01(\v2\b.IOChunkH\x00R\x04data\x12/\n" +
+	"\x06commit\x18\x03 \x01(\v2\x15.UploadRequest.CommitH\x00R\x06commit\x1a\xa8\x01\n" +
+	"\x05Begin\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x04R\x06offset\x12*\n" +
+	"\x06xattrs\x18\x06 \x03(\v2\x12.ExtendedAttributeR\x06xattrs\x1a \n" +
+	"\x06Commit\x12\x16\n" +
+	"\x06sha256\x18\x01 \x01(\fR\x06sha256B\x06\n" +
+	"\x04type\"$\n" +
+	"\x0eUploadResponse\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x04R\x04size\"d\n" +
+	"\x0fDownloadRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12%\n" +
+	"\x0einclude_xattrs\x18\x03 \x01(\bR\rincludeXattrs\"\xd4\x01\n" +
+	"\x10DownloadResponse\x128\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.DownloadResponse.MetadataH\x00R\bmetadata\x12\x1e\n" +
+	"\x04data\x18\x02 \x01(\v2\b.IOChunkH\x00R\x04data\x1a^\n" +
+	"\bMetadata\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x04R\x04size\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\rR\x04mode\x12*\n" +
+	"\x06xattrs\x18\x03 \x03(\v2\x12.ExtendedAttributeR\x06xattrsB\x06\n" +
+	"\x04type\"7\n" +
+	"\x14QueryTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\"j\n" +
+	"\x15QueryTransferResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\x12)\n" +
+	"\x10committed_offset\x18\x03 \x01(\x04R\x0fcommittedOffset\"=\n" +
+	"\x11ExtendedAttribute\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"k\n" +
+	"\x11ListXattrsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tno_follow\x18\x02 \x01(\bR\bnoFollow\x12%\n" +
+	"\x0einclude_values\x18\x03 \x01(\bR\rincludeValues\"@\n" +
+	"\x12ListXattrsResponse\x12*\n" +
+	"\x06xattrs\x18\x01 \x03(\v2\x12.ExtendedAttributeR\x06xattrs\"V\n" +
+	"\x0fGetXattrRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tno_follow\x18\x02 \x01(\bR\bnoFollow\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"(\n" +
+	"\x10GetXattrResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\"l\n" +
+	"\x0fSetXattrRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tno_follow\x18\x02 \x01(\bR\bnoFollow\x12(\n" +
+	"\x05xattr\x18\x03 \x01(\v2\x12.ExtendedAttributeR\x05xattr\"\x12\n" +
+	"\x10SetXattrResponse\"Y\n" +
+	"\x12RemoveXattrRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tno_follow\x18\x02 \x01(\bR\bnoFollow\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x15\n" +
+	"\x13RemoveXattrResponse\")\n" +
+	"\x13GetFileFlagsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"B\n" +
+	"\x14GetFileFlagsResponse\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\"Q\n" +
+	"\x13SetFileFlagsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03set\x18\x02 \x03(\tR\x03set\x12\x14\n" +
+	"\x05clear\x18\x03 \x03(\tR\x05clear\"B\n" +
+	"\x14SetFileFlagsResponse\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\"=\n" +
+	"\rGetACLRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\adefault\x18\x02 \x01(\bR\adefault\"*\n" +
+	"\x0eGetACLResponse\x12\x18\n" +
+	"\aentries\x18\x01 \x03(\tR\aentries\"W\n" +
+	"\rSetACLRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\adefault\x18\x02 \x01(\bR\adefault\x12\x18\n" +
+	"\aentries\x18\x03 \x03(\tR\aentries\"\x10\n" +
+	"\x0eSetACLResponse\"\xaa\x02\n" +
+	"\x17CollectArtifactsRequest\x12\x1a\n" +
+	"\bpatterns\x18\x01 \x03(\tR\bpatterns\x12\x19\n" +
+	"\bbase_dir\x18\x02 \x01(\tR\abaseDir\x12$\n" +
+	"\x0emax_total_size\x18\x03 \x01(\x04R\fmaxTotalSize\x12\"\n" +
+	"\rmax_file_size\x18\x04 \x01(\x04R\vmaxFileSize\x127\n" +
+	"\x06format\x18\x05 \x01(\x0e2\x1f.CollectArtifactsRequest.FormatR\x06format\"U\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"FORMAT_TAR\x10\x01\x12\x13\n" +
+	"\x0fFORMAT_TAR_GZIP\x10\x02\x12\x0e\n" +
+	"\n" +
+	"FORMAT_ZIP\x10\x03\"\xd6\x05\n" +
+	"\x18CollectArtifactsResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\b.IOChunkH\x00R\x04data\x12@\n" +
+	"\bmanifest\x18\x02 \x01(\v2\".CollectArtifactsResponse.ManifestH\x00R\bmanifest\x1a\xcf\x04\n" +
+	"\bManifest\x12D\n" +
+	"\bincluded\x18\x01 \x03(\v2(.CollectArtifactsResponse.Manifest.EntryR\bincluded\x12D\n" +
+	"\askipped\x18\x02 \x03(\v2*.CollectArtifactsResponse.Manifest.SkippedR\askipped\x12-\n" +
+	"\x12unmatched_patterns\x18\x03 \x03(\tR\x11unmatchedPatterns\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x04 \x01(\x04R\ttotalSize\x1aC\n" +
+	"\x05Entry\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x1a\xa3\x02\n" +
+	"\aSkipped\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\x12I\n" +
+	"\x06reason\x18\x03 \x01(\x0e21.CollectArtifactsResponse.Manifest.Skipped.ReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8a\x01\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REASON_FILE_TOO_LARGE\x10\x01\x12\x1e\n" +
+	"\x1aREASON_TOTAL_SIZE_EXCEEDED\x10\x02\x12\x1b\n" +
+	"\x17REASON_NOT_REGULAR_FILE\x10\x03\x12\x10\n" +
+	"\fREASON_ERROR\x10\x04B\x06\n" +
+	"\x04type\"]\n" +
+	"\x13WatchNetworkRequest\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x01 \x03(\tR\n" +
+	"interfaces\x12&\n" +
+	"\x06family\x18\x02 \x01(\x0e2\x0e.AddressFamilyR\x06family\"\xf5\x04\n" +
+	"\x14WatchNetworkResponse\x12<\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1e.WatchNetworkResponse.SnapshotH\x00R\bsnapshot\x123\n" +
+	"\x05event\x18\x02 \x01(\v2\x1b.WatchNetworkResponse.EventH\x00R\x05event\x1a=\n" +
+	"\bSnapshot\x121\n" +
+	"\n" +
+	"interfaces\x18\x01 \x03(\v2\x11.NetworkInterfaceR\n" +
+	"interfaces\x1a\xa2\x03\n" +
+	"\x05Event\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .WatchNetworkResponse.Event.TypeR\x04type\x12/\n" +
+	"\tinterface\x18\x02 \x01(\v2\x11.NetworkInterfaceR\tinterface\x123\n" +
+	"\aaddress\x18\x03 \x01(\v2\x19.NetworkInterface.AddressR\aaddress\x12&\n" +
+	"\x06family\x18\x04 \x01(\x0e2\x0e.AddressFamilyR\x06family\"\xd4\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TYPE_INTERFACE_ADDED\x10\x01\x12\x1a\n" +
+	"\x16TYPE_INTERFACE_REMOVED\x10\x02\x12\x15\n" +
+	"\x11TYPE_INTERFACE_UP\x10\x03\x12\x17\n" +
+	"\x13TYPE_INTERFACE_DOWN\x10\x04\x12\x16\n" +
+	"\x12TYPE_ADDRESS_ADDED\x10\x05\x12\x18\n" +
+	"\x14TYPE_ADDRESS_REMOVED\x10\x06\x12\x1e\n" +
+	"\x1aTYPE_DEFAULT_ROUTE_CHANGED\x10\aB\x06\n" +
+	"\x04type\"\x86\x02\n" +
+	"\x11ForwardTCPRequest\x126\n" +
+	"\aconnect\x18\x01 \x01(\v2\x1a.ForwardTCPRequest.ConnectH\x00R\aconnect\x12\x1e\n" +
+	"\x04data\x18\x02 \x01(\v2\b.IOChunkH\x00R\x04data\x1a\x90\x01\n" +
+	"\aConnect\x12\x1a\n" +
+	"\aaddress\x18\x01 \x01(\tH\x00R\aaddress\x12*\n" +
+	"\x10unix_socket_path\x18\x03 \x01(\tH\x00R\x0eunixSocketPath\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeoutB\b\n" +
+	"\x06targetB\x06\n" +
+	"\x04type\"\xd4\x01\n" +
+	"\x12ForwardTCPResponse\x12=\n" +
+	"\tconnected\x18\x01 \x01(\v2\x1d.ForwardTCPResponse.ConnectedH\x00R\tconnected\x12\x1e\n" +
+	"\x04data\x18\x02 \x01(\v2\b.IOChunkH\x00R\x04data\x1aW\n" +
+	"\tConnected\x12#\n" +
+	"\rlocal_address\x18\x01 \x01(\tR\flocalAddress\x12%\n" +
+	"\x0eremote_address\x18\x02 \x01(\tR\rremoteAddressB\x06\n" +
+	"\x04type\"\x93\x01\n" +
+	"\x15ReverseForwardRequest\x12\x1a\n" +
+	"\aaddress\x18\x01 \x01(\tH\x00R\aaddress\x12*\n" +
+	"\x10unix_socket_path\x18\x02 \x01(\tH\x00R\x0eunixSocketPath\x12(\n" +
+	"\x10unix_socket_mode\x18\x03 \x01(\rR\x0eunixSocketModeB\b\n" +
+	"\x06target\"\xb6\x02\n" +
+	"\x16ReverseForwardResponse\x12A\n" +
+	"\tlistening\x18\x01 \x01(\v2!.ReverseForwardResponse.ListeningH\x00R\tlistening\x12]\n" +
+	"\x13incoming_connection\x18\x02 \x01(\v2*.ReverseForwardResponse.IncomingConnectionH\x00R\x12incomingConnection\x1a%\n" +
+	"\tListening\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x1aK\n" +
+	"\x12IncomingConnection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eremote_address\x18\x02 \x01(\tR\rremoteAddressB\x06\n" +
+	"\x04type\"\xa6\x01\n" +
+	"\x1fReverseForwardConnectionRequest\x12A\n" +
+	"\x06attach\x18\x01 \x01(\v2'.ReverseForwardConnectionRequest.AttachH\x00R\x06attach\x12\x1e\n" +
+	"\x04data\x18\x02 \x01(\v2\b.IOChunkH\x00R\x04data\x1a\x18\n" +
+	"\x06Attach\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02idB\x06\n" +
+	"\x04type\"J\n" +
+	" ReverseForwardConnectionResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\b.IOChunkH\x00R\x04dataB\x06\n" +
+	"\x04type\"h\n" +
+	"\rListeningPort\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x12\x10\n" +
+	"\x03pid\x18\x03 \x01(\rR\x03pid\x12!\n" +
+	"\fprocess_name\x18\x04 \x01(\tR\vprocessName\"\x1b\n" +
+	"\x19ListListeningPortsRequest\"B\n" +
+	"\x1aListListeningPortsResponse\x12$\n" +
+	"\x05ports\x18\x01 \x03(\v2\x0e.ListeningPortR\x05ports\"S\n" +
+	"\x1aWatchListeningPortsRequest\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\"\x82\x02\n" +
+	"\x1bWatchListeningPortsResponse\x12C\n" +
+	"\bsnapshot\x18\x01 \x01(\v2%.WatchListeningPortsResponse.SnapshotH\x00R\bsnapshot\x121\n" +
+	"\vport_opened\x18\x02 \x01(\v2\x0e.ListeningPortH\x00R\n" +
+	"portOpened\x121\n" +
+	"\vport_closed\x18\x03 \x01(\v2\x0e.ListeningPortH\x00R\n" +
+	"portClosed\x1a0\n" +
+	"\bSnapshot\x12$\n" +
+	"\x05ports\x18\x01 \x03(\v2\x0e.ListeningPortR\x05portsB\x06\n" +
+	"\x04type\"(\n" +
+	"\fProxyRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\xd2\x03\n" +
+	"\rProxyResponse\x128\n" +
+	"\tlistening\x18\x01 \x01(\v2\x18.ProxyResponse.ListeningH\x00R\tlistening\x12T\n" +
+	"\x13incoming_connection\x18\x02 \x01(\v2!.ProxyResponse.IncomingConnectionH\x00R\x12incomingConnection\x1a%\n" +
+	"\tListening\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x1a\x81\x02\n" +
+	"\x12IncomingConnection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\bprotocol\x18\x02 \x01(\x0e2*.ProxyResponse.IncomingConnection.ProtocolR\bprotocol\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12%\n" +
+	"\x0eremote_address\x18\x04 \x01(\tR\rremoteAddress\"T\n" +
+	"\bProtocol\x12\x18\n" +
+	"\x14PROTOCOL_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPROTOCOL_SOCKS5\x10\x01\x12\x19\n" +
+	"\x15PROTOCOL_HTTP_CONNECT\x10\x02B\x06\n" +
+	"\x04type\"\x9e\x03\n" +
+	"\x16ProxyConnectionRequest\x128\n" +
+	"\x06accept\x18\x01 \x01(\v2\x1e.ProxyConnectionRequest.AcceptH\x00R\x06accept\x128\n" +
+	"\x06reject\x18\x02 \x01(\v2\x1e.ProxyConnectionRequest.RejectH\x00R\x06reject\x12\x1e\n" +
+	"\x04data\x18\x03 \x01(\v2\b.IOChunkH\x00R\x04data\x1a\x18\n" +
+	"\x06Accept\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x1a\xcd\x01\n" +
+	"\x06Reject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\x06reason\x18\x02 \x01(\x0e2%.ProxyConnectionRequest.Reject.ReasonR\x06reason\"t\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REASON_NOT_ALLOWED\x10\x01\x12\x1b\n" +
+	"\x17REASON_HOST_UNREACHABLE\x10\x02\x12\x1d\n" +
+	"\x19REASON_CONNECTION_REFUSED\x10\x03B\x06\n" +
+	"\x04type\"A\n" +
+	"\x17ProxyConnectionResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\b.IOChunkH\x00R\x04dataB\x06\n" +
+	"\x04type\"q\n" +
+	"\x13RenewNetworkRequest\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x12<\n" +
+	"\fwait_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vwaitTimeout\"_\n" +
+	"\x14RenewNetworkResponse\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12/\n" +
+	"\tinterface\x18\x02 \x01(\v2\x11.NetworkInterfaceR\tinterface\"\xf6\x03\n" +
+	"\x17ConfigureNetworkRequest\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x12\x14\n" +
+	"\x05dhcp4\x18\x02 \x01(\bR\x05dhcp4\x12\x1c\n" +
+	"\taddresses\x18\x03 \x03(\tR\taddresses\x126\n" +
+	"\x06routes\x18\x04 \x03(\v2\x1e.ConfigureNetworkRequest.RouteR\x06routes\x12\x1f\n" +
+	"\vdns_servers\x18\x05 \x03(\tR\n" +
+	"dnsServers\x12%\n" +
+	"\x0esearch_domains\x18\x06 \x03(\tR\rsearchDomains\x12\x10\n" +
+	"\x03mtu\x18\a \x01(\rR\x03mtu\x12\x18\n" +
+	"\abackend\x18\b \x01(\tR\abackend\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fcheck_addresses\x18\n" +
+	" \x03(\tR\x0echeckAddresses\x12>\n" +
+	"\rcheck_timeout\x18\v \x01(\v2\x19.google.protobuf.DurationR\fcheckTimeout\x1a[\n" +
+	"\x05Route\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x18\n" +
+	"\agateway\x18\x02 \x01(\tR\agateway\x12\x16\n" +
+	"\x06metric\x18\x03 \x01(\rR\x06metric\"\xe4\x02\n" +
+	"\x18ConfigureNetworkResponse\x12\x18\n" +
+	"\abackend\x18\x01 \x01(\tR\abackend\x124\n" +
+	"\x05files\x18\x02 \x03(\v2\x1e.ConfigureNetworkResponse.FileR\x05files\x12=\n" +
+	"\bcommands\x18\x03 \x03(\v2!.ConfigureNetworkResponse.CommandR\bcommands\x12\x1f\n" +
+	"\vcheck_error\x18\x04 \x01(\tR\n" +
+	"checkError\x12/\n" +
+	"\tinterface\x18\x05 \x01(\v2\x11.NetworkInterfaceR\tinterface\x1aH\n" +
+	"\x04File\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\rR\x04mode\x1a\x1d\n" +
+	"\aCommand\x12\x12\n" +
+	"\x04args\x18\x01 \x03(\tR\x04args\":\n" +
+	"\n" +
+	"HostsEntry\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x1c\n" +
+	"\thostnames\x18\x02 \x03(\tR\thostnames\"\x18\n" +
+	"\x16GetHostsEntriesRequest\"@\n" +
+	"\x17GetHostsEntriesResponse\x12%\n" +
+	"\aentries\x18\x01 \x03(\v2\v.HostsEntryR\aentries\"?\n" +
+	"\x16SetHostsEntriesRequest\x12%\n" +
+	"\aentries\x18\x01 \x03(\v2\v.HostsEntryR\aentries\"\x19\n" +
+	"\x17SetHostsEntriesResponse\"s\n" +
+	"\x0eResolverConfig\x12 \n" +
+	"\vnameservers\x18\x01 \x03(\tR\vnameservers\x12%\n" +
+	"\x0esearch_domains\x18\x02 \x03(\tR\rsearchDomains\x12\x18\n" +
+	"\aoptions\x18\x03 \x03(\tR\aoptions\"d\n" +
+	"\x14ScopedResolverConfig\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12 \n" +
+	"\vnameservers\x18\x02 \x03(\tR\vnameservers\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\rR\x04port\"\x1a\n" +
+	"\x18GetResolverConfigRequest\"s\n" +
+	"\x19GetResolverConfigResponse\x12'\n" +
+	"\x06global\x18\x01 \x01(\v2\x0f.ResolverConfigR\x06global\x12-\n" +
+	"\x06scoped\x18\x02 \x03(\v2\x15.ScopedResolverConfigR\x06scoped\"\xb2\x01\n" +
+	"\x18SetResolverConfigRequest\x12)\n" +
+	"\x06global\x18\x01 \x01(\v2\x0f.ResolverConfigH\x00R\x06global\x12/\n" +
+	"\x06scoped\x18\x02 \x01(\v2\x15.ScopedResolverConfigH\x00R\x06scoped\x122\n" +
+	"\x14remove_scoped_domain\x18\x03 \x01(\tH\x00R\x12removeScopedDomainB\x06\n" +
+	"\x04type\"\x1b\n" +
+	"\x19SetResolverConfigResponse\"\x16\n" +
+	"\x14GetSystemInfoRequest\"\x80\x06\n" +
+	"\x15GetSystemInfoResponse\x12)\n" +
+	"\x02os\x18\x01 \x01(\v2\x19.GetSystemInfoResponse.OSR\x02os\x12%\n" +
+	"\x0ekernel_version\x18\x02 \x01(\tR\rkernelVersion\x12\"\n" +
+	"\farchitecture\x18\x03 \x01(\tR\farchitecture\x12\x1a\n" +
+	"\bhostname\x18\x04 \x01(\tR\bhostname\x127\n" +
+	"\tboot_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bbootTime\x121\n" +
+	"\x06uptime\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06uptime\x12,\n" +
+	"\x03cpu\x18\a \x01(\v2\x1a.GetSystemInfoResponse.CPUR\x03cpu\x125\n" +
+	"\x06memory\x18\b \x01(\v2\x1d.GetSystemInfoResponse.MemoryR\x06memory\x12!\n" +
+	"\fconsole_user\x18\t \x01(\tR\vconsoleUser\x122\n" +
+	"\x05agent\x18\n" +
+	" \x01(\v2\x1c.GetSystemInfoResponse.AgentR\x05agent\x12!\n" +
+	"\ftart_version\x18\v \x01(\tR\vtartVersion\x1aH\n" +
+	"\x02OS\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
+	"\x05build\x18\x03 \x01(\tR\x05build\x1a1\n" +
+	"\x03CPU\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\rR\x05count\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x1aR\n" +
+	"\x06Memory\x12\x1f\n" +
+	"\vtotal_bytes\x18\x01 \x01(\x04R\n" +
+	"totalBytes\x12'\n" +
+	"\x0favailable_bytes\x18\x02 \x01(\x04R\x0eavailableBytes\x1a9\n" +
+	"\x05Agent\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\"\x18\n" +
+	"\x16GetCapabilitiesRequest\"\xd4\x03\n" +
+	"\x17GetCapabilitiesResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x12#\n" +
+	"\ragent_version\x18\x02 \x01(\tR\fagentVersion\x12\x12\n" +
+	"\x04rpcs\x18\x03 \x03(\tR\x04rpcs\x12B\n" +
+	"\bfeatures\x18\x04 \x03(\v2&.GetCapabilitiesResponse.FeaturesEntryR\bfeatures\x12\x1e\n" +
+	"\n" +
+	"components\x18\x05 \x03(\tR\n" +
+	"components\x127\n" +
+	"\x06limits\x18\x06 \x01(\v2\x1f.GetCapabilitiesResponse.LimitsR\x06limits\x1a\x8b\x01\n" +
+	"\x06Limits\x12(\n" +
+	"\x10max_message_size\x18\x01 \x01(\rR\x0emaxMessageSize\x124\n" +
+	"\x16max_concurrent_streams\x18\x02 \x01(\rR\x14maxConcurrentStreams\x12!\n" +
+	"\fmax_sessions\x18\x03 \x01(\rR\vmaxSessions\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"r\n" +
+	"\x14StreamMetricsRequest\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12#\n" +
+	"\rtop_processes\x18\x02 \x01(\rR\ftopProcesses\"\xc8\r\n" +
+	"\x15StreamMetricsResponse\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12,\n" +
+	"\x03cpu\x18\x02 \x01(\v2\x1a.StreamMetricsResponse.CPUR\x03cpu\x12E\n" +
+	"\fload_average\x18\x03 \x01(\v2\".StreamMetricsResponse.LoadAverageR\vloadAverage\x125\n" +
+	"\x06memory\x18\x04 \x01(\v2\x1d.StreamMetricsResponse.MemoryR\x06memory\x127\n" +
+	"\avolumes\x18\x05 \x03(\v2\x1d.StreamMetricsResponse.VolumeR\avolumes\x121\n" +
+	"\x05disks\x18\x06 \x03(\v2\x1b.StreamMetricsResponse.DiskR\x05disks\x12V\n" +
+	"\x12network_interfaces\x18\a \x03(\v2'.StreamMetricsResponse.NetworkInterfaceR\x11networkInterfaces\x12O\n" +
+	"\x14top_processes_by_cpu\x18\b \x03(\v2\x1e.StreamMetricsResponse.ProcessR\x11topProcessesByCpu\x12U\n" +
+	"\x17top_processes_by_memory\x18\t \x03(\v2\x1e.StreamMetricsResponse.ProcessR\x14topProcessesByMemory\x1a_\n" +
+	"\x03CPU\x12#\n" +
+	"\rusage_percent\x18\x01 \x01(\x01R\fusagePercent\x123\n" +
+	"\x16per_core_usage_percent\x18\x02 \x03(\x01R\x13perCoreUsagePercent\x1ax\n" +
+	"\vLoadAverage\x12\x1d\n" +
+	"\n" +
+	"one_minute\x18\x01 \x01(\x01R\toneMinute\x12!\n" +
+	"\ffive_minutes\x18\x02 \x01(\x01R\vfiveMinutes\x12'\n" +
+	"\x0ffifteen_minutes\x18\x03 \x01(\x01R\x0efifteenMinutes\x1a\xee\x01\n" +
+	"\x06Memory\x12\x1f\n" +
+	"\vtotal_bytes\x18\x01 \x01(\x04R\n" +
+	"totalBytes\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x02 \x01(\x04R\tusedBytes\x12'\n" +
+	"\x0favailable_bytes\x18\x03 \x01(\x04R\x0eavailableBytes\x12(\n" +
+	"\x10swap_total_bytes\x18\x04 \x01(\x04R\x0eswapTotalBytes\x12&\n" +
+	"\x0fswap_used_bytes\x18\x05 \x01(\x04R\rswapUsedBytes\x12)\n" +
+	"\x10pressure_percent\x18\x06 \x01(\x01R\x0fpressurePercent\x1a\xdf\x01\n" +
+	"\x06Volume\x12\x1f\n" +
+	"\vmount_point\x18\x01 \x01(\tR\n" +
+	"mountPoint\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x1f\n" +
+	"\vfile_system\x18\x03 \x01(\tR\n" +
+	"fileSystem\x12\x1f\n" +
+	"\vtotal_bytes\x18\x04 \x01(\x04R\n" +
+	"totalBytes\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x05 \x01(\x04R\tusedBytes\x12'\n" +
+	"\x0favailable_bytes\x18\x06 \x01(\x04R\x0eavailableBytes\x12\x12\n" +
+	"\x04disk\x18\a \x01(\tR\x04disk\x1a\x82\x01\n" +
+	"\x04Disk\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\x15read_bytes_per_second\x18\x02 \x01(\x01R\x12readBytesPerSecond\x123\n" +
+	"\x16write_bytes_per_second\x18\x03 \x01(\x01R\x13writeBytesPerSecond\x1a\x9a\x01\n" +
+	"\x10NetworkInterface\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\x18receive_bytes_per_second\x18\x02 \x01(\x01R\x15receiveBytesPerSecond\x129\n" +
+	"\x19transmit_bytes_per_second\x18\x03 \x01(\x01R\x16transmitBytesPerSecond\x1a\x96\x01\n" +
+	"\aProcess\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12*\n" +
+	"\x11cpu_usage_percent\x18\x04 \x01(\x01R\x0fcpuUsagePercent\x12%\n" +
+	"\x0eresident_bytes\x18\x05 \x01(\x04R\rresidentBytes\"\xab\x02\n" +
+	"\aProcess\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
+	"\x04ppid\x18\x02 \x01(\x05R\x04ppid\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\rR\x03uid\x12\x12\n" +
+	"\x04user\x18\x04 \x01(\tR\x04user\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x12\n" +
+	"\x04args\x18\x06 \x03(\tR\x04args\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"start_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\bcpu_time\x18\t \x01(\v2\x19.google.protobuf.DurationR\acpuTime\x12%\n" +
+	"\x0eresident_bytes\x18\n" +
+	" \x01(\x04R\rresidentBytes\"B\n" +
+	"\x14ListProcessesRequest\x12\x14\n" +
+	"\x05users\x18\x01 \x03(\tR\x05users\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\"?\n" +
+	"\x15ListProcessesResponse\x12&\n" +
+	"\tprocesses\x18\x01 \x03(\v2\b.ProcessR\tprocesses\"{\n" +
+	"\x14SignalProcessRequest\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x16\n" +
+	"\x06signal\x18\x02 \x01(\tR\x06signal\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"\x17\n" +
+	"\x15SignalProcessResponse\"\xd6\x03\n" +
+	"\x10WaitForCondition\x12!\n" +
+	"\vpath_exists\x18\x01 \x01(\tH\x00R\n" +
+	"pathExists\x12'\n" +
+	"\x0eport_listening\x18\x02 \x01(\rH\x00R\rportListening\x12)\n" +
+	"\x0fprocess_running\x18\x03 \x01(\tH\x00R\x0eprocessRunning\x12N\n" +
+	"\x10address_assigned\x18\x04 \x01(\v2!.WaitForCondition.AddressAssignedH\x00R\x0faddressAssigned\x12&\n" +
+	"\x0euser_logged_in\x18\x05 \x01(\tH\x00R\fuserLoggedIn\x12'\n" +
+	"\x0eservice_active\x18\x06 \x01(\tH\x00R\rserviceActive\x1a\xa1\x01\n" +
+	"\x0fAddressAssigned\x12&\n" +
+	"\x06family\x18\x01 \x01(\x0e2\x0e.AddressFamilyR\x06family\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x02 \x03(\tR\n" +
+	"interfaces\x12\x18\n" +
+	"\asubnets\x18\x03 \x03(\tR\asubnets\x12,\n" +
+	"\x12default_route_only\x18\x04 \x01(\bR\x10defaultRouteOnlyB\x06\n" +
+	"\x04type\"\xb8\x01\n" +
+	"\x0eWaitForRequest\x121\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x11.WaitForConditionR\n" +
+	"conditions\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12>\n" +
+	"\rpoll_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\"\xe9\x01\n" +
+	"\x0fWaitForResponse\x12\x1c\n" +
+	"\tsatisfied\x18\x01 \x01(\bR\tsatisfied\x12@\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2 .WaitForResponse.ConditionStatusR\n" +
+	"conditions\x1av\n" +
+	"\x0fConditionStatus\x12/\n" +
+	"\tcondition\x18\x01 \x01(\v2\x11.WaitForConditionR\tcondition\x12\x1c\n" +
+	"\tsatisfied\x18\x02 \x01(\bR\tsatisfied\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x9e\x01\n" +
+	"\x0fShutdownRequest\x12!\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\r.ShutdownModeR\x04mode\x12/\n" +
+	"\x05delay\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05delay\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"skip_hooks\x18\x04 \x01(\bR\tskipHooks\"Q\n" +
+	"\x10ShutdownResponse\x12=\n" +
+	"\fscheduled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"\x17\n" +
+	"\x15CancelShutdownRequest\"\x18\n" +
+	"\x16CancelShutdownResponse\"\xaa\x01\n" +
+	"\x0eSetTimeRequest\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12&\n" +
+	"\x06policy\x18\x02 \x01(\x0e2\x0e.SetTimePolicyR\x06policy\x12@\n" +
+	"\x0estep_threshold\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rstepThreshold\"^\n" +
+	"\x0fSetTimeResponse\x121\n" +
+	"\x06offset\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06offset\x12\x18\n" +
+	"\astepped\x18\x02 \x01(\bR\astepped\"\x83\x01\n" +
+	"\x11WatchClockRequest\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x127\n" +
+	"\tthreshold\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\tthreshold\"\x9b\x02\n" +
+	"\x12WatchClockResponse\x12,\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x18.WatchClockResponse.KindR\x04kind\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12-\n" +
+	"\x04jump\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x04jump\x127\n" +
+	"\tsuspended\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tsuspended\"?\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fKIND_RESUMED\x10\x01\x12\x0f\n" +
+	"\vKIND_JUMPED\x10\x02\"H\n" +
+	"\x14ResetIdentityRequest\x12\x1a\n" +
+	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"p\n" +
+	"\x15ResetIdentityResponse\x12\x1c\n" +
+	"\tperformed\x18\x01 \x01(\bR\tperformed\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x03 \x01(\tR\tmachineId*a\n" +
+	"\rAddressFamily\x12\x1e\n" +
+	"\x1aADDRESS_FAMILY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ADDRESS_FAMILY_IPV4\x10\x01\x12\x17\n" +
+	"\x13ADDRESS_FAMILY_IPV6\x10\x02*\xa2\x01\n" +
+	"\fShutdownMode\x12\x1d\n" +
+	"\x19SHUTDOWN_MODE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SHUTDOWN_MODE_POWEROFF\x10\x01\x12\x18\n" +
+	"\x14SHUTDOWN_MODE_REBOOT\x10\x02\x12\x16\n" +
+	"\x12SHUTDOWN_MODE_HALT\x10\x03\x12%\n" +
+	"!SHUTDOWN_MODE_LOGOUT_CONSOLE_USER\x10\x04*d\n" +
+	"\rSetTimePolicy\x12\x1f\n" +
+	"\x1bSET_TIME_POLICY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SET_TIME_POLICY_STEP\x10\x01\x12\x18\n" +
+	"\x14SET_TIME_POLICY_SLEW\x10\x022\xd3\x13\n" +
 	"\x05Agent\x12'\n" +
 	"\x04Exec\x12\f.ExecRequest\x1a\r.ExecResponse(\x010\x01\x122\n" +
-	"\tResolveIP\x12\x11.ResolveIPRequest\x1a\x12.ResolveIPResponseB5Z3github.com/cirruslabs/tart-guest-agent/internal/rpcb\x06proto3"
+	"\tResolveIP\x12\x11.ResolveIPRequest\x1a\x12.ResolveIPResponse\x124\n" +
+	"\tWatchPath\x12\x11.WatchPathRequest\x1a\x12.WatchPathResponse0\x01\x123\n" +
+	"\bSyncFile\x12\x10.SyncFileRequest\x1a\x11.SyncFileResponse(\x010\x01\x12+\n" +
+	"\x06Upload\x12\x0e.UploadRequest\x1a\x0f.UploadResponse(\x01\x121\n" +
+	"\bDownload\x12\x10.DownloadRequest\x1a\x11.DownloadResponse0\x01\x12>\n" +
+	"\rQueryTransfer\x12\x15.QueryTransferRequest\x1a\x16.QueryTransferResponse\x125\n" +
+	"\n" +
+	"ListXattrs\x12\x12.ListXattrsRequest\x1a\x13.ListXattrsResponse\x12/\n" +
+	"\bGetXattr\x12\x10.GetXattrRequest\x1a\x11.GetXattrResponse\x12/\n" +
+	"\bSetXattr\x12\x10.SetXattrRequest\x1a\x11.SetXattrResponse\x128\n" +
+	"\vRemoveXattr\x12\x13.RemoveXattrRequest\x1a\x14.RemoveXattrResponse\x12;\n" +
+	"\fGetFileFlags\x12\x14.GetFileFlagsRequest\x1a\x15.GetFileFlagsResponse\x12;\n" +
+	"\fSetFileFlags\x12\x14.SetFileFlagsRequest\x1a\x15.SetFileFlagsResponse\x12)\n" +
+	"\x06GetACL\x12\x0e.GetACLRequest\x1a\x0f.GetACLResponse\x12)\n" +
+	"\x06SetACL\x12\x0e.SetACLRequest\x1a\x0f.SetACLResponse\x12I\n" +
+	"\x10CollectArtifacts\x12\x18.CollectArtifactsRequest\x1a\x19.CollectArtifactsResponse0\x01\x12=\n" +
+	"\fWatchNetwork\x12\x14.WatchNetworkRequest\x1a\x15.WatchNetworkResponse0\x01\x129\n" +
+	"\n" +
+	"ForwardTCP\x12\x12.ForwardTCPRequest\x1a\x13.ForwardTCPResponse(\x010\x01\x12C\n" +
+	"\x0eReverseForward\x12\x16.ReverseForwardRequest\x1a\x17.ReverseForwardResponse0\x01\x12c\n" +
+	"\x18ReverseForwardConnection\x12 .ReverseForwardConnectionRequest\x1a!.ReverseForwardConnectionResponse(\x010\x01\x12M\n" +
+	"\x12ListListeningPorts\x12\x1a.ListListeningPortsRequest\x1a\x1b.ListListeningPortsResponse\x12R\n" +
+	"\x13WatchListeningPorts\x12\x1b.WatchListeningPortsRequest\x1a\x1c.WatchListeningPortsResponse0\x01\x12(\n" +
+	"\x05Proxy\x12\r.ProxyRequest\x1a\x0e.ProxyResponse0\x01\x12H\n" +
+	"\x0fProxyConnection\x12\x17.ProxyConnectionRequest\x1a\x18.ProxyConnectionResponse(\x010\x01\x12;\n" +
+	"\fRenewNetwork\x12\x14.RenewNetworkRequest\x1a\x15.RenewNetworkResponse\x12G\n" +
+	"\x10ConfigureNetwork\x12\x18.ConfigureNetworkRequest\x1a\x19.ConfigureNetworkResponse\x12D\n" +
+	"\x0fGetHostsEntries\x12\x17.GetHostsEntriesRequest\x1a\x18.GetHostsEntriesResponse\x12D\n" +
+	"\x0fSetHostsEntries\x12\x17.SetHostsEntriesRequest\x1a\x18.SetHostsEntriesResponse\x12J\n" +
+	"\x11GetResolverConfig\x12\x19.GetResolverConfigRequest\x1a\x1a.GetResolverConfigResponse\x12J\n" +
+	"\x11SetResolverConfig\x12\x19.SetResolverConfigRequest\x1a\x1a.SetResolverConfigResponse\x12>\n" +
+	"\rGetSystemInfo\x12\x15.GetSystemInfoRequest\x1a\x16.GetSystemInfoResponse\x12D\n" +
+	"\x0fGetCapabilities\x12\x17.GetCapabilitiesRequest\x1a\x18.GetCapabilitiesResponse\x12@\n" +
+	"\rStreamMetrics\x12\x15.StreamMetricsRequest\x1a\x16.StreamMetricsResponse0\x01\x12>\n" +
+	"\rListProcesses\x12\x15.ListProcessesRequest\x1a\x16.ListProcessesResponse\x12>\n" +
+	"\rSignalProcess\x12\x15.SignalProcessRequest\x1a\x16.SignalProcessResponse\x12,\n" +
+	"\aWaitFor\x12\x0f.WaitForRequest\x1a\x10.WaitForResponse\x12/\n" +
+	"\bShutdown\x12\x10.ShutdownRequest\x1a\x11.ShutdownResponse\x12A\n" +
+	"\x0eCancelShutdown\x12\x16.CancelShutdownRequest\x1a\x17.CancelShutdownResponse\x12,\n" +
+	"\aSetTime\x12\x0f.SetTimeRequest\x1a\x10.SetTimeResponse\x127\n" +
+	"\n" +
+	"WatchClock\x12\x12.WatchClockRequest\x1a\x13.WatchClockResponse0\x01\x12>\n" +
+	"\rResetIdentity\x12\x15.ResetIdentityRequest\x1a\x16.ResetIdentityResponseB5Z3github.com/cirruslabs/tart-guest-agent/internal/rpcb\x06proto3"
 
 var (
 	file_rpc_agent_proto_rawDescOnce sync.Once
@@ -561,34 +10137,375 @@ func file_rpc_agent_proto_rawDescGZIP() []byte {
 	return file_rpc_agent_proto_rawDescData
 }
 
-var file_rpc_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rpc_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_rpc_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 140)
 var file_rpc_agent_proto_goTypes = []any{
-	(*ExecRequest)(nil),         // 0: ExecRequest
-	(*ExecResponse)(nil),        // 1: ExecResponse
-	(*TerminalSize)(nil),        // 2: TerminalSize
-	(*IOChunk)(nil),             // 3: IOChunk
-	(*ResolveIPRequest)(nil),    // 4: ResolveIPRequest
-	(*ResolveIPResponse)(nil),   // 5: ResolveIPResponse
-	(*ExecRequest_Command)(nil), // 6: ExecRequest.Command
-	(*ExecResponse_Exit)(nil),   // 7: ExecResponse.Exit
+	(AddressFamily)(0),                                    // 0: AddressFamily
+	(ShutdownMode)(0),                                     // 1: ShutdownMode
+	(SetTimePolicy)(0),                                    // 2: SetTimePolicy
+	(WatchPathResponse_Event_Type)(0),                     // 3: WatchPathResponse.Event.Type
+	(CollectArtifactsRequest_Format)(0),                   // 4: CollectArtifactsRequest.Format
+	(CollectArtifactsResponse_Manifest_Skipped_Reason)(0), // 5: CollectArtifactsResponse.Manifest.Skipped.Reason
+	(WatchNetworkResponse_Event_Type)(0),                  // 6: WatchNetworkResponse.Event.Type
+	(ProxyResponse_IncomingConnection_Protocol)(0),        // 7: ProxyResponse.IncomingConnection.Protocol
+	(ProxyConnectionRequest_Reject_Reason)(0),             // 8: ProxyConnectionRequest.Reject.Reason
+	(WatchClockResponse_Kind)(0),                          // 9: WatchClockResponse.Kind
+	(*ExecRequest)(nil),                                   // 10: ExecRequest
+	(*ExecResponse)(nil),                                  // 11: ExecResponse
+	(*TerminalSize)(nil),                                  // 12: TerminalSize
+	(*IOChunk)(nil),                                       // 13: IOChunk
+	(*ResolveIPRequest)(nil),                              // 14: ResolveIPRequest
+	(*ResolveIPResponse)(nil),                             // 15: ResolveIPResponse
+	(*NetworkInterface)(nil),                              // 16: NetworkInterface
+	(*WatchPathRequest)(nil),                              // 17: WatchPathRequest
+	(*WatchPathResponse)(nil),                             // 18: WatchPathResponse
+	(*SyncFileRequest)(nil),                               // 19: SyncFileRequest
+	(*SyncFileResponse)(nil),                              // 20: SyncFileResponse
+	(*UploadRequest)(nil),                                 // 21: UploadRequest
+	(*UploadResponse)(nil),                                // 22: UploadResponse
+	(*DownloadRequest)(nil),                               // 23: DownloadRequest
+	(*DownloadResponse)(nil),                              // 24: DownloadResponse
+	(*QueryTransferRequest)(nil),                          // 25: QueryTransferRequest
+	(*QueryTransferResponse)(nil),                         // 26: QueryTransferResponse
+	(*ExtendedAttribute)(nil),                             // 27: ExtendedAttribute
+	(*ListXattrsRequest)(nil),                             // 28: ListXattrsRequest
+	(*ListXattrsResponse)(nil),                            // 29: ListXattrsResponse
+	(*GetXattrRequest)(nil),                               // 30: GetXattrRequest
+	(*GetXattrResponse)(nil),                              // 31: GetXattrResponse
+	(*SetXattrRequest)(nil),                               // 32: SetXattrRequest
+	(*SetXattrResponse)(nil),                              // 33: SetXattrResponse
+	(*RemoveXattrRequest)(nil),                            // 34: RemoveXattrRequest
+	(*RemoveXattrResponse)(nil),                           // 35: RemoveXattrResponse
+	(*GetFileFlagsRequest)(nil),                           // 36: GetFileFlagsRequest
+	(*GetFileFlagsResponse)(nil),                          // 37: GetFileFlagsResponse
+	(*SetFileFlagsRequest)(nil),                           // 38: SetFileFlagsRequest
+	(*SetFileFlagsResponse)(nil),                          // 39: SetFileFlagsResponse
+	(*GetACLRequest)(nil),                                 // 40: GetACLRequest
+	(*GetACLResponse)(nil),                                // 41: GetACLResponse
+	(*SetACLRequest)(nil),                                 // 42: SetACLRequest
+	(*SetACLResponse)(nil),                                // 43: SetACLResponse
+	(*CollectArtifactsRequest)(nil),                       // 44: CollectArtifactsRequest
+	(*CollectArtifactsResponse)(nil),                      // 45: CollectArtifactsResponse
+	(*WatchNetworkRequest)(nil),                           // 46: WatchNetworkRequest
+	(*WatchNetworkResponse)(nil),                          // 47: WatchNetworkResponse
+	(*ForwardTCPRequest)(nil),                             // 48: ForwardTCPRequest
+	(*ForwardTCPResponse)(nil),                            // 49: ForwardTCPResponse
+	(*ReverseForwardRequest)(nil),                         // 50: ReverseForwardRequest
+	(*ReverseForwardResponse)(nil),                        // 51: ReverseForwardResponse
+	(*ReverseForwardConnectionRequest)(nil),               // 52: ReverseForwardConnectionRequest
+	(*ReverseForwardConnectionResponse)(nil),              // 53: ReverseForwardConnectionResponse
+	(*ListeningPort)(nil),                                 // 54: ListeningPort
+	(*ListListeningPortsRequest)(nil),                     // 55: ListListeningPortsRequest
+	(*ListListeningPortsResponse)(nil),                    // 56: ListListeningPortsResponse
+	(*WatchListeningPortsRequest)(nil),                    // 57: WatchListeningPortsRequest
+	(*WatchListeningPortsResponse)(nil),                   // 58: WatchListeningPortsResponse
+	(*ProxyRequest)(nil),                                  // 59: ProxyRequest
+	(*ProxyResponse)(nil),                                 // 60: ProxyResponse
+	(*ProxyConnectionRequest)(nil),                        // 61: ProxyConnectionRequest
+	(*ProxyConnectionResponse)(nil),                       // 62: ProxyConnectionResponse
+	(*RenewNetworkRequest)(nil),                           // 63: RenewNetworkRequest
+	(*RenewNetworkResponse)(nil),                          // 64: RenewNetworkResponse
+	(*ConfigureNetworkRequest)(nil),                       // 65: ConfigureNetworkRequest
+	(*ConfigureNetworkResponse)(nil),                      // 66: ConfigureNetworkResponse
+	(*HostsEntry)(nil),                                    // 67: HostsEntry
+	(*GetHostsEntriesRequest)(nil),                        // 68: GetHostsEntriesRequest
+	(*GetHostsEntriesResponse)(nil),                       // 69: GetHostsEntriesResponse
+	(*SetHostsEntriesRequest)(nil),                        // 70: SetHostsEntriesRequest
+	(*SetHostsEntriesResponse)(nil),                       // 71: SetHostsEntriesResponse
+	(*ResolverConfig)(nil),                                // 72: ResolverConfig
+	(*ScopedResolverConfig)(nil),                          // 73: ScopedResolverConfig
+	(*GetResolverConfigRequest)(nil),                      // 74: GetResolverConfigRequest
+	(*GetResolverConfigResponse)(nil),                     // 75: GetResolverConfigResponse
+	(*SetResolverConfigRequest)(nil),                      // 76: SetResolverConfigRequest
+	(*SetResolverConfigResponse)(nil),                     // 77: SetResolverConfigResponse
+	(*GetSystemInfoRequest)(nil),                          // 78: GetSystemInfoRequest
+	(*GetSystemInfoResponse)(nil),                         // 79: GetSystemInfoResponse
+	(*GetCapabilitiesRequest)(nil),                        // 80: GetCapabilitiesRequest
+	(*GetCapabilitiesResponse)(nil),                       // 81: GetCapabilitiesResponse
+	(*StreamMetricsRequest)(nil),                          // 82: StreamMetricsRequest
+	(*StreamMetricsResponse)(nil),                         // 83: StreamMetricsResponse
+	(*Process)(nil),                                       // 84: Process
+	(*ListProcessesRequest)(nil),                          // 85: ListProcessesRequest
+	(*ListProcessesResponse)(nil),                         // 86: ListProcessesResponse
+	(*SignalProcessRequest)(nil),                          // 87: SignalProcessRequest
+	(*SignalProcessResponse)(nil),                         // 88: SignalProcessResponse
+	(*WaitForCondition)(nil),                              // 89: WaitForCondition
+	(*WaitForRequest)(nil),                                // 90: WaitForRequest
+	(*WaitForResponse)(nil),                               // 91: WaitForResponse
+	(*ShutdownRequest)(nil),                               // 92: ShutdownRequest
+	(*ShutdownResponse)(nil),                              // 93: ShutdownResponse
+	(*CancelShutdownRequest)(nil),                         // 94: CancelShutdownRequest
+	(*CancelShutdownResponse)(nil),                        // 95: CancelShutdownResponse
+	(*SetTimeRequest)(nil),                                // 96: SetTimeRequest
+	(*SetTimeResponse)(nil),                               // 97: SetTimeResponse
+	(*WatchClockRequest)(nil),                             // 98: WatchClockRequest
+	(*WatchClockResponse)(nil),                            // 99: WatchClockResponse
+	(*ResetIdentityRequest)(nil),                          // 100: ResetIdentityRequest
+	(*ResetIdentityResponse)(nil),                         // 101: ResetIdentityResponse
+	(*ExecRequest_Command)(nil),                           // 102: ExecRequest.Command
+	(*ExecResponse_Exit)(nil),                             // 103: ExecResponse.Exit
+	(*NetworkInterface_Address)(nil),                      // 104: NetworkInterface.Address
+	(*WatchPathResponse_Ready)(nil),                       // 105: WatchPathResponse.Ready
+	(*WatchPathResponse_Event)(nil),                       // 106: WatchPathResponse.Event
+	(*SyncFileRequest_Begin)(nil),                         // 107: SyncFileRequest.Begin
+	(*SyncFileRequest_CopyBlocks)(nil),                    // 108: SyncFileRequest.CopyBlocks
+	(*SyncFileRequest_Commit)(nil),                        // 109: SyncFileRequest.Commit
+	(*SyncFileResponse_Signatures)(nil),                   // 110: SyncFileResponse.Signatures
+	(*SyncFileResponse_SignaturesEnd)(nil),                // 111: SyncFileResponse.SignaturesEnd
+	(*SyncFileResponse_Committed)(nil),                    // 112: SyncFileResponse.Committed
+	(*SyncFileResponse_Signatures_Block)(nil),             // 113: SyncFileResponse.Signatures.Block
+	(*UploadRequest_Begin)(nil),                           // 114: UploadRequest.Begin
+	(*UploadRequest_Commit)(nil),                          // 115: UploadRequest.Commit
+	(*DownloadResponse_Metadata)(nil),                     // 116: DownloadResponse.Metadata
+	(*CollectArtifactsResponse_Manifest)(nil),             // 117: CollectArtifactsResponse.Manifest
+	(*CollectArtifactsResponse_Manifest_Entry)(nil),       // 118: CollectArtifactsResponse.Manifest.Entry
+	(*CollectArtifactsResponse_Manifest_Skipped)(nil),     // 119: CollectArtifactsResponse.Manifest.Skipped
+	(*WatchNetworkResponse_Snapshot)(nil),                 // 120: WatchNetworkResponse.Snapshot
+	(*WatchNetworkResponse_Event)(nil),                    // 121: WatchNetworkResponse.Event
+	(*ForwardTCPRequest_Connect)(nil),                     // 122: ForwardTCPRequest.Connect
+	(*ForwardTCPResponse_Connected)(nil),                  // 123: ForwardTCPResponse.Connected
+	(*ReverseForwardResponse_Listening)(nil),              // 124: ReverseForwardResponse.Listening
+	(*ReverseForwardResponse_IncomingConnection)(nil),     // 125: ReverseForwardResponse.IncomingConnection
+	(*ReverseForwardConnectionRequest_Attach)(nil),        // 126: ReverseForwardConnectionRequest.Attach
+	(*WatchListeningPortsResponse_Snapshot)(nil),          // 127: WatchListeningPortsResponse.Snapshot
+	(*ProxyResponse_Listening)(nil),                       // 128: ProxyResponse.Listening
+	(*ProxyResponse_IncomingConnection)(nil),              // 129: ProxyResponse.IncomingConnection
+	(*ProxyConnectionRequest_Accept)(nil),                 // 130: ProxyConnectionRequest.Accept
+	(*ProxyConnectionRequest_Reject)(nil),                 // 131: ProxyConnectionRequest.Reject
+	(*ConfigureNetworkRequest_Route)(nil),                 // 132: ConfigureNetworkRequest.Route
+	(*ConfigureNetworkResponse_File)(nil),                 // 133: ConfigureNetworkResponse.File
+	(*ConfigureNetworkResponse_Command)(nil),              // 134: ConfigureNetworkResponse.Command
+	(*GetSystemInfoResponse_OS)(nil),                      // 135: GetSystemInfoResponse.OS
+	(*GetSystemInfoResponse_CPU)(nil),                     // 136: GetSystemInfoResponse.CPU
+	(*GetSystemInfoResponse_Memory)(nil),                  // 137: GetSystemInfoResponse.Memory
+	(*GetSystemInfoResponse_Agent)(nil),                   // 138: GetSystemInfoResponse.Agent
+	(*GetCapabilitiesResponse_Limits)(nil),                // 139: GetCapabilitiesResponse.Limits
+	nil,                                                   // 140: GetCapabilitiesResponse.FeaturesEntry
+	(*StreamMetricsResponse_CPU)(nil),                     // 141: StreamMetricsResponse.CPU
+	(*StreamMetricsResponse_LoadAverage)(nil),             // 142: StreamMetricsResponse.LoadAverage
+	(*StreamMetricsResponse_Memory)(nil),                  // 143: StreamMetricsResponse.Memory
+	(*StreamMetricsResponse_Volume)(nil),                  // 144: StreamMetricsResponse.Volume
+	(*StreamMetricsResponse_Disk)(nil),                    // 145: StreamMetricsResponse.Disk
+	(*StreamMetricsResponse_NetworkInterface)(nil),        // 146: StreamMetricsResponse.NetworkInterface
+	(*StreamMetricsResponse_Process)(nil),                 // 147: StreamMetricsResponse.Process
+	(*WaitForCondition_AddressAssigned)(nil),              // 148: WaitForCondition.AddressAssigned
+	(*WaitForResponse_ConditionStatus)(nil),               // 149: WaitForResponse.ConditionStatus
+	(*durationpb.Duration)(nil),                           // 150: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                         // 151: google.protobuf.Timestamp
 }
 var file_rpc_agent_proto_depIdxs = []int32{
-	6, // 0: ExecRequest.command:type_name -> ExecRequest.Command
-	3, // 1: ExecRequest.standard_input:type_name -> IOChunk
-	2, // 2: ExecRequest.terminal_resize:type_name -> TerminalSize
-	7, // 3: ExecResponse.exit:type_name -> ExecResponse.Exit
-	3, // 4: ExecResponse.standard_output:type_name -> IOChunk
-	3, // 5: ExecResponse.standard_error:type_name -> IOChunk
-	2, // 6: ExecRequest.Command.terminal_size:type_name -> TerminalSize
-	0, // 7: Agent.Exec:input_type -> ExecRequest
-	4, // 8: Agent.ResolveIP:input_type -> ResolveIPRequest
-	1, // 9: Agent.Exec:output_type -> ExecResponse
-	5, // 10: Agent.ResolveIP:output_type -> ResolveIPResponse
-	9, // [9:11] is the sub-list for method output_type
-	7, // [7:9] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	102, // 0: ExecRequest.command:type_name -> ExecRequest.Command
+	13,  // 1: ExecRequest.standard_input:type_name -> IOChunk
+	12,  // 2: ExecRequest.terminal_resize:type_name -> TerminalSize
+	103, // 3: ExecResponse.exit:type_name -> ExecResponse.Exit
+	13,  // 4: ExecResponse.standard_output:type_name -> IOChunk
+	13,  // 5: ExecResponse.standard_error:type_name -> IOChunk
+	0,   // 6: ResolveIPRequest.family:type_name -> AddressFamily
+	150, // 7: ResolveIPRequest.wait_timeout:type_name -> google.protobuf.Duration
+	16,  // 8: ResolveIPResponse.interfaces:type_name -> NetworkInterface
+	104, // 9: NetworkInterface.addresses:type_name -> NetworkInterface.Address
+	105, // 10: WatchPathResponse.ready:type_name -> WatchPathResponse.Ready
+	106, // 11: WatchPathResponse.event:type_name -> WatchPathResponse.Event
+	107, // 12: SyncFileRequest.begin:type_name -> SyncFileRequest.Begin
+	108, // 13: SyncFileRequest.copy_blocks:type_name -> SyncFileRequest.CopyBlocks
+	13,  // 14: SyncFileRequest.literal:type_name -> IOChunk
+	109, // 15: SyncFileRequest.commit:type_name -> SyncFileRequest.Commit
+	110, // 16: SyncFileResponse.signatures:type_name -> SyncFileResponse.Signatures
+	111, // 17: SyncFileResponse.signatures_end:type_name -> SyncFileResponse.SignaturesEnd
+	112, // 18: SyncFileResponse.committed:type_name -> SyncFileResponse.Committed
+	114, // 19: UploadRequest.begin:type_name -> UploadRequest.Begin
+	13,  // 20: UploadRequest.data:type_name -> IOChunk
+	115, // 21: UploadRequest.commit:type_name -> UploadRequest.Commit
+	116, // 22: DownloadResponse.metadata:type_name -> DownloadResponse.Metadata
+	13,  // 23: DownloadResponse.data:type_name -> IOChunk
+	27,  // 24: ListXattrsResponse.xattrs:type_name -> ExtendedAttribute
+	27,  // 25: SetXattrRequest.xattr:type_name -> ExtendedAttribute
+	4,   // 26: CollectArtifactsRequest.format:type_name -> CollectArtifactsRequest.Format
+	13,  // 27: CollectArtifactsResponse.data:type_name -> IOChunk
+	117, // 28: CollectArtifactsResponse.manifest:type_name -> CollectArtifactsResponse.Manifest
+	0,   // 29: WatchNetworkRequest.family:type_name -> AddressFamily
+	120, // 30: WatchNetworkResponse.snapshot:type_name -> WatchNetworkResponse.Snapshot
+	121, // 31: WatchNetworkResponse.event:type_name -> WatchNetworkResponse.Event
+	122, // 32: ForwardTCPRequest.connect:type_name -> ForwardTCPRequest.Connect
+	13,  // 33: ForwardTCPRequest.data:type_name -> IOChunk
+	123, // 34: ForwardTCPResponse.connected:type_name -> ForwardTCPResponse.Connected
+	13,  // 35: ForwardTCPResponse.data:type_name -> IOChunk
+	124, // 36: ReverseForwardResponse.listening:type_name -> ReverseForwardResponse.Listening
+	125, // 37: ReverseForwardResponse.incoming_connection:type_name -> ReverseForwardResponse.IncomingConnection
+	126, // 38: ReverseForwardConnectionRequest.attach:type_name -> ReverseForwardConnectionRequest.Attach
+	13,  // 39: ReverseForwardConnectionRequest.data:type_name -> IOChunk
+	13,  // 40: ReverseForwardConnectionResponse.data:type_name -> IOChunk
+	54,  // 41: ListListeningPortsResponse.ports:type_name -> ListeningPort
+	150, // 42: WatchListeningPortsRequest.interval:type_name -> google.protobuf.Duration
+	127, // 43: WatchListeningPortsResponse.snapshot:type_name -> WatchListeningPortsResponse.Snapshot
+	54,  // 44: WatchListeningPortsResponse.port_opened:type_name -> ListeningPort
+	54,  // 45: WatchListeningPortsResponse.port_closed:type_name -> ListeningPort
+	128, // 46: ProxyResponse.listening:type_name -> ProxyResponse.Listening
+	129, // 47: ProxyResponse.incoming_connection:type_name -> ProxyResponse.IncomingConnection
+	130, // 48: ProxyConnectionRequest.accept:type_name -> ProxyConnectionRequest.Accept
+	131, // 49: ProxyConnectionRequest.reject:type_name -> ProxyConnectionRequest.Reject
+	13,  // 50: ProxyConnectionRequest.data:type_name -> IOChunk
+	13,  // 51: ProxyConnectionResponse.data:type_name -> IOChunk
+	150, // 52: RenewNetworkRequest.wait_timeout:type_name -> google.protobuf.Duration
+	16,  // 53: RenewNetworkResponse.interface:type_name -> NetworkInterface
+	132, // 54: ConfigureNetworkRequest.routes:type_name -> ConfigureNetworkRequest.Route
+	150, // 55: ConfigureNetworkRequest.check_timeout:type_name -> google.protobuf.Duration
+	133, // 56: ConfigureNetworkResponse.files:type_name -> ConfigureNetworkResponse.File
+	134, // 57: ConfigureNetworkResponse.commands:type_name -> ConfigureNetworkResponse.Command
+	16,  // 58: ConfigureNetworkResponse.interface:type_name -> NetworkInterface
+	67,  // 59: GetHostsEntriesResponse.entries:type_name -> HostsEntry
+	67,  // 60: SetHostsEntriesRequest.entries:type_name -> HostsEntry
+	72,  // 61: GetResolverConfigResponse.global:type_name -> ResolverConfig
+	73,  // 62: GetResolverConfigResponse.scoped:type_name -> ScopedResolverConfig
+	72,  // 63: SetResolverConfigRequest.global:type_name -> ResolverConfig
+	73,  // 64: SetResolverConfigRequest.scoped:type_name -> ScopedResolverConfig
+	135, // 65: GetSystemInfoResponse.os:type_name -> GetSystemInfoResponse.OS
+	151, // 66: GetSystemInfoResponse.boot_time:type_name -> google.protobuf.Timestamp
+	150, // 67: GetSystemInfoResponse.uptime:type_name -> google.protobuf.Duration
+	136, // 68: GetSystemInfoResponse.cpu:type_name -> GetSystemInfoResponse.CPU
+	137, // 69: GetSystemInfoResponse.memory:type_name -> GetSystemInfoResponse.Memory
+	138, // 70: GetSystemInfoResponse.agent:type_name -> GetSystemInfoResponse.Agent
+	140, // 71: GetCapabilitiesResponse.features:type_name -> GetCapabilitiesResponse.FeaturesEntry
+	139, // 72: GetCapabilitiesResponse.limits:type_name -> GetCapabilitiesResponse.Limits
+	150, // 73: StreamMetricsRequest.interval:type_name -> google.protobuf.Duration
+	151, // 74: StreamMetricsResponse.time:type_name -> google.protobuf.Timestamp
+	141, // 75: StreamMetricsResponse.cpu:type_name -> StreamMetricsResponse.CPU
+	142, // 76: StreamMetricsResponse.load_average:type_name -> StreamMetricsResponse.LoadAverage
+	143, // 77: StreamMetricsResponse.memory:type_name -> StreamMetricsResponse.Memory
+	144, // 78: StreamMetricsResponse.volumes:type_name -> StreamMetricsResponse.Volume
+	145, // 79: StreamMetricsResponse.disks:type_name -> StreamMetricsResponse.Disk
+	146, // 80: StreamMetricsResponse.network_interfaces:type_name -> StreamMetricsResponse.NetworkInterface
+	147, // 81: StreamMetricsResponse.top_processes_by_cpu:type_name -> StreamMetricsResponse.Process
+	147, // 82: StreamMetricsResponse.top_processes_by_memory:type_name -> StreamMetricsResponse.Process
+	151, // 83: Process.start_time:type_name -> google.protobuf.Timestamp
+	150, // 84: Process.cpu_time:type_name -> google.protobuf.Duration
+	84,  // 85: ListProcessesResponse.processes:type_name -> Process
+	151, // 86: SignalProcessRequest.start_time:type_name -> google.protobuf.Timestamp
+	148, // 87: WaitForCondition.address_assigned:type_name -> WaitForCondition.AddressAssigned
+	89,  // 88: WaitForRequest.conditions:type_name -> WaitForCondition
+	150, // 89: WaitForRequest.timeout:type_name -> google.protobuf.Duration
+	150, // 90: WaitForRequest.poll_interval:type_name -> google.protobuf.Duration
+	149, // 91: WaitForResponse.conditions:type_name -> WaitForResponse.ConditionStatus
+	1,   // 92: ShutdownRequest.mode:type_name -> ShutdownMode
+	150, // 93: ShutdownRequest.delay:type_name -> google.protobuf.Duration
+	151, // 94: ShutdownResponse.scheduled_at:type_name -> google.protobuf.Timestamp
+	151, // 95: SetTimeRequest.time:type_name -> google.protobuf.Timestamp
+	2,   // 96: SetTimeRequest.policy:type_name -> SetTimePolicy
+	150, // 97: SetTimeRequest.step_threshold:type_name -> google.protobuf.Duration
+	150, // 98: SetTimeResponse.offset:type_name -> google.protobuf.Duration
+	150, // 99: WatchClockRequest.interval:type_name -> google.protobuf.Duration
+	150, // 100: WatchClockRequest.threshold:type_name -> google.protobuf.Duration
+	9,   // 101: WatchClockResponse.kind:type_name -> WatchClockResponse.Kind
+	151, // 102: WatchClockResponse.time:type_name -> google.protobuf.Timestamp
+	150, // 103: WatchClockResponse.jump:type_name -> google.protobuf.Duration
+	150, // 104: WatchClockResponse.suspended:type_name -> google.protobuf.Duration
+	12,  // 105: ExecRequest.Command.terminal_size:type_name -> TerminalSize
+	0,   // 106: NetworkInterface.Address.family:type_name -> AddressFamily
+	3,   // 107: WatchPathResponse.Event.type:type_name -> WatchPathResponse.Event.Type
+	113, // 108: SyncFileResponse.Signatures.blocks:type_name -> SyncFileResponse.Signatures.Block
+	27,  // 109: UploadRequest.Begin.xattrs:type_name -> ExtendedAttribute
+	27,  // 110: DownloadResponse.Metadata.xattrs:type_name -> ExtendedAttribute
+	118, // 111: CollectArtifactsResponse.Manifest.included:type_name -> CollectArtifactsResponse.Manifest.Entry
+	119, // 112: CollectArtifactsResponse.Manifest.skipped:type_name -> CollectArtifactsResponse.Manifest.Skipped
+	5,   // 113: CollectArtifactsResponse.Manifest.Skipped.reason:type_name -> CollectArtifactsResponse.Manifest.Skipped.Reason
+	16,  // 114: WatchNetworkResponse.Snapshot.interfaces:type_name -> NetworkInterface
+	6,   // 115: WatchNetworkResponse.Event.type:type_name -> WatchNetworkResponse.Event.Type
+	16,  // 116: WatchNetworkResponse.Event.interface:type_name -> NetworkInterface
+	104, // 117: WatchNetworkResponse.Event.address:type_name -> NetworkInterface.Address
+	0,   // 118: WatchNetworkResponse.Event.family:type_name -> AddressFamily
+	150, // 119: ForwardTCPRequest.Connect.timeout:type_name -> google.protobuf.Duration
+	54,  // 120: WatchListeningPortsResponse.Snapshot.ports:type_name -> ListeningPort
+	7,   // 121: ProxyResponse.IncomingConnection.protocol:type_name -> ProxyResponse.IncomingConnection.Protocol
+	8,   // 122: ProxyConnectionRequest.Reject.reason:type_name -> ProxyConnectionRequest.Reject.Reason
+	0,   // 123: WaitForCondition.AddressAssigned.family:type_name -> AddressFamily
+	89,  // 124: WaitForResponse.ConditionStatus.condition:type_name -> WaitForCondition
+	10,  // 125: Agent.Exec:input_type -> ExecRequest
+	14,  // 126: Agent.ResolveIP:input_type -> ResolveIPRequest
+	17,  // 127: Agent.WatchPath:input_type -> WatchPathRequest
+	19,  // 128: Agent.SyncFile:input_type -> SyncFileRequest
+	21,  // 129: Agent.Upload:input_type -> UploadRequest
+	23,  // 130: Agent.Download:input_type -> DownloadRequest
+	25,  // 131: Agent.QueryTransfer:input_type -> QueryTransferRequest
+	28,  // 132: Agent.ListXattrs:input_type -> ListXattrsRequest
+	30,  // 133: Agent.GetXattr:input_type -> GetXattrRequest
+	32,  // 134: Agent.SetXattr:input_type -> SetXattrRequest
+	34,  // 135: Agent.RemoveXattr:input_type -> RemoveXattrRequest
+	36,  // 136: Agent.GetFileFlags:input_type -> GetFileFlagsRequest
+	38,  // 137: Agent.SetFileFlags:input_type -> SetFileFlagsRequest
+	40,  // 138: Agent.GetACL:input_type -> GetACLRequest
+	42,  // 139: Agent.SetACL:input_type -> SetACLRequest
+	44,  // 140: Agent.CollectArtifacts:input_type -> CollectArtifactsRequest
+	46,  // 141: Agent.WatchNetwork:input_type -> WatchNetworkRequest
+	48,  // 142: Agent.ForwardTCP:input_type -> ForwardTCPRequest
+	50,  // 143: Agent.ReverseForward:input_type -> ReverseForwardRequest
+	52,  // 144: Agent.ReverseForwardConnection:input_type -> ReverseForwardConnectionRequest
+	55,  // 145: Agent.ListListeningPorts:input_type -> ListListeningPortsRequest
+	57,  // 146: Agent.WatchListeningPorts:input_type -> WatchListeningPortsRequest
+	59,  // 147: Agent.Proxy:input_type -> ProxyRequest
+	61,  // 148: Agent.ProxyConnection:input_type -> ProxyConnectionRequest
+	63,  // 149: Agent.RenewNetwork:input_type -> RenewNetworkRequest
+	65,  // 150: Agent.ConfigureNetwork:input_type -> ConfigureNetworkRequest
+	68,  // 151: Agent.GetHostsEntries:input_type -> GetHostsEntriesRequest
+	70,  // 152: Agent.SetHostsEntries:input_type -> SetHostsEntriesRequest
+	74,  // 153: Agent.GetResolverConfig:input_type -> GetResolverConfigRequest
+	76,  // 154: Agent.SetResolverConfig:input_type -> SetResolverConfigRequest
+	78,  // 155: Agent.GetSystemInfo:input_type -> GetSystemInfoRequest
+	80,  // 156: Agent.GetCapabilities:input_type -> GetCapabilitiesRequest
+	82,  // 157: Agent.StreamMetrics:input_type -> StreamMetricsRequest
+	85,  // 158: Agent.ListProcesses:input_type -> ListProcessesRequest
+	87,  // 159: Agent.SignalProcess:input_type -> SignalProcessRequest
+	90,  // 160: Agent.WaitFor:input_type -> WaitForRequest
+	92,  // 161: Agent.Shutdown:input_type -> ShutdownRequest
+	94,  // 162: Agent.CancelShutdown:input_type -> CancelShutdownRequest
+	96,  // 163: Agent.SetTime:input_type -> SetTimeRequest
+	98,  // 164: Agent.WatchClock:input_type -> WatchClockRequest
+	100, // 165: Agent.ResetIdentity:input_type -> ResetIdentityRequest
+	11,  // 166: Agent.Exec:output_type -> ExecResponse
+	15,  // 167: Agent.ResolveIP:output_type -> ResolveIPResponse
+	18,  // 168: Agent.WatchPath:output_type -> WatchPathResponse
+	20,  // 169: Agent.SyncFile:output_type -> SyncFileResponse
+	22,  // 170: Agent.Upload:output_type -> UploadResponse
+	24,  // 171: Agent.Download:output_type -> DownloadResponse
+	26,  // 172: Agent.QueryTransfer:output_type -> QueryTransferResponse
+	29,  // 173: Agent.ListXattrs:output_type -> ListXattrsResponse
+	31,  // 174: Agent.GetXattr:output_type -> GetXattrResponse
+	33,  // 175: Agent.SetXattr:output_type -> SetXattrResponse
+	35,  // 176: Agent.RemoveXattr:output_type -> RemoveXattrResponse
+	37,  // 177: Agent.GetFileFlags:output_type -> GetFileFlagsResponse
+	39,  // 178: Agent.SetFileFlags:output_type -> SetFileFlagsResponse
+	41,  // 179: Agent.GetACL:output_type -> GetACLResponse
+	43,  // 180: Agent.SetACL:output_type -> SetACLResponse
+	45,  // 181: Agent.CollectArtifacts:output_type -> CollectArtifactsResponse
+	47,  // 182: Agent.WatchNetwork:output_type -> WatchNetworkResponse
+	49,  // 183: Agent.ForwardTCP:output_type -> ForwardTCPResponse
+	51,  // 184: Agent.ReverseForward:output_type -> ReverseForwardResponse
+	53,  // 185: Agent.ReverseForwardConnection:output_type -> ReverseForwardConnectionResponse
+	56,  // 186: Agent.ListListeningPorts:output_type -> ListListeningPortsResponse
+	58,  // 187: Agent.WatchListeningPorts:output_type -> WatchListeningPortsResponse
+	60,  // 188: Agent.Proxy:output_type -> ProxyResponse
+	62,  // 189: Agent.ProxyConnection:output_type -> ProxyConnectionResponse
+	64,  // 190: Agent.RenewNetwork:output_type -> RenewNetworkResponse
+	66,  // 191: Agent.ConfigureNetwork:output_type -> ConfigureNetworkResponse
+	69,  // 192: Agent.GetHostsEntries:output_type -> GetHostsEntriesResponse
+	71,  // 193: Agent.SetHostsEntries:output_type -> SetHostsEntriesResponse
+	75,  // 194: Agent.GetResolverConfig:output_type -> GetResolverConfigResponse
+	77,  // 195: Agent.SetResolverConfig:output_type -> SetResolverConfigResponse
+	79,  // 196: Agent.GetSystemInfo:output_type -> GetSystemInfoResponse
+	81,  // 197: Agent.GetCapabilities:output_type -> GetCapabilitiesResponse
+	83,  // 198: Agent.StreamMetrics:output_type -> StreamMetricsResponse
+	86,  // 199: Agent.ListProcesses:output_type -> ListProcessesResponse
+	88,  // 200: Agent.SignalProcess:output_type -> SignalProcessResponse
+	91,  // 201: Agent.WaitFor:output_type -> WaitForResponse
+	93,  // 202: Agent.Shutdown:output_type -> ShutdownResponse
+	95,  // 203: Agent.CancelShutdown:output_type -> CancelShutdownResponse
+	97,  // 204: Agent.SetTime:output_type -> SetTimeResponse
+	99,  // 205: Agent.WatchClock:output_type -> WatchClockResponse
+	101, // 206: Agent.ResetIdentity:output_type -> ResetIdentityResponse
+	166, // [166:207] is the sub-list for method output_type
+	125, // [125:166] is the sub-list for method input_type
+	125, // [125:125] is the sub-list for extension type_name
+	125, // [125:125] is the sub-list for extension extendee
+	0,   // [0:125] is the sub-list for field type_name
 }
 
 func init() { file_rpc_agent_proto_init() }
@@ -606,18 +10523,108 @@ func file_rpc_agent_proto_init() {
 		(*ExecResponse_StandardOutput)(nil),
 		(*ExecResponse_StandardError)(nil),
 	}
+	file_rpc_agent_proto_msgTypes[8].OneofWrappers = []any{
+		(*WatchPathResponse_Ready_)(nil),
+		(*WatchPathResponse_Event_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[9].OneofWrappers = []any{
+		(*SyncFileRequest_Begin_)(nil),
+		(*SyncFileRequest_CopyBlocks_)(nil),
+		(*SyncFileRequest_Literal)(nil),
+		(*SyncFileRequest_Commit_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[10].OneofWrappers = []any{
+		(*SyncFileResponse_Signatures_)(nil),
+		(*SyncFileResponse_SignaturesEnd_)(nil),
+		(*SyncFileResponse_Committed_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[11].OneofWrappers = []any{
+		(*UploadRequest_Begin_)(nil),
+		(*UploadRequest_Data)(nil),
+		(*UploadRequest_Commit_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[14].OneofWrappers = []any{
+		(*DownloadResponse_Metadata_)(nil),
+		(*DownloadResponse_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[35].OneofWrappers = []any{
+		(*CollectArtifactsResponse_Data)(nil),
+		(*CollectArtifactsResponse_Manifest_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[37].OneofWrappers = []any{
+		(*WatchNetworkResponse_Snapshot_)(nil),
+		(*WatchNetworkResponse_Event_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[38].OneofWrappers = []any{
+		(*ForwardTCPRequest_Connect_)(nil),
+		(*ForwardTCPRequest_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[39].OneofWrappers = []any{
+		(*ForwardTCPResponse_Connected_)(nil),
+		(*ForwardTCPResponse_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[40].OneofWrappers = []any{
+		(*ReverseForwardRequest_Address)(nil),
+		(*ReverseForwardRequest_UnixSocketPath)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[41].OneofWrappers = []any{
+		(*ReverseForwardResponse_Listening_)(nil),
+		(*ReverseForwardResponse_IncomingConnection_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[42].OneofWrappers = []any{
+		(*ReverseForwardConnectionRequest_Attach_)(nil),
+		(*ReverseForwardConnectionRequest_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[43].OneofWrappers = []any{
+		(*ReverseForwardConnectionResponse_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[48].OneofWrappers = []any{
+		(*WatchListeningPortsResponse_Snapshot_)(nil),
+		(*WatchListeningPortsResponse_PortOpened)(nil),
+		(*WatchListeningPortsResponse_PortClosed)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[50].OneofWrappers = []any{
+		(*ProxyResponse_Listening_)(nil),
+		(*ProxyResponse_IncomingConnection_)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[51].OneofWrappers = []any{
+		(*ProxyConnectionRequest_Accept_)(nil),
+		(*ProxyConnectionRequest_Reject_)(nil),
+		(*ProxyConnectionRequest_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[52].OneofWrappers = []any{
+		(*ProxyConnectionResponse_Data)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[66].OneofWrappers = []any{
+		(*SetResolverConfigRequest_Global)(nil),
+		(*SetResolverConfigRequest_Scoped)(nil),
+		(*SetResolverConfigRequest_RemoveScopedDomain)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[79].OneofWrappers = []any{
+		(*WaitForCondition_PathExists)(nil),
+		(*WaitForCondition_PortListening)(nil),
+		(*WaitForCondition_ProcessRunning)(nil),
+		(*WaitForCondition_AddressAssigned_)(nil),
+		(*WaitForCondition_UserLoggedIn)(nil),
+		(*WaitForCondition_ServiceActive)(nil),
+	}
+	file_rpc_agent_proto_msgTypes[112].OneofWrappers = []any{
+		(*ForwardTCPRequest_Connect_Address)(nil),
+		(*ForwardTCPRequest_Connect_UnixSocketPath)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_agent_proto_rawDesc), len(file_rpc_agent_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      10,
+			NumMessages:   140,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_rpc_agent_proto_goTypes,
 		DependencyIndexes: file_rpc_agent_proto_depIdxs,
+		EnumInfos:         file_rpc_agent_proto_enumTypes,
 		MessageInfos:      file_rpc_agent_proto_msgTypes,
 	}.Build()
 	File_rpc_agent_proto = out.File
@@ -19,8 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Agent_Exec_FullMethodName      = "/Agent/Exec"
-	Agent_ResolveIP_FullMethodName = "/Agent/ResolveIP"
+	Agent_Exec_FullMethodName                     = "/Agent/Exec"
+	Agent_ResolveIP_FullMethodName                = "/Agent/ResolveIP"
+	Agent_WatchPath_FullMethodName                = "/Agent/WatchPath"
+	Agent_SyncFile_FullMethodName                 = "/Agent/SyncFile"
+	Agent_Upload_FullMethodName                   = "/Agent/Upload"
+	Agent_Download_FullMethodName                 = "/Agent/Download"
+	Agent_QueryTransfer_FullMethodName            = "/Agent/QueryTransfer"
+	Agent_ListXattrs_FullMethodName               = "/Agent/ListXattrs"
+	Agent_GetXattr_FullMethodName                 = "/Agent/GetXattr"
+	Agent_SetXattr_FullMethodName                 = "/Agent/SetXattr"
+	Agent_RemoveXattr_FullMethodName              = "/Agent/RemoveXattr"
+	Agent_GetFileFlags_FullMethodName             = "/Agent/GetFileFlags"
+	Agent_SetFileFlags_FullMethodName             = "/Agent/SetFileFlags"
+	Agent_GetACL_FullMethodName                   = "/Agent/GetACL"
+	Agent_SetACL_FullMethodName                   = "/Agent/SetACL"
+	Agent_CollectArtifacts_FullMethodName         = "/Agent/CollectArtifacts"
+	Agent_WatchNetwork_FullMethodName             = "/Agent/WatchNetwork"
+	Agent_ForwardTCP_FullMethodName               = "/Agent/ForwardTCP"
+	Agent_ReverseForward_FullMethodName           = "/Agent/ReverseForward"
+	Agent_ReverseForwardConnection_FullMethodName = "/Agent/ReverseForwardConnection"
+	Agent_ListListeningPorts_FullMethodName       = "/Agent/ListListeningPorts"
+	Agent_WatchListeningPorts_FullMethodName      = "/Agent/WatchListeningPorts"
+	Agent_Proxy_FullMethodName                    = "/Agent/Proxy"
+	Agent_ProxyConnection_FullMethodName          = "/Agent/ProxyConnection"
+	Agent_RenewNetwork_FullMethodName             = "/Agent/RenewNetwork"
+	Agent_ConfigureNetwork_FullMethodName         = "/Agent/ConfigureNetwork"
+	Agent_GetHostsEntries_FullMethodName          = "/Agent/GetHostsEntries"
+	Agent_SetHostsEntries_FullMethodName          = "/Agent/SetHostsEntries"
+	Agent_GetResolverConfig_FullMethodName        = "/Agent/GetResolverConfig"
+	Agent_SetResolverConfig_FullMethodName        = "/Agent/SetResolverConfig"
+	Agent_GetSystemInfo_FullMethodName            = "/Agent/GetSystemInfo"
+	Agent_GetCapabilities_FullMethodName          = "/Agent/GetCapabilities"
+	Agent_StreamMetrics_FullMethodName            = "/Agent/StreamMetrics"
+	Agent_ListProcesses_FullMethodName            = "/Agent/ListProcesses"
+	Agent_SignalProcess_FullMethodName            = "/Agent/SignalProcess"
+	Agent_WaitFor_FullMethodName                  = "/Agent/WaitFor"
+	Agent_Shutdown_FullMethodName                 = "/Agent/Shutdown"
+	Agent_CancelShutdown_FullMethodName           = "/Agent/CancelShutdown"
+	Agent_SetTime_FullMethodName                  = "/Agent/SetTime"
+	Agent_WatchClock_FullMethodName               = "/Agent/WatchClock"
+	Agent_ResetIdentity_FullMethodName            = "/Agent/ResetIdentity"
 )
 
 // AgentClient is the client API for Agent service.
@@ -29,6 +68,45 @@ const (
 type AgentClient interface {
 	Exec(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecRequest, ExecResponse], error)
 	ResolveIP(ctx context.Context, in *ResolveIPRequest, opts ...grpc.CallOption) (*ResolveIPResponse, error)
+	WatchPath(ctx context.Context, in *WatchPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPathResponse], error)
+	SyncFile(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SyncFileRequest, SyncFileResponse], error)
+	Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadRequest, UploadResponse], error)
+	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadResponse], error)
+	QueryTransfer(ctx context.Context, in *QueryTransferRequest, opts ...grpc.CallOption) (*QueryTransferResponse, error)
+	ListXattrs(ctx context.Context, in *ListXattrsRequest, opts ...grpc.CallOption) (*ListXattrsResponse, error)
+	GetXattr(ctx context.Context, in *GetXattrRequest, opts ...grpc.CallOption) (*GetXattrResponse, error)
+	SetXattr(ctx context.Context, in *SetXattrRequest, opts ...grpc.CallOption) (*SetXattrResponse, error)
+	RemoveXattr(ctx context.Context, in *RemoveXattrRequest, opts ...grpc.CallOption) (*RemoveXattrResponse, error)
+	GetFileFlags(ctx context.Context, in *GetFileFlagsRequest, opts ...grpc.CallOption) (*GetFileFlagsResponse, error)
+	SetFileFlags(ctx context.Context, in *SetFileFlagsRequest, opts ...grpc.CallOption) (*SetFileFlagsResponse, error)
+	GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error)
+	SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error)
+	CollectArtifacts(ctx context.Context, in *CollectArtifactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CollectArtifactsResponse], error)
+	WatchNetwork(ctx context.Context, in *WatchNetworkRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchNetworkResponse], error)
+	ForwardTCP(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ForwardTCPRequest, ForwardTCPResponse], error)
+	ReverseForward(ctx context.Context, in *ReverseForwardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReverseForwardResponse], error)
+	ReverseForwardConnection(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse], error)
+	ListListeningPorts(ctx context.Context, in *ListListeningPortsRequest, opts ...grpc.CallOption) (*ListListeningPortsResponse, error)
+	WatchListeningPorts(ctx context.Context, in *WatchListeningPortsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchListeningPortsResponse], error)
+	Proxy(ctx context.Context, in *ProxyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProxyResponse], error)
+	ProxyConnection(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ProxyConnectionRequest, ProxyConnectionResponse], error)
+	RenewNetwork(ctx context.Context, in *RenewNetworkRequest, opts ...grpc.CallOption) (*RenewNetworkResponse, error)
+	ConfigureNetwork(ctx context.Context, in *ConfigureNetworkRequest, opts ...grpc.CallOption) (*ConfigureNetworkResponse, error)
+	GetHostsEntries(ctx context.Context, in *GetHostsEntriesRequest, opts ...grpc.CallOption) (*GetHostsEntriesResponse, error)
+	SetHostsEntries(ctx context.Context, in *SetHostsEntriesRequest, opts ...grpc.CallOption) (*SetHostsEntriesResponse, error)
+	GetResolverConfig(ctx context.Context, in *GetResolverConfigRequest, opts ...grpc.CallOption) (*GetResolverConfigResponse, error)
+	SetResolverConfig(ctx context.Context, in *SetResolverConfigRequest, opts ...grpc.CallOption) (*SetResolverConfigResponse, error)
+	GetSystemInfo(ctx context.Context, in *GetSystemInfoRequest, opts ...grpc.CallOption) (*GetSystemInfoResponse, error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	StreamMetrics(ctx context.Context, in *StreamMetricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamMetricsResponse], error)
+	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error)
+	SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*SignalProcessResponse, error)
+	WaitFor(ctx context.Context, in *WaitForRequest, opts ...grpc.CallOption) (*WaitForResponse, error)
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+	CancelShutdown(ctx context.Context, in *CancelShutdownRequest, opts ...grpc.CallOption) (*CancelShutdownResponse, error)
+	SetTime(ctx context.Context, in *SetTimeRequest, opts ...grpc.CallOption) (*SetTimeResponse, error)
+	WatchClock(ctx context.Context, in *WatchClockRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchClockResponse], error)
+	ResetIdentity(ctx context.Context, in *ResetIdentityRequest, opts ...grpc.CallOption) (*ResetIdentityResponse, error)
 }
 
 type agentClient struct {
@@ -62,70 +140,1296 @@ func (c *agentClient) ResolveIP(ctx context.Context, in *ResolveIPRequest, opts
 	return out, nil
 }
 
+func (c *agentClient) WatchPath(ctx context.Context, in *WatchPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPathResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[1], Agent_WatchPath_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPathRequest, WatchPathResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchPathClient = grpc.ServerStreamingClient[WatchPathResponse]
+
+func (c *agentClient) SyncFile(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SyncFileRequest, SyncFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[2], Agent_SyncFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SyncFileRequest, SyncFileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_SyncFileClient = grpc.BidiStreamingClient[SyncFileRequest, SyncFileResponse]
+
+func (c *agentClient) Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadRequest, UploadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[3], Agent_Upload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadRequest, UploadResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_UploadClient = grpc.ClientStreamingClient[UploadRequest, UploadResponse]
+
+func (c *agentClient) Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[4], Agent_Download_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadRequest, DownloadResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_DownloadClient = grpc.ServerStreamingClient[DownloadResponse]
+
+func (c *agentClient) QueryTransfer(ctx context.Context, in *QueryTransferRequest, opts ...grpc.CallOption) (*QueryTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferResponse)
+	err := c.cc.Invoke(ctx, Agent_QueryTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ListXattrs(ctx context.Context, in *ListXattrsRequest, opts ...grpc.CallOption) (*ListXattrsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListXattrsResponse)
+	err := c.cc.Invoke(ctx, Agent_ListXattrs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetXattr(ctx context.Context, in *GetXattrRequest, opts ...grpc.CallOption) (*GetXattrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetXattrResponse)
+	err := c.cc.Invoke(ctx, Agent_GetXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetXattr(ctx context.Context, in *SetXattrRequest, opts ...grpc.CallOption) (*SetXattrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetXattrResponse)
+	err := c.cc.Invoke(ctx, Agent_SetXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) RemoveXattr(ctx context.Context, in *RemoveXattrRequest, opts ...grpc.CallOption) (*RemoveXattrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveXattrResponse)
+	err := c.cc.Invoke(ctx, Agent_RemoveXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetFileFlags(ctx context.Context, in *GetFileFlagsRequest, opts ...grpc.CallOption) (*GetFileFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileFlagsResponse)
+	err := c.cc.Invoke(ctx, Agent_GetFileFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetFileFlags(ctx context.Context, in *SetFileFlagsRequest, opts ...grpc.CallOption) (*SetFileFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFileFlagsResponse)
+	err := c.cc.Invoke(ctx, Agent_SetFileFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetACLResponse)
+	err := c.cc.Invoke(ctx, Agent_GetACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetACLResponse)
+	err := c.cc.Invoke(ctx, Agent_SetACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CollectArtifacts(ctx context.Context, in *CollectArtifactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CollectArtifactsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[5], Agent_CollectArtifacts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CollectArtifactsRequest, CollectArtifactsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_CollectArtifactsClient = grpc.ServerStreamingClient[CollectArtifactsResponse]
+
+func (c *agentClient) WatchNetwork(ctx context.Context, in *WatchNetworkRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchNetworkResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[6], Agent_WatchNetwork_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNetworkRequest, WatchNetworkResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchNetworkClient = grpc.ServerStreamingClient[WatchNetworkResponse]
+
+func (c *agentClient) ForwardTCP(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ForwardTCPRequest, ForwardTCPResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[7], Agent_ForwardTCP_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ForwardTCPRequest, ForwardTCPResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ForwardTCPClient = grpc.BidiStreamingClient[ForwardTCPRequest, ForwardTCPResponse]
+
+func (c *agentClient) ReverseForward(ctx context.Context, in *ReverseForwardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReverseForwardResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[8], Agent_ReverseForward_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReverseForwardRequest, ReverseForwardResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ReverseForwardClient = grpc.ServerStreamingClient[ReverseForwardResponse]
+
+func (c *agentClient) ReverseForwardConnection(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[9], Agent_ReverseForwardConnection_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ReverseForwardConnectionClient = grpc.BidiStreamingClient[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]
+
+func (c *agentClient) ListListeningPorts(ctx context.Context, in *ListListeningPortsRequest, opts ...grpc.CallOption) (*ListListeningPortsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListListeningPortsResponse)
+	err := c.cc.Invoke(ctx, Agent_ListListeningPorts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) WatchListeningPorts(ctx context.Context, in *WatchListeningPortsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchListeningPortsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[10], Agent_WatchListeningPorts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchListeningPortsRequest, WatchListeningPortsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchListeningPortsClient = grpc.ServerStreamingClient[WatchListeningPortsResponse]
+
+func (c *agentClient) Proxy(ctx context.Context, in *ProxyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProxyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[11], Agent_Proxy_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProxyRequest, ProxyResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ProxyClient = grpc.ServerStreamingClient[ProxyResponse]
+
+func (c *agentClient) ProxyConnection(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ProxyConnectionRequest, ProxyConnectionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[12], Agent_ProxyConnection_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProxyConnectionRequest, ProxyConnectionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ProxyConnectionClient = grpc.BidiStreamingClient[ProxyConnectionRequest, ProxyConnectionResponse]
+
+func (c *agentClient) RenewNetwork(ctx context.Context, in *RenewNetworkRequest, opts ...grpc.CallOption) (*RenewNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewNetworkResponse)
+	err := c.cc.Invoke(ctx, Agent_RenewNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ConfigureNetwork(ctx context.Context, in *ConfigureNetworkRequest, opts ...grpc.CallOption) (*ConfigureNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigureNetworkResponse)
+	err := c.cc.Invoke(ctx, Agent_ConfigureNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetHostsEntries(ctx context.Context, in *GetHostsEntriesRequest, opts ...grpc.CallOption) (*GetHostsEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHostsEntriesResponse)
+	err := c.cc.Invoke(ctx, Agent_GetHostsEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetHostsEntries(ctx context.Context, in *SetHostsEntriesRequest, opts ...grpc.CallOption) (*SetHostsEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHostsEntriesResponse)
+	err := c.cc.Invoke(ctx, Agent_SetHostsEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetResolverConfig(ctx context.Context, in *GetResolverConfigRequest, opts ...grpc.CallOption) (*GetResolverConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResolverConfigResponse)
+	err := c.cc.Invoke(ctx, Agent_GetResolverConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetResolverConfig(ctx context.Context, in *SetResolverConfigRequest, opts ...grpc.CallOption) (*SetResolverConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResolverConfigResponse)
+	err := c.cc.Invoke(ctx, Agent_SetResolverConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetSystemInfo(ctx context.Context, in *GetSystemInfoRequest, opts ...grpc.CallOption) (*GetSystemInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemInfoResponse)
+	err := c.cc.Invoke(ctx, Agent_GetSystemInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, Agent_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) StreamMetrics(ctx context.Context, in *StreamMetricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamMetricsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[13], Agent_StreamMetrics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMetricsRequest, StreamMetricsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_StreamMetricsClient = grpc.ServerStreamingClient[StreamMetricsResponse]
+
+func (c *agentClient) ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProcessesResponse)
+	err := c.cc.Invoke(ctx, Agent_ListProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*SignalProcessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignalProcessResponse)
+	err := c.cc.Invoke(ctx, Agent_SignalProcess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) WaitFor(ctx context.Context, in *WaitForRequest, opts ...grpc.CallOption) (*WaitForResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForResponse)
+	err := c.cc.Invoke(ctx, Agent_WaitFor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShutdownResponse)
+	err := c.cc.Invoke(ctx, Agent_Shutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CancelShutdown(ctx context.Context, in *CancelShutdownRequest, opts ...grpc.CallOption) (*CancelShutdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelShutdownResponse)
+	err := c.cc.Invoke(ctx, Agent_CancelShutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SetTime(ctx context.Context, in *SetTimeRequest, opts ...grpc.CallOption) (*SetTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTimeResponse)
+	err := c.cc.Invoke(ctx, Agent_SetTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) WatchClock(ctx context.Context, in *WatchClockRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchClockResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[14], Agent_WatchClock_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchClockRequest, WatchClockResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchClockClient = grpc.ServerStreamingClient[WatchClockResponse]
+
+func (c *agentClient) ResetIdentity(ctx context.Context, in *ResetIdentityRequest, opts ...grpc.CallOption) (*ResetIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetIdentityResponse)
+	err := c.cc.Invoke(ctx, Agent_ResetIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility.
 type AgentServer interface {
 	Exec(grpc.BidiStreamingServer[ExecRequest, ExecResponse]) error
 	ResolveIP(context.Context, *ResolveIPRequest) (*ResolveIPResponse, error)
+	WatchPath(*WatchPathRequest, grpc.ServerStreamingServer[WatchPathResponse]) error
+	SyncFile(grpc.BidiStreamingServer[SyncFileRequest, SyncFileResponse]) error
+	Upload(grpc.ClientStreamingServer[UploadRequest, UploadResponse]) error
+	Download(*DownloadRequest, grpc.ServerStreamingServer[DownloadResponse]) error
+	QueryTransfer(context.Context, *QueryTransferRequest) (*QueryTransferResponse, error)
+	ListXattrs(context.Context, *ListXattrsRequest) (*ListXattrsResponse, error)
+	GetXattr(context.Context, *GetXattrRequest) (*GetXattrResponse, error)
+	SetXattr(context.Context, *SetXattrRequest) (*SetXattrResponse, error)
+	RemoveXattr(context.Context, *RemoveXattrRequest) (*RemoveXattrResponse, error)
+	GetFileFlags(context.Context, *GetFileFlagsRequest) (*GetFileFlagsResponse, error)
+	SetFileFlags(context.Context, *SetFileFlagsRequest) (*SetFileFlagsResponse, error)
+	GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error)
+	SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error)
+	CollectArtifacts(*CollectArtifactsRequest, grpc.ServerStreamingServer[CollectArtifactsResponse]) error
+	WatchNetwork(*WatchNetworkRequest, grpc.ServerStreamingServer[WatchNetworkResponse]) error
+	ForwardTCP(grpc.BidiStreamingServer[ForwardTCPRequest, ForwardTCPResponse]) error
+	ReverseForward(*ReverseForwardRequest, grpc.ServerStreamingServer[ReverseForwardResponse]) error
+	ReverseForwardConnection(grpc.BidiStreamingServer[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]) error
+	ListListeningPorts(context.Context, *ListListeningPortsRequest) (*ListListeningPortsResponse, error)
+	WatchListeningPorts(*WatchListeningPortsRequest, grpc.ServerStreamingServer[WatchListeningPortsResponse]) error
+	Proxy(*ProxyRequest, grpc.ServerStreamingServer[ProxyResponse]) error
+	ProxyConnection(grpc.BidiStreamingServer[ProxyConnectionRequest, ProxyConnectionResponse]) error
+	RenewNetwork(context.Context, *RenewNetworkRequest) (*RenewNetworkResponse, error)
+	ConfigureNetwork(context.Context, *ConfigureNetworkRequest) (*ConfigureNetworkResponse, error)
+	GetHostsEntries(context.Context, *GetHostsEntriesRequest) (*GetHostsEntriesResponse, error)
+	SetHostsEntries(context.Context, *SetHostsEntriesRequest) (*SetHostsEntriesResponse, error)
+	GetResolverConfig(context.Context, *GetResolverConfigRequest) (*GetResolverConfigResponse, error)
+	SetResolverConfig(context.Context, *SetResolverConfigRequest) (*SetResolverConfigResponse, error)
+	GetSystemInfo(context.Context, *GetSystemInfoRequest) (*GetSystemInfoResponse, error)
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
+	StreamMetrics(*StreamMetricsRequest, grpc.ServerStreamingServer[StreamMetricsResponse]) error
+	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
+	SignalProcess(context.Context, *SignalProcessRequest) (*SignalProcessResponse, error)
+	WaitFor(context.Context, *WaitForRequest) (*WaitForResponse, error)
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	CancelShutdown(context.Context, *CancelShutdownRequest) (*CancelShutdownResponse, error)
+	SetTime(context.Context, *SetTimeRequest) (*SetTimeResponse, error)
+	WatchClock(*WatchClockRequest, grpc.ServerStreamingServer[WatchClockResponse]) error
+	ResetIdentity(context.Context, *ResetIdentityRequest) (*ResetIdentityResponse, error)
+	mustEmbedUnimplementedAgentServer()
+}
+
+// UnimplementedAgentServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAgentServer struct{}
+
+func (UnimplementedAgentServer) Exec(grpc.BidiStreamingServer[ExecRequest, ExecResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedAgentServer) ResolveIP(context.Context, *ResolveIPRequest) (*ResolveIPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveIP not implemented")
+}
+func (UnimplementedAgentServer) WatchPath(*WatchPathRequest, grpc.ServerStreamingServer[WatchPathResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPath not implemented")
+}
+func (UnimplementedAgentServer) SyncFile(grpc.BidiStreamingServer[SyncFileRequest, SyncFileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SyncFile not implemented")
+}
+func (UnimplementedAgentServer) Upload(grpc.ClientStreamingServer[UploadRequest, UploadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedAgentServer) Download(*DownloadRequest, grpc.ServerStreamingServer[DownloadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Download not implemented")
+}
+func (UnimplementedAgentServer) QueryTransfer(context.Context, *QueryTransferRequest) (*QueryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTransfer not implemented")
+}
+func (UnimplementedAgentServer) ListXattrs(context.Context, *ListXattrsRequest) (*ListXattrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListXattrs not implemented")
+}
+func (UnimplementedAgentServer) GetXattr(context.Context, *GetXattrRequest) (*GetXattrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetXattr not implemented")
+}
+func (UnimplementedAgentServer) SetXattr(context.Context, *SetXattrRequest) (*SetXattrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetXattr not implemented")
+}
+func (UnimplementedAgentServer) RemoveXattr(context.Context, *RemoveXattrRequest) (*RemoveXattrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveXattr not implemented")
+}
+func (UnimplementedAgentServer) GetFileFlags(context.Context, *GetFileFlagsRequest) (*GetFileFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileFlags not implemented")
+}
+func (UnimplementedAgentServer) SetFileFlags(context.Context, *SetFileFlagsRequest) (*SetFileFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileFlags not implemented")
+}
+func (UnimplementedAgentServer) GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetACL not implemented")
+}
+func (UnimplementedAgentServer) SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetACL not implemented")
+}
+func (UnimplementedAgentServer) CollectArtifacts(*CollectArtifactsRequest, grpc.ServerStreamingServer[CollectArtifactsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CollectArtifacts not implemented")
+}
+func (UnimplementedAgentServer) WatchNetwork(*WatchNetworkRequest, grpc.ServerStreamingServer[WatchNetworkResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNetwork not implemented")
+}
+func (UnimplementedAgentServer) ForwardTCP(grpc.BidiStreamingServer[ForwardTCPRequest, ForwardTCPResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ForwardTCP not implemented")
+}
+func (UnimplementedAgentServer) ReverseForward(*ReverseForwardRequest, grpc.ServerStreamingServer[ReverseForwardResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReverseForward not implemented")
+}
+func (UnimplementedAgentServer) ReverseForwardConnection(grpc.BidiStreamingServer[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReverseForwardConnection not implemented")
+}
+func (UnimplementedAgentServer) ListListeningPorts(context.Context, *ListListeningPortsRequest) (*ListListeningPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListListeningPorts not implemented")
+}
+func (UnimplementedAgentServer) WatchListeningPorts(*WatchListeningPortsRequest, grpc.ServerStreamingServer[WatchListeningPortsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchListeningPorts not implemented")
+}
+func (UnimplementedAgentServer) Proxy(*ProxyRequest, grpc.ServerStreamingServer[ProxyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Proxy not implemented")
+}
+func (UnimplementedAgentServer) ProxyConnection(grpc.BidiStreamingServer[ProxyConnectionRequest, ProxyConnectionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ProxyConnection not implemented")
+}
+func (UnimplementedAgentServer) RenewNetwork(context.Context, *RenewNetworkRequest) (*RenewNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewNetwork not implemented")
+}
+func (UnimplementedAgentServer) ConfigureNetwork(context.Context, *ConfigureNetworkRequest) (*ConfigureNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureNetwork not implemented")
+}
+func (UnimplementedAgentServer) GetHostsEntries(context.Context, *GetHostsEntriesRequest) (*GetHostsEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostsEntries not implemented")
+}
+func (UnimplementedAgentServer) SetHostsEntries(context.Context, *SetHostsEntriesRequest) (*SetHostsEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHostsEntries not implemented")
+}
+func (UnimplementedAgentServer) GetResolverConfig(context.Context, *GetResolverConfigRequest) (*GetResolverConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResolverConfig not implemented")
+}
+func (UnimplementedAgentServer) SetResolverConfig(context.Context, *SetResolverConfigRequest) (*SetResolverConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResolverConfig not implemented")
+}
+func (UnimplementedAgentServer) GetSystemInfo(context.Context, *GetSystemInfoRequest) (*GetSystemInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemInfo not implemented")
+}
+func (UnimplementedAgentServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedAgentServer) StreamMetrics(*StreamMetricsRequest, grpc.ServerStreamingServer[StreamMetricsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMetrics not implemented")
+}
+func (UnimplementedAgentServer) ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcesses not implemented")
+}
+func (UnimplementedAgentServer) SignalProcess(context.Context, *SignalProcessRequest) (*SignalProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalProcess not implemented")
+}
+func (UnimplementedAgentServer) WaitFor(context.Context, *WaitForRequest) (*WaitForResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitFor not implemented")
+}
+func (UnimplementedAgentServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedAgentServer) CancelShutdown(context.Context, *CancelShutdownRequest) (*CancelShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShutdown not implemented")
+}
+func (UnimplementedAgentServer) SetTime(context.Context, *SetTimeRequest) (*SetTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTime not implemented")
+}
+func (UnimplementedAgentServer) WatchClock(*WatchClockRequest, grpc.ServerStreamingServer[WatchClockResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClock not implemented")
+}
+func (UnimplementedAgentServer) ResetIdentity(context.Context, *ResetIdentityRequest) (*ResetIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetIdentity not implemented")
+}
+func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
+func (UnimplementedAgentServer) testEmbeddedByValue()               {}
+
+// UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentServer will
+// result in compilation errors.
+type UnsafeAgentServer interface {
 	mustEmbedUnimplementedAgentServer()
 }
 
-// UnimplementedAgentServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAgentServer struct{}
+func RegisterAgentServer(s grpc.ServiceRegistrar, srv AgentServer) {
+	// If the following call pancis, it indicates UnimplementedAgentServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Agent_ServiceDesc, srv)
+}
+
+func _Agent_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).Exec(&grpc.GenericServerStream[ExecRequest, ExecResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ExecServer = grpc.BidiStreamingServer[ExecRequest, ExecResponse]
+
+func _Agent_ResolveIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ResolveIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_ResolveIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ResolveIP(ctx, req.(*ResolveIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_WatchPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).WatchPath(m, &grpc.GenericServerStream[WatchPathRequest, WatchPathResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchPathServer = grpc.ServerStreamingServer[WatchPathResponse]
+
+func _Agent_SyncFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).SyncFile(&grpc.GenericServerStream[SyncFileRequest, SyncFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_SyncFileServer = grpc.BidiStreamingServer[SyncFileRequest, SyncFileResponse]
+
+func _Agent_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).Upload(&grpc.GenericServerStream[UploadRequest, UploadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_UploadServer = grpc.ClientStreamingServer[UploadRequest, UploadResponse]
+
+func _Agent_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).Download(m, &grpc.GenericServerStream[DownloadRequest, DownloadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_DownloadServer = grpc.ServerStreamingServer[DownloadResponse]
+
+func _Agent_QueryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).QueryTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_QueryTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).QueryTransfer(ctx, req.(*QueryTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ListXattrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListXattrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListXattrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_ListXattrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListXattrs(ctx, req.(*ListXattrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetXattrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetXattr(ctx, req.(*GetXattrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SetXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetXattrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SetXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetXattr(ctx, req.(*SetXattrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_RemoveXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveXattrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).RemoveXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_RemoveXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).RemoveXattr(ctx, req.(*RemoveXattrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetFileFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetFileFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetFileFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetFileFlags(ctx, req.(*GetFileFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SetFileFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFileFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetFileFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SetFileFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetFileFlags(ctx, req.(*SetFileFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetACL(ctx, req.(*GetACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SetACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetACL(ctx, req.(*SetACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CollectArtifacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CollectArtifactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).CollectArtifacts(m, &grpc.GenericServerStream[CollectArtifactsRequest, CollectArtifactsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_CollectArtifactsServer = grpc.ServerStreamingServer[CollectArtifactsResponse]
+
+func _Agent_WatchNetwork_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNetworkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).WatchNetwork(m, &grpc.GenericServerStream[WatchNetworkRequest, WatchNetworkResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchNetworkServer = grpc.ServerStreamingServer[WatchNetworkResponse]
+
+func _Agent_ForwardTCP_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ForwardTCP(&grpc.GenericServerStream[ForwardTCPRequest, ForwardTCPResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ForwardTCPServer = grpc.BidiStreamingServer[ForwardTCPRequest, ForwardTCPResponse]
+
+func _Agent_ReverseForward_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReverseForwardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).ReverseForward(m, &grpc.GenericServerStream[ReverseForwardRequest, ReverseForwardResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ReverseForwardServer = grpc.ServerStreamingServer[ReverseForwardResponse]
+
+func _Agent_ReverseForwardConnection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ReverseForwardConnection(&grpc.GenericServerStream[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ReverseForwardConnectionServer = grpc.BidiStreamingServer[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]
+
+func _Agent_ListListeningPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListListeningPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListListeningPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_ListListeningPorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListListeningPorts(ctx, req.(*ListListeningPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_WatchListeningPorts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchListeningPortsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).WatchListeningPorts(m, &grpc.GenericServerStream[WatchListeningPortsRequest, WatchListeningPortsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_WatchListeningPortsServer = grpc.ServerStreamingServer[WatchListeningPortsResponse]
+
+func _Agent_Proxy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProxyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).Proxy(m, &grpc.GenericServerStream[ProxyRequest, ProxyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ProxyServer = grpc.ServerStreamingServer[ProxyResponse]
+
+func _Agent_ProxyConnection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ProxyConnection(&grpc.GenericServerStream[ProxyConnectionRequest, ProxyConnectionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_ProxyConnectionServer = grpc.BidiStreamingServer[ProxyConnectionRequest, ProxyConnectionResponse]
+
+func _Agent_RenewNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).RenewNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_RenewNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).RenewNetwork(ctx, req.(*RenewNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ConfigureNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ConfigureNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_ConfigureNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ConfigureNetwork(ctx, req.(*ConfigureNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetHostsEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostsEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetHostsEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetHostsEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetHostsEntries(ctx, req.(*GetHostsEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SetHostsEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHostsEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetHostsEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SetHostsEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetHostsEntries(ctx, req.(*SetHostsEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetResolverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResolverConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetResolverConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetResolverConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetResolverConfig(ctx, req.(*GetResolverConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedAgentServer) Exec(grpc.BidiStreamingServer[ExecRequest, ExecResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+func _Agent_SetResolverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResolverConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetResolverConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SetResolverConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetResolverConfig(ctx, req.(*SetResolverConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAgentServer) ResolveIP(context.Context, *ResolveIPRequest) (*ResolveIPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveIP not implemented")
+
+func _Agent_GetSystemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetSystemInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetSystemInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetSystemInfo(ctx, req.(*GetSystemInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
-func (UnimplementedAgentServer) testEmbeddedByValue()               {}
 
-// UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AgentServer will
-// result in compilation errors.
-type UnsafeAgentServer interface {
-	mustEmbedUnimplementedAgentServer()
+func _Agent_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAgentServer(s grpc.ServiceRegistrar, srv AgentServer) {
-	// If the following call pancis, it indicates UnimplementedAgentServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Agent_StreamMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMetricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	s.RegisterService(&Agent_ServiceDesc, srv)
+	return srv.(AgentServer).StreamMetrics(m, &grpc.GenericServerStream[StreamMetricsRequest, StreamMetricsResponse]{ServerStream: stream})
 }
 
-func _Agent_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(AgentServer).Exec(&grpc.GenericServerStream[ExecRequest, ExecResponse]{ServerStream: stream})
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_StreamMetricsServer = grpc.ServerStreamingServer[StreamMetricsResponse]
+
+func _Agent_ListProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_ListProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListProcesses(ctx, req.(*ListProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SignalProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SignalProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SignalProcess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SignalProcess(ctx, req.(*SignalProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_WaitFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).WaitFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_WaitFor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).WaitFor(ctx, req.(*WaitForRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_Shutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CancelShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CancelShutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_CancelShutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CancelShutdown(ctx, req.(*CancelShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SetTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SetTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SetTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SetTime(ctx, req.(*SetTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_WatchClock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).WatchClock(m, &grpc.GenericServerStream[WatchClockRequest, WatchClockResponse]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type Agent_ExecServer = grpc.BidiStreamingServer[ExecRequest, ExecResponse]
+type Agent_WatchClockServer = grpc.ServerStreamingServer[WatchClockResponse]
 
-func _Agent_ResolveIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResolveIPRequest)
+func _Agent_ResetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentServer).ResolveIP(ctx, in)
+		return srv.(AgentServer).ResetIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Agent_ResolveIP_FullMethodName,
+		FullMethod: Agent_ResetIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServer).ResolveIP(ctx, req.(*ResolveIPRequest))
+		return srv.(AgentServer).ResetIdentity(ctx, req.(*ResetIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -141,6 +1445,106 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveIP",
 			Handler:    _Agent_ResolveIP_Handler,
 		},
+		{
+			MethodName: "QueryTransfer",
+			Handler:    _Agent_QueryTransfer_Handler,
+		},
+		{
+			MethodName: "ListXattrs",
+			Handler:    _Agent_ListXattrs_Handler,
+		},
+		{
+			MethodName: "GetXattr",
+			Handler:    _Agent_GetXattr_Handler,
+		},
+		{
+			MethodName: "SetXattr",
+			Handler:    _Agent_SetXattr_Handler,
+		},
+		{
+			MethodName: "RemoveXattr",
+			Handler:    _Agent_RemoveXattr_Handler,
+		},
+		{
+			MethodName: "GetFileFlags",
+			Handler:    _Agent_GetFileFlags_Handler,
+		},
+		{
+			MethodName: "SetFileFlags",
+			Handler:    _Agent_SetFileFlags_Handler,
+		},
+		{
+			MethodName: "GetACL",
+			Handler:    _Agent_GetACL_Handler,
+		},
+		{
+			MethodName: "SetACL",
+			Handler:    _Agent_SetACL_Handler,
+		},
+		{
+			MethodName: "ListListeningPorts",
+			Handler:    _Agent_ListListeningPorts_Handler,
+		},
+		{
+			MethodName: "RenewNetwork",
+			Handler:    _Agent_RenewNetwork_Handler,
+		},
+		{
+			MethodName: "ConfigureNetwork",
+			Handler:    _Agent_ConfigureNetwork_Handler,
+		},
+		{
+			MethodName: "GetHostsEntries",
+			Handler:    _Agent_GetHostsEntries_Handler,
+		},
+		{
+			MethodName: "SetHostsEntries",
+			Handler:    _Agent_SetHostsEntries_Handler,
+		},
+		{
+			MethodName: "GetResolverConfig",
+			Handler:    _Agent_GetResolverConfig_Handler,
+		},
+		{
+			MethodName: "SetResolverConfig",
+			Handler:    _Agent_SetResolverConfig_Handler,
+		},
+		{
+			MethodName: "GetSystemInfo",
+			Handler:    _Agent_GetSystemInfo_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Agent_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "ListProcesses",
+			Handler:    _Agent_ListProcesses_Handler,
+		},
+		{
+			MethodName: "SignalProcess",
+			Handler:    _Agent_SignalProcess_Handler,
+		},
+		{
+			MethodName: "WaitFor",
+			Handler:    _Agent_WaitFor_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _Agent_Shutdown_Handler,
+		},
+		{
+			MethodName: "CancelShutdown",
+			Handler:    _Agent_CancelShutdown_Handler,
+		},
+		{
+			MethodName: "SetTime",
+			Handler:    _Agent_SetTime_Handler,
+		},
+		{
+			MethodName: "ResetIdentity",
+			Handler:    _Agent_ResetIdentity_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -149,6 +1553,80 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchPath",
+			Handler:       _Agent_WatchPath_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SyncFile",
+			Handler:       _Agent_SyncFile_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Upload",
+			Handler:       _Agent_Upload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Download",
+			Handler:       _Agent_Download_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CollectArtifacts",
+			Handler:       _Agent_CollectArtifacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNetwork",
+			Handler:       _Agent_WatchNetwork_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ForwardTCP",
+			Handler:       _Agent_ForwardTCP_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReverseForward",
+			Handler:       _Agent_ReverseForward_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReverseForwardConnection",
+			Handler:       _Agent_ReverseForwardConnection_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchListeningPorts",
+			Handler:       _Agent_WatchListeningPorts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Proxy",
+			Handler:       _Agent_Proxy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProxyConnection",
+			Handler:       _Agent_ProxyConnection_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamMetrics",
+			Handler:       _Agent_StreamMetrics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchClock",
+			Handler:       _Agent_WatchClock_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc/agent.proto",
 }
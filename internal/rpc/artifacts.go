@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"bufio"
+	"fmt"
+	"slices"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/artifacts"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func (rpc *RPC) CollectArtifacts(
+	request *CollectArtifactsRequest,
+	stream grpc.ServerStreamingServer[CollectArtifactsResponse],
+) error {
+	var format artifacts.Format
+
+	switch request.Format {
+	case CollectArtifactsRequest_FORMAT_UNSPECIFIED, CollectArtifactsRequest_FORMAT_TAR:
+		format = artifacts.FormatTar
+	case CollectArtifactsRequest_FORMAT_TAR_GZIP:
+		format = artifacts.FormatTarGzip
+	case CollectArtifactsRequest_FORMAT_ZIP:
+		format = artifacts.FormatZip
+	default:
+		return fmt.Errorf("unsupported archive format: %s", request.Format)
+	}
+
+	zap.S().Infof("collecting artifacts matching %v", request.Patterns)
+
+	bufferedWriter := bufio.NewWriterSize(&chunkWriter{
+		send: func(chunk []byte) error {
+			return stream.Send(&CollectArtifactsResponse{
+				Type: &CollectArtifactsResponse_Data{
+					Data: &IOChunk{
+						Data: chunk,
+					},
+				},
+			})
+		},
+	}, downloadChunkSize)
+
+	manifest, err := artifacts.Collect(artifacts.Options{
+		Patterns:     request.Patterns,
+		BaseDir:      request.BaseDir,
+		MaxTotalSize: request.MaxTotalSize,
+		MaxFileSize:  request.MaxFileSize,
+		Format:       format,
+	}, bufferedWriter)
+	if err != nil {
+		return err
+	}
+
+	if err := bufferedWriter.Flush(); err != nil {
+		return err
+	}
+
+	zap.S().Infof("collected %d artifacts (%d bytes), skipped %d", len(manifest.Included),
+		manifest.TotalSize, len(manifest.Skipped))
+
+	return stream.Send(&CollectArtifactsResponse{
+		Type: &CollectArtifactsResponse_Manifest_{
+			Manifest: &CollectArtifactsResponse_Manifest{
+				Included: lo.Map(manifest.Included, func(entry artifacts.Entry, _ int) *CollectArtifactsResponse_Manifest_Entry {
+					return &CollectArtifactsResponse_Manifest_Entry{
+						Path: entry.Path,
+						Name: entry.Name,
+						Size: entry.Size,
+					}
+				}),
+				Skipped: lo.Map(manifest.Skipped, func(skipped artifacts.Skipped, _ int) *CollectArtifactsResponse_Manifest_Skipped {
+					return &CollectArtifactsResponse_Manifest_Skipped{
+						Path:    skipped.Path,
+						Size:    skipped.Size,
+						Reason:  skipReasonToProto(skipped.Reason),
+						Message: skipped.Message,
+					}
+				}),
+				UnmatchedPatterns: manifest.UnmatchedPatterns,
+				TotalSize:         manifest.TotalSize,
+			},
+		},
+	})
+}
+
+func skipReasonToProto(reason artifacts.SkipReason) CollectArtifactsResponse_Manifest_Skipped_Reason {
+	switch reason {
+	case artifacts.SkipReasonFileTooLarge:
+		return CollectArtifactsResponse_Manifest_Skipped_REASON_FILE_TOO_LARGE
+	case artifacts.SkipReasonTotalSizeExceeded:
+		return CollectArtifactsResponse_Manifest_Skipped_REASON_TOTAL_SIZE_EXCEEDED
+	case artifacts.SkipReasonNotRegularFile:
+		return CollectArtifactsResponse_Manifest_Skipped_REASON_NOT_REGULAR_FILE
+	case artifacts.SkipReasonError:
+		return CollectArtifactsResponse_Manifest_Skipped_REASON_ERROR
+	default:
+		return CollectArtifactsResponse_Manifest_Skipped_REASON_UNSPECIFIED
+	}
+}
+
+// chunkWriter turns writes into IOChunk-sized messages.
+type chunkWriter struct {
+	send func(chunk []byte) error
+}
+
+func (chunkWriter *chunkWriter) Write(p []byte) (int, error) {
+	for chunk := range slices.Chunk(p, downloadChunkSize) {
+		if err := chunkWriter.send(slices.Clone(chunk)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/version"
+)
+
+// capabilitiesVersion is incremented whenever
+// GetCapabilitiesResponse's schema changes.
+const capabilitiesVersion = 1
+
+// features lists the versions of the agent's features, a feature's
+// version is incremented whenever new fields or behaviors are added
+// to it, so that the clients know whether they can rely on them.
+//
+// Features that are only available on some of the platforms
+// are listed in platformFeatures instead.
+var features = map[string]uint32{
+	"exec":              1,
+	"resolve-ip":        1,
+	"watch-path":        1,
+	"sync-file":         1,
+	"transfer":          1,
+	"file-attributes":   1,
+	"artifacts":         1,
+	"watch-network":     1,
+	"forward":           1,
+	"reverse-forward":   1,
+	"listening-ports":   1,
+	"proxy":             1,
+	"renew-network":     1,
+	"configure-network": 1,
+	"dns":               1,
+	"system-info":       1,
+	"capabilities":      1,
+	"health":            1,
+	"metrics":           1,
+	"processes":         1,
+	"wait-for":          1,
+	"shutdown":          1,
+	"clock":             1,
+	"watch-clock":       1,
+	"identity-reset":    1,
+}
+
+// rootFeatures lists the features that
+// are only reported when running as root.
+var rootFeatures = []string{
+	"identity-reset",
+	"shutdown",
+
+	// Setting the time, while "watch-clock"
+	// is available to the other users too
+	"clock",
+}
+
+func (rpc *RPC) GetCapabilities(_ context.Context, _ *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return &GetCapabilitiesResponse{
+		Version:      capabilitiesVersion,
+		AgentVersion: version.FullVersion,
+		Rpcs:         rpcNames(),
+		Features:     allFeatures(),
+		Components:   rpc.components,
+		Limits: &GetCapabilitiesResponse_Limits{
+			MaxMessageSize:       DefaultMaxMessageSize,
+			MaxConcurrentStreams: DefaultMaxConcurrentStreams,
+			MaxSessions:          uint32(rpc.maxSessions),
+		},
+	}, nil
+}
+
+func allFeatures() map[string]uint32 {
+	result := maps.Clone(features)
+
+	maps.Copy(result, platformFeatures)
+
+	// Some of the features are only available to the superuser, which the
+	// agent is not when running as a launchd global agent on macOS or as
+	// a systemd service with "User=" set on Linux, for example
+	if os.Geteuid() != 0 {
+		for _, feature := range rootFeatures {
+			delete(result, feature)
+		}
+	}
+
+	return result
+}
+
+func rpcNames() []string {
+	var result []string
+
+	for _, method := range Agent_ServiceDesc.Methods {
+		result = append(result, method.MethodName)
+	}
+
+	for _, stream := range Agent_ServiceDesc.Streams {
+		result = append(result, stream.StreamName)
+	}
+
+	slices.Sort(result)
+
+	return result
+}
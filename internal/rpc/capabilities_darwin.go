@@ -0,0 +1,6 @@
+package rpc
+
+var platformFeatures = map[string]uint32{
+	// Domain-specific resolver configuration via /etc/resolver
+	"dns-scoped": 1,
+}
@@ -0,0 +1,10 @@
+package rpc
+
+var platformFeatures = map[string]uint32{
+	// Global resolver options are written to resolv.conf,
+	// unless it's managed by systemd-resolved
+	"dns-global-options": 1,
+
+	// Default ACLs are a POSIX ACL concept
+	"default-acls": 1,
+}
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/clock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (rpc *RPC) SetTime(_ context.Context, request *SetTimeRequest) (*SetTimeResponse, error) {
+	if request.Time == nil {
+		return nil, status.Error(codes.InvalidArgument, "no time specified")
+	}
+
+	if err := request.Time.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var policy clock.Policy
+
+	switch request.Policy {
+	case SetTimePolicy_SET_TIME_POLICY_UNSPECIFIED:
+		policy = clock.PolicyAuto
+	case SetTimePolicy_SET_TIME_POLICY_STEP:
+		policy = clock.PolicyStep
+	case SetTimePolicy_SET_TIME_POLICY_SLEW:
+		policy = clock.PolicySlew
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported policy %s", request.Policy)
+	}
+
+	stepThreshold := clock.DefaultStepThreshold
+	if request.StepThreshold != nil && request.StepThreshold.AsDuration() > 0 {
+		stepThreshold = request.StepThreshold.AsDuration()
+	}
+
+	offset, stepped, err := clock.Set(request.Time.AsTime(), policy, stepThreshold)
+	if err != nil {
+		switch {
+		case errors.Is(err, clock.ErrSlewTooLarge):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, os.ErrPermission):
+			// Only the superuser can set the clock
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	if stepped {
+		zap.S().Infof("stepped the clock by %s", offset)
+	} else {
+		zap.S().Infof("slewing the clock by %s", offset)
+	}
+
+	return &SetTimeResponse{
+		Offset:  durationpb.New(offset),
+		Stepped: stepped,
+	}, nil
+}
+
+func (rpc *RPC) WatchClock(request *WatchClockRequest, stream grpc.ServerStreamingServer[WatchClockResponse]) error {
+	interval := clock.DefaultWatchInterval
+	if request.Interval != nil && request.Interval.AsDuration() > 0 {
+		interval = max(request.Interval.AsDuration(), clock.MinWatchInterval)
+	}
+
+	threshold := clock.DefaultJumpThreshold
+	if request.Threshold != nil && request.Threshold.AsDuration() > 0 {
+		threshold = request.Threshold.AsDuration()
+	}
+
+	return clock.Watch(stream.Context(), interval, threshold, func(event clock.Event) error {
+		kind := WatchClockResponse_KIND_JUMPED
+		if event.Kind == clock.EventResumed {
+			kind = WatchClockResponse_KIND_RESUMED
+		}
+
+		zap.S().Debugf("clock event %s: jump %s, suspended %s", kind, event.Jump, event.Suspended)
+
+		return stream.Send(&WatchClockResponse{
+			Kind:      kind,
+			Time:      timestamppb.New(event.Time),
+			Jump:      durationpb.New(event.Jump),
+			Suspended: durationpb.New(event.Suspended),
+		})
+	})
+}
@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/netconfig"
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) ConfigureNetwork(
+	ctx context.Context,
+	request *ConfigureNetworkRequest,
+) (*ConfigureNetworkResponse, error) {
+	config, err := interfaceConfigFromRequest(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !request.DryRun {
+		zap.S().Infof("configuring network interface %s...", config.Name)
+	}
+
+	result, err := netconfig.Configure(ctx, config, netconfig.ConfigureOptions{
+		Backend:        request.Backend,
+		DryRun:         request.DryRun,
+		CheckAddresses: request.CheckAddresses,
+		CheckTimeout:   request.GetCheckTimeout().AsDuration(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, netconfig.ErrUnknownBackend), errors.Is(err, netconfig.ErrUnsupportedConfig):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, netconfig.ErrNoBackend):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	response := &ConfigureNetworkResponse{
+		Backend: result.Plan.Backend,
+		Files: lo.Map(result.Plan.Files, func(file netconfig.File, _ int) *ConfigureNetworkResponse_File {
+			return &ConfigureNetworkResponse_File{
+				Path:    file.Path,
+				Content: file.Content,
+				Mode:    uint32(file.Mode),
+			}
+		}),
+		Commands: lo.Map(result.Plan.Commands, func(args []string, _ int) *ConfigureNetworkResponse_Command {
+			return &ConfigureNetworkResponse_Command{
+				Args: args,
+			}
+		}),
+	}
+
+	if request.DryRun {
+		return response, nil
+	}
+
+	if result.CheckError != nil {
+		zap.S().Warnf("rolled back the configuration of network interface %s: %v", config.Name,
+			result.CheckError)
+
+		response.CheckError = result.CheckError.Error()
+	}
+
+	interfaces, err := netinfo.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces = netinfo.Filter{InterfaceNames: []string{config.Name}}.Apply(interfaces)
+	if len(interfaces) != 0 {
+		response.Interface = interfacesToProto(interfaces)[0]
+	}
+
+	return response, nil
+}
+
+func interfaceConfigFromRequest(request *ConfigureNetworkRequest) (netconfig.InterfaceConfig, error) {
+	config := netconfig.InterfaceConfig{
+		Name:          request.Interface,
+		DHCP4:         request.Dhcp4,
+		SearchDomains: request.SearchDomains,
+		MTU:           request.Mtu,
+	}
+
+	for _, address := range request.Addresses {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return config, fmt.Errorf("invalid address %q: %w", address, err)
+		}
+
+		config.Addresses = append(config.Addresses, prefix)
+	}
+
+	for _, route := range request.Routes {
+		destination, err := netip.ParsePrefix(route.Destination)
+		if err != nil {
+			return config, fmt.Errorf("invalid route destination %q: %w", route.Destination, err)
+		}
+
+		gateway, err := netip.ParseAddr(route.Gateway)
+		if err != nil {
+			return config, fmt.Errorf("invalid route gateway %q: %w", route.Gateway, err)
+		}
+
+		config.Routes = append(config.Routes, netconfig.Route{
+			Destination: destination,
+			Gateway:     gateway,
+			Metric:      route.Metric,
+		})
+	}
+
+	for _, server := range request.DnsServers {
+		addr, err := netip.ParseAddr(server)
+		if err != nil {
+			return config, fmt.Errorf("invalid DNS server %q: %w", server, err)
+		}
+
+		config.DNSServers = append(config.DNSServers, addr)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/hostsfile"
+	"github.com/cirruslabs/tart-guest-agent/internal/resolver"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) GetHostsEntries(_ context.Context, _ *GetHostsEntriesRequest) (*GetHostsEntriesResponse, error) {
+	entries, err := hostsfile.Entries(hostsfile.DefaultPath)
+	if err != nil {
+		return nil, hostsfileError(err)
+	}
+
+	return &GetHostsEntriesResponse{
+		Entries: lo.Map(entries, func(entry hostsfile.Entry, _ int) *HostsEntry {
+			return &HostsEntry{
+				Ip:        entry.IP.String(),
+				Hostnames: entry.Hostnames,
+			}
+		}),
+	}, nil
+}
+
+func (rpc *RPC) SetHostsEntries(_ context.Context, request *SetHostsEntriesRequest) (*SetHostsEntriesResponse, error) {
+	var entries []hostsfile.Entry
+
+	for _, entry := range request.Entries {
+		ip, err := netip.ParseAddr(entry.Ip)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid IP address %q: %v", entry.Ip, err)
+		}
+
+		entry := hostsfile.Entry{
+			IP:        ip,
+			Hostnames: entry.Hostnames,
+		}
+
+		if err := entry.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		entries = append(entries, entry)
+	}
+
+	zap.S().Infof("setting %d hosts file entries", len(entries))
+
+	if err := hostsfile.SetEntries(hostsfile.DefaultPath, entries); err != nil {
+		return nil, hostsfileError(err)
+	}
+
+	return &SetHostsEntriesResponse{}, nil
+}
+
+func hostsfileError(err error) error {
+	if errors.Is(err, hostsfile.ErrMalformedBlock) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
+func (rpc *RPC) GetResolverConfig(ctx context.Context, _ *GetResolverConfigRequest) (*GetResolverConfigResponse, error) {
+	global, err := resolver.Global(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scoped, err := resolver.Scoped()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetResolverConfigResponse{
+		Global: &ResolverConfig{
+			Nameservers:   addrsToStrings(global.Nameservers),
+			SearchDomains: global.SearchDomains,
+			Options:       global.Options,
+		},
+		Scoped: lo.Map(scoped, func(config resolver.ScopedConfig, _ int) *ScopedResolverConfig {
+			return &ScopedResolverConfig{
+				Domain:      config.Domain,
+				Nameservers: addrsToStrings(config.Nameservers),
+				Port:        uint32(config.Port),
+			}
+		}),
+	}, nil
+}
+
+func (rpc *RPC) SetResolverConfig(
+	ctx context.Context,
+	request *SetResolverConfigRequest,
+) (*SetResolverConfigResponse, error) {
+	var err error
+
+	switch typedAction := request.Type.(type) {
+	case *SetResolverConfigRequest_Global:
+		nameservers, parseErr := addrsFromStrings(typedAction.Global.Nameservers)
+		if parseErr != nil {
+			return nil, status.Error(codes.InvalidArgument, parseErr.Error())
+		}
+
+		config := resolver.Config{
+			Nameservers:   nameservers,
+			SearchDomains: typedAction.Global.SearchDomains,
+			Options:       typedAction.Global.Options,
+		}
+
+		if validateErr := config.Validate(); validateErr != nil {
+			return nil, status.Error(codes.InvalidArgument, validateErr.Error())
+		}
+
+		zap.S().Infof("setting global resolver configuration")
+
+		err = resolver.SetGlobal(ctx, config)
+	case *SetResolverConfigRequest_Scoped:
+		nameservers, parseErr := addrsFromStrings(typedAction.Scoped.Nameservers)
+		if parseErr != nil {
+			return nil, status.Error(codes.InvalidArgument, parseErr.Error())
+		}
+
+		if typedAction.Scoped.Port > 65535 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid port %d", typedAction.Scoped.Port)
+		}
+
+		config := resolver.ScopedConfig{
+			Domain:      typedAction.Scoped.Domain,
+			Nameservers: nameservers,
+			Port:        uint16(typedAction.Scoped.Port),
+		}
+
+		if validateErr := config.Validate(); validateErr != nil {
+			return nil, status.Error(codes.InvalidArgument, validateErr.Error())
+		}
+
+		zap.S().Infof("setting resolver configuration for %s", config.Domain)
+
+		err = resolver.SetScoped(config)
+	case *SetResolverConfigRequest_RemoveScopedDomain:
+		zap.S().Infof("removing resolver configuration for %s", typedAction.RemoveScopedDomain)
+
+		err = resolver.RemoveScoped(typedAction.RemoveScopedDomain)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "no resolver configuration change specified")
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, resolver.ErrUnsupported):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, resolver.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	return &SetResolverConfigResponse{}, nil
+}
+
+func addrsToStrings(addrs []netip.Addr) []string {
+	return lo.Map(addrs, func(addr netip.Addr, _ int) string {
+		return addr.String()
+	})
+}
+
+func addrsFromStrings(addrsRaw []string) ([]netip.Addr, error) {
+	var addrs []netip.Addr
+
+	for _, addrRaw := range addrsRaw {
+		addr, err := netip.ParseAddr(addrRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q: %w", addrRaw, err)
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
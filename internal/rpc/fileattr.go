@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/fileattr"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) ListXattrs(_ context.Context, request *ListXattrsRequest) (*ListXattrsResponse, error) {
+	if !request.IncludeValues {
+		names, err := fileattr.ListXattrs(request.Path, request.NoFollow)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ListXattrsResponse{
+			Xattrs: lo.Map(names, func(name string, _ int) *ExtendedAttribute {
+				return &ExtendedAttribute{Name: name}
+			}),
+		}, nil
+	}
+
+	xattrs, err := fileattr.GetXattrs(request.Path, request.NoFollow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListXattrsResponse{
+		Xattrs: xattrsToProto(xattrs),
+	}, nil
+}
+
+func (rpc *RPC) GetXattr(_ context.Context, request *GetXattrRequest) (*GetXattrResponse, error) {
+	value, err := fileattr.GetXattr(request.Path, request.Name, request.NoFollow)
+	if err != nil {
+		return nil, xattrError(err)
+	}
+
+	return &GetXattrResponse{
+		Value: value,
+	}, nil
+}
+
+func (rpc *RPC) SetXattr(_ context.Context, request *SetXattrRequest) (*SetXattrResponse, error) {
+	if request.Xattr == nil {
+		return nil, status.Error(codes.InvalidArgument, "extended attribute to set should be specified")
+	}
+
+	if err := fileattr.SetXattr(request.Path, request.Xattr.Name, request.Xattr.Value, request.NoFollow); err != nil {
+		return nil, err
+	}
+
+	return &SetXattrResponse{}, nil
+}
+
+func (rpc *RPC) RemoveXattr(_ context.Context, request *RemoveXattrRequest) (*RemoveXattrResponse, error) {
+	if err := fileattr.RemoveXattr(request.Path, request.Name, request.NoFollow); err != nil {
+		return nil, xattrError(err)
+	}
+
+	return &RemoveXattrResponse{}, nil
+}
+
+func (rpc *RPC) GetFileFlags(_ context.Context, request *GetFileFlagsRequest) (*GetFileFlagsResponse, error) {
+	flags, names, err := fileattr.GetFlags(request.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetFileFlagsResponse{
+		Flags: flags,
+		Names: names,
+	}, nil
+}
+
+func (rpc *RPC) SetFileFlags(_ context.Context, request *SetFileFlagsRequest) (*SetFileFlagsResponse, error) {
+	if _, err := fileattr.ModifyFlags(request.Path, request.Set, request.Clear); err != nil {
+		return nil, err
+	}
+
+	flags, names, err := fileattr.GetFlags(request.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetFileFlagsResponse{
+		Flags: flags,
+		Names: names,
+	}, nil
+}
+
+func (rpc *RPC) GetACL(_ context.Context, request *GetACLRequest) (*GetACLResponse, error) {
+	entries, err := fileattr.GetACL(request.Path, request.Default)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetACLResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (rpc *RPC) SetACL(_ context.Context, request *SetACLRequest) (*SetACLResponse, error) {
+	if err := fileattr.SetACL(request.Path, request.Default, request.Entries); err != nil {
+		return nil, err
+	}
+
+	return &SetACLResponse{}, nil
+}
+
+func xattrsToProto(xattrs []fileattr.Xattr) []*ExtendedAttribute {
+	return lo.Map(xattrs, func(xattr fileattr.Xattr, _ int) *ExtendedAttribute {
+		return &ExtendedAttribute{
+			Name:  xattr.Name,
+			Value: xattr.Value,
+		}
+	})
+}
+
+func xattrsFromProto(xattrs []*ExtendedAttribute) []fileattr.Xattr {
+	return lo.Map(xattrs, func(xattr *ExtendedAttribute, _ int) fileattr.Xattr {
+		return fileattr.Xattr{
+			Name:  xattr.GetName(),
+			Value: xattr.GetValue(),
+		}
+	})
+}
+
+func xattrError(err error) error {
+	if errors.Is(err, fileattr.ErrNoAttribute) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
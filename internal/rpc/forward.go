@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/forward"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ForwardTCP forwards a connection to a TCP address or, despite its
+// name, which is kept for compatibility, to a unix domain socket.
+func (rpc *RPC) ForwardTCP(stream grpc.BidiStreamingServer[ForwardTCPRequest, ForwardTCPResponse]) error {
+	// Read the first request, it should describe where to connect
+	firstRequest, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	connect := firstRequest.GetConnect()
+	if connect == nil {
+		return status.Error(codes.InvalidArgument, "first forward request should describe where to connect")
+	}
+
+	var network, address string
+
+	switch target := connect.Target.(type) {
+	case *ForwardTCPRequest_Connect_Address:
+		network, address = "tcp", target.Address
+	case *ForwardTCPRequest_Connect_UnixSocketPath:
+		network, address = "unix", target.UnixSocketPath
+	default:
+		return status.Error(codes.InvalidArgument, "no address or unix socket path to connect to specified")
+	}
+
+	dialer := net.Dialer{
+		Timeout: connect.GetTimeout().AsDuration(),
+	}
+
+	conn, err := dialer.DialContext(stream.Context(), network, address)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to connect to %s: %v", address, err)
+	}
+
+	zap.S().Infof("forwarding %s connection to %s", network, address)
+
+	if err := stream.Send(&ForwardTCPResponse{
+		Type: &ForwardTCPResponse_Connected_{
+			Connected: &ForwardTCPResponse_Connected{
+				LocalAddress:  conn.LocalAddr().String(),
+				RemoteAddress: conn.RemoteAddr().String(),
+			},
+		},
+	}); err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+
+	return forward.Pipe(stream.Context(), conn, &forwardTCPStream{stream: stream})
+}
+
+type forwardTCPStream struct {
+	stream grpc.BidiStreamingServer[ForwardTCPRequest, ForwardTCPResponse]
+}
+
+func (s *forwardTCPStream) Recv() ([]byte, error) {
+	request, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	data := request.GetData()
+	if data == nil {
+		return nil, fmt.Errorf("expected data, got %T", request.Type)
+	}
+
+	return data.Data, nil
+}
+
+func (s *forwardTCPStream) Send(data []byte) error {
+	return s.stream.Send(&ForwardTCPResponse{
+		Type: &ForwardTCPResponse_Data{
+			Data: &IOChunk{Data: data},
+		},
+	})
+}
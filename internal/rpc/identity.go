@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/identity"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) ResetIdentity(_ context.Context, request *ResetIdentityRequest) (*ResetIdentityResponse, error) {
+	// Both the machine identifiers and the hostname
+	// can only be changed by the superuser
+	if os.Geteuid() != 0 {
+		return nil, status.Error(codes.PermissionDenied, "resetting identity requires the agent to run as root")
+	}
+
+	zap.S().Infof("resetting identity...")
+
+	result, err := identity.Reset(rpc.identityStatePath, identity.Options{
+		Hostname:         request.Hostname,
+		HostnameTemplate: rpc.hostnameTemplate,
+	}, request.Force)
+	if err != nil {
+		switch {
+		case errors.Is(err, identity.ErrAlreadyReset):
+			// Still honor the hostname supplied by the host, e.g. when
+			// the identity was already reset on startup, but without
+			// regenerating the keys once more
+			var hostname string
+
+			if request.Hostname != "" {
+				hostname, err = identity.SetHostname(request.Hostname)
+			} else {
+				hostname, err = os.Hostname()
+			}
+			if err != nil {
+				return nil, identityError(err)
+			}
+
+			return &ResetIdentityResponse{
+				Performed: false,
+				Hostname:  hostname,
+			}, nil
+		default:
+			return nil, identityError(err)
+		}
+	}
+
+	return &ResetIdentityResponse{
+		Performed: true,
+		Hostname:  result.Hostname,
+		MachineId: result.MachineID,
+	}, nil
+}
+
+func identityError(err error) error {
+	switch {
+	case errors.Is(err, identity.ErrInvalidHostname):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, os.ErrPermission):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return err
+	}
+}
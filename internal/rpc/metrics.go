@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/metrics"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultMetricsInterval     = 5 * time.Second
+	defaultMetricsTopProcesses = 5
+
+	// Sampling is expensive on macOS, where ps(1) and ioreg(8)
+	// are invoked and the arguments of each process are read
+	minMetricsInterval = time.Second
+)
+
+func (rpc *RPC) StreamMetrics(
+	request *StreamMetricsRequest,
+	stream grpc.ServerStreamingServer[StreamMetricsResponse],
+) error {
+	interval := defaultMetricsInterval
+	if request.Interval != nil && request.Interval.AsDuration() > 0 {
+		interval = max(request.Interval.AsDuration(), minMetricsInterval)
+	}
+
+	topProcesses := defaultMetricsTopProcesses
+	if request.TopProcesses != 0 {
+		topProcesses = int(request.TopProcesses)
+	}
+
+	sampler, err := metrics.NewSampler(topProcesses)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		sample, err := sampler.Sample()
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(sampleToProto(sample)); err != nil {
+			return err
+		}
+	}
+}
+
+func sampleToProto(sample *metrics.Sample) *StreamMetricsResponse {
+	return &StreamMetricsResponse{
+		Time: timestamppb.New(sample.Time),
+		Cpu: &StreamMetricsResponse_CPU{
+			UsagePercent:        sample.CPU.Usage,
+			PerCoreUsagePercent: sample.CPU.PerCore,
+		},
+		LoadAverage: &StreamMetricsResponse_LoadAverage{
+			OneMinute:      sample.LoadAverage.One,
+			FiveMinutes:    sample.LoadAverage.Five,
+			FifteenMinutes: sample.LoadAverage.Fifteen,
+		},
+		Memory: &StreamMetricsResponse_Memory{
+			TotalBytes:      sample.Memory.Total,
+			UsedBytes:       sample.Memory.Used,
+			AvailableBytes:  sample.Memory.Available,
+			SwapTotalBytes:  sample.Memory.SwapTotal,
+			SwapUsedBytes:   sample.Memory.SwapUsed,
+			PressurePercent: sample.Memory.Pressure,
+		},
+		Volumes: lo.Map(sample.Volumes, func(volume metrics.Volume, _ int) *StreamMetricsResponse_Volume {
+			return &StreamMetricsResponse_Volume{
+				MountPoint:     volume.MountPoint,
+				Device:         volume.Device,
+				FileSystem:     volume.FileSystem,
+				TotalBytes:     volume.Total,
+				UsedBytes:      volume.Used,
+				AvailableBytes: volume.Available,
+				Disk:           volume.Disk,
+			}
+		}),
+		Disks: lo.Map(sample.Disks, func(disk metrics.Disk, _ int) *StreamMetricsResponse_Disk {
+			return &StreamMetricsResponse_Disk{
+				Name:                disk.Name,
+				ReadBytesPerSecond:  disk.ReadBytesPerSecond,
+				WriteBytesPerSecond: disk.WriteBytesPerSecond,
+			}
+		}),
+		NetworkInterfaces: lo.Map(sample.Interfaces,
+			func(iface metrics.Interface, _ int) *StreamMetricsResponse_NetworkInterface {
+				return &StreamMetricsResponse_NetworkInterface{
+					Name:                   iface.Name,
+					ReceiveBytesPerSecond:  iface.ReceiveBytesPerSecond,
+					TransmitBytesPerSecond: iface.TransmitBytesPerSecond,
+				}
+			}),
+		TopProcessesByCpu:    lo.Map(sample.TopProcessesByCPU, processUsageToProto),
+		TopProcessesByMemory: lo.Map(sample.TopProcessesByMemory, processUsageToProto),
+	}
+}
+
+func processUsageToProto(process metrics.Process, _ int) *StreamMetricsResponse_Process {
+	return &StreamMetricsResponse_Process{
+		Pid:             int32(process.PID),
+		Name:            process.Name,
+		User:            process.User,
+		CpuUsagePercent: process.CPUUsage,
+		ResidentBytes:   process.RSS,
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"github.com/cirruslabs/tart-guest-agent/internal/health"
+	"github.com/cirruslabs/tart-guest-agent/internal/transfer"
+)
+
+type Option func(rpc *RPC)
+
+// WithTransferManager specifies the manager of the resumable
+// uploads, which are disabled when not specified.
+func WithTransferManager(transferManager *transfer.Manager) Option {
+	return func(rpc *RPC) {
+		rpc.transferManager = transferManager
+	}
+}
+
+// WithComponents specifies the agent components that are enabled,
+// which is reported to the clients via GetCapabilities.
+func WithComponents(components ...string) Option {
+	return func(rpc *RPC) {
+		rpc.components = components
+	}
+}
+
+// WithHealthReporter specifies the reporter whose components' health
+// is exposed via the standard gRPC health checking service.
+func WithHealthReporter(healthReporter *health.Reporter) Option {
+	return func(rpc *RPC) {
+		rpc.healthReporter = healthReporter
+	}
+}
+
+// WithShutdownHooksDir specifies the directory with the
+// executables to run before shutting down via Shutdown.
+func WithShutdownHooksDir(dir string) Option {
+	return func(rpc *RPC) {
+		rpc.shutdownHooksDir = dir
+	}
+}
+
+// WithIdentityStatePath specifies the file in which the identity
+// reset is recorded to only perform it once per cloned VM.
+func WithIdentityStatePath(path string) Option {
+	return func(rpc *RPC) {
+		rpc.identityStatePath = path
+	}
+}
+
+// WithHostnameTemplate specifies the template from which the hostname
+// is derived when it is not supplied in the ResetIdentity request.
+func WithHostnameTemplate(template string) Option {
+	return func(rpc *RPC) {
+		rpc.hostnameTemplate = template
+	}
+}
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/ports"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultListeningPortsScanInterval = 2 * time.Second
+
+	// Scanning is expensive on macOS, where lsof(8) is invoked
+	minListeningPortsScanInterval = time.Second
+)
+
+func (rpc *RPC) ListListeningPorts(
+	_ context.Context,
+	_ *ListListeningPortsRequest,
+) (*ListListeningPortsResponse, error) {
+	listeningPorts, err := ports.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListListeningPortsResponse{
+		Ports: portsToProto(listeningPorts),
+	}, nil
+}
+
+func (rpc *RPC) WatchListeningPorts(
+	request *WatchListeningPortsRequest,
+	stream grpc.ServerStreamingServer[WatchListeningPortsResponse],
+) error {
+	interval := defaultListeningPortsScanInterval
+	if request.Interval != nil && request.Interval.AsDuration() > 0 {
+		interval = max(request.Interval.AsDuration(), minListeningPortsScanInterval)
+	}
+
+	listeningPorts, err := ports.List()
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&WatchListeningPortsResponse{
+		Type: &WatchListeningPortsResponse_Snapshot_{
+			Snapshot: &WatchListeningPortsResponse_Snapshot{
+				Ports: portsToProto(listeningPorts),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		newListeningPorts, err := ports.List()
+		if err != nil {
+			return err
+		}
+
+		opened, closed := ports.Diff(listeningPorts, newListeningPorts)
+
+		for _, port := range closed {
+			zap.S().Debugf("port %s closed by PID %d", port.String(), port.PID)
+
+			if err := stream.Send(&WatchListeningPortsResponse{
+				Type: &WatchListeningPortsResponse_PortClosed{
+					PortClosed: portToProto(port),
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		for _, port := range opened {
+			zap.S().Debugf("port %s opened by PID %d", port.String(), port.PID)
+
+			if err := stream.Send(&WatchListeningPortsResponse{
+				Type: &WatchListeningPortsResponse_PortOpened{
+					PortOpened: portToProto(port),
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		listeningPorts = newListeningPorts
+	}
+}
+
+func portsToProto(listeningPorts []ports.Port) []*ListeningPort {
+	return lo.Map(listeningPorts, func(port ports.Port, _ int) *ListeningPort {
+		return portToProto(port)
+	})
+}
+
+func portToProto(port ports.Port) *ListeningPort {
+	return &ListeningPort{
+		Ip:          port.IP.String(),
+		Port:        uint32(port.Port),
+		Pid:         uint32(port.PID),
+		ProcessName: port.ProcessName,
+	}
+}
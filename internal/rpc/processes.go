@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/process"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const startTimeTolerance = time.Second
+
+func (rpc *RPC) ListProcesses(_ context.Context, request *ListProcessesRequest) (*ListProcessesResponse, error) {
+	processes, err := process.List()
+	if err != nil {
+		return nil, err
+	}
+
+	processes = lo.Filter(processes, func(process process.Process, _ int) bool {
+		if len(request.Users) != 0 && !slices.Contains(request.Users, process.User) {
+			return false
+		}
+
+		if len(request.Names) != 0 && !slices.Contains(request.Names, process.Name) {
+			return false
+		}
+
+		return true
+	})
+
+	return &ListProcessesResponse{
+		Processes: lo.Map(processes, func(process process.Process, _ int) *Process {
+			return &Process{
+				Pid:           int32(process.PID),
+				Ppid:          int32(process.PPID),
+				Uid:           process.UID,
+				User:          process.User,
+				Name:          process.Name,
+				Args:          process.Args,
+				State:         process.State,
+				StartTime:     timestamppb.New(process.StartTime),
+				CpuTime:       durationpb.New(process.CPUTime),
+				ResidentBytes: process.RSS,
+			}
+		}),
+	}, nil
+}
+
+func (rpc *RPC) SignalProcess(_ context.Context, request *SignalProcessRequest) (*SignalProcessResponse, error) {
+	// Non-positive PIDs refer to process groups or
+	// to all processes, see kill(2), refuse these
+	if request.Pid <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid PID %d", request.Pid)
+	}
+
+	// Signalling the init or the agent itself would
+	// take the whole VM or the RPC service down
+	if request.Pid == 1 || int(request.Pid) == os.Getpid() {
+		return nil, status.Errorf(codes.InvalidArgument, "refusing to signal PID %d", request.Pid)
+	}
+
+	signalName := "SIGTERM"
+
+	if request.Signal != "" {
+		signalName = strings.ToUpper(request.Signal)
+
+		if !strings.HasPrefix(signalName, "SIG") {
+			signalName = "SIG" + signalName
+		}
+	}
+
+	signal := unix.SignalNum(signalName)
+	if signal == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown signal %q", request.Signal)
+	}
+
+	if request.StartTime != nil {
+		if err := request.StartTime.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		startTime, err := process.StartTime(int(request.Pid))
+		if err != nil {
+			if errors.Is(err, process.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "no process with PID %d", request.Pid)
+			}
+
+			return nil, err
+		}
+
+		// Linux derives the start time from the boot time, which
+		// is only known with a second precision, so allow for that
+		if diff := startTime.Sub(request.StartTime.AsTime()).Abs(); diff > startTimeTolerance {
+			return nil, status.Errorf(codes.FailedPrecondition, "PID %d belongs to a different "+
+				"process started at %s", request.Pid, startTime.Format(time.RFC3339))
+		}
+	}
+
+	zap.S().Infof("sending %s to PID %d", signalName, request.Pid)
+
+	if err := unix.Kill(int(request.Pid), signal); err != nil {
+		switch {
+		case errors.Is(err, unix.ESRCH):
+			return nil, status.Errorf(codes.NotFound, "no process with PID %d", request.Pid)
+		case errors.Is(err, unix.EPERM):
+			return nil, status.Errorf(codes.PermissionDenied, "not permitted to signal PID %d", request.Pid)
+		default:
+			return nil, err
+		}
+	}
+
+	return &SignalProcessResponse{}, nil
+}
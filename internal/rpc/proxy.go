@@ -0,0 +1,192 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/forward"
+	"github.com/cirruslabs/tart-guest-agent/internal/proxy"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) Proxy(request *ProxyRequest, stream grpc.ServerStreamingServer[ProxyResponse]) error {
+	ctx := stream.Context()
+
+	listener, err := net.Listen("tcp", request.Address)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to listen on %s: %v", request.Address, err)
+	}
+	defer listener.Close()
+
+	zap.S().Infof("running SOCKS5 and HTTP CONNECT proxy on %s", listener.Addr().String())
+
+	if err := stream.Send(&ProxyResponse{
+		Type: &ProxyResponse_Listening_{
+			Listening: &ProxyResponse_Listening{
+				Address: listener.Addr().String(),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	// Perform the handshakes concurrently, so that
+	// a slow client won't hold up the others
+	incomingConns := make(chan *proxy.Conn)
+	acceptErrCh := make(chan error, 1)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				acceptErrCh <- err
+
+				return
+			}
+
+			go func() {
+				proxyConn, err := proxy.Handshake(conn)
+				if err != nil {
+					zap.S().Debugf("proxy handshake with %s failed: %v", conn.RemoteAddr().String(), err)
+
+					return
+				}
+
+				select {
+				case incomingConns <- proxyConn:
+				case <-ctx.Done():
+					_ = proxyConn.Close()
+				}
+			}()
+		}
+	}()
+
+	for {
+		select {
+		case proxyConn := <-incomingConns:
+			id := rpc.pendingProxyConnections.Add(proxyConn)
+
+			if err := stream.Send(&ProxyResponse{
+				Type: &ProxyResponse_IncomingConnection_{
+					IncomingConnection: &ProxyResponse_IncomingConnection{
+						Id:            id,
+						Protocol:      proxyProtocolToProto(proxyConn.Protocol),
+						Target:        proxyConn.Target,
+						RemoteAddress: proxyConn.RemoteAddr().String(),
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		case err := <-acceptErrCh:
+			if errors.Is(err, net.ErrClosed) {
+				return ctx.Err()
+			}
+
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (rpc *RPC) ProxyConnection(stream grpc.BidiStreamingServer[ProxyConnectionRequest, ProxyConnectionResponse]) error {
+	// Read the first request, it should either accept or reject a connection
+	firstRequest, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	switch typedAction := firstRequest.Type.(type) {
+	case *ProxyConnectionRequest_Accept_:
+		proxyConn, err := rpc.takeProxyConnection(typedAction.Accept.Id)
+		if err != nil {
+			return err
+		}
+
+		zap.S().Infof("proxying connection from %s to %s", proxyConn.RemoteAddr().String(), proxyConn.Target)
+
+		if err := proxyConn.Accept(); err != nil {
+			_ = proxyConn.Close()
+
+			return err
+		}
+
+		return forward.Pipe(stream.Context(), proxyConn, &proxyConnectionStream{stream: stream})
+	case *ProxyConnectionRequest_Reject_:
+		proxyConn, err := rpc.takeProxyConnection(typedAction.Reject.Id)
+		if err != nil {
+			return err
+		}
+
+		zap.S().Infof("host rejected proxying connection from %s to %s", proxyConn.RemoteAddr().String(),
+			proxyConn.Target)
+
+		return proxyConn.Reject(rejectReasonFromProto(typedAction.Reject.Reason))
+	default:
+		return status.Error(codes.InvalidArgument, "first proxy connection request should "+
+			"either accept or reject a connection")
+	}
+}
+
+func (rpc *RPC) takeProxyConnection(id string) (*proxy.Conn, error) {
+	proxyConn, ok := rpc.pendingProxyConnections.Take(id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "connection %q does not exist or has already been "+
+			"picked up", id)
+	}
+
+	return proxyConn, nil
+}
+
+func proxyProtocolToProto(protocol proxy.Protocol) ProxyResponse_IncomingConnection_Protocol {
+	switch protocol {
+	case proxy.ProtocolSOCKS5:
+		return ProxyResponse_IncomingConnection_PROTOCOL_SOCKS5
+	case proxy.ProtocolHTTPConnect:
+		return ProxyResponse_IncomingConnection_PROTOCOL_HTTP_CONNECT
+	default:
+		return ProxyResponse_IncomingConnection_PROTOCOL_UNSPECIFIED
+	}
+}
+
+func rejectReasonFromProto(reason ProxyConnectionRequest_Reject_Reason) proxy.RejectReason {
+	switch reason {
+	case ProxyConnectionRequest_Reject_REASON_HOST_UNREACHABLE:
+		return proxy.RejectReasonHostUnreachable
+	case ProxyConnectionRequest_Reject_REASON_CONNECTION_REFUSED:
+		return proxy.RejectReasonConnectionRefused
+	default:
+		return proxy.RejectReasonNotAllowed
+	}
+}
+
+type proxyConnectionStream struct {
+	stream grpc.BidiStreamingServer[ProxyConnectionRequest, ProxyConnectionResponse]
+}
+
+func (s *proxyConnectionStream) Recv() ([]byte, error) {
+	request, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	data := request.GetData()
+	if data == nil {
+		return nil, fmt.Errorf("expected data, got %T", request.Type)
+	}
+
+	return data.Data, nil
+}
+
+func (s *proxyConnectionStream) Send(data []byte) error {
+	return s.stream.Send(&ProxyConnectionResponse{
+		Type: &ProxyConnectionResponse_Data{
+			Data: &IOChunk{Data: data},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rpc, err := New(nil)
+	require.NoError(t, err)
+
+	client := serveBufconn(t, ctx, rpc)
+
+	proxyStream, err := client.Proxy(ctx, &ProxyRequest{Address: "127.0.0.1:0"})
+	require.NoError(t, err)
+
+	response, err := proxyStream.Recv()
+	require.NoError(t, err)
+	proxyAddress := response.GetListening().GetAddress()
+	require.NotEmpty(t, proxyAddress)
+
+	// HTTP CONNECT client whose connection is accepted by the host
+	httpConn, err := net.Dial("tcp", proxyAddress)
+	require.NoError(t, err)
+	defer httpConn.Close()
+
+	_, err = io.WriteString(httpConn, "CONNECT example.com:443 HTTP/1.1\r\nHost: example.com:443\r\n\r\n")
+	require.NoError(t, err)
+
+	response, err = proxyStream.Recv()
+	require.NoError(t, err)
+	incomingConnection := response.GetIncomingConnection()
+	require.NotNil(t, incomingConnection)
+	require.Equal(t, ProxyResponse_IncomingConnection_PROTOCOL_HTTP_CONNECT, incomingConnection.Protocol)
+	require.Equal(t, "example.com:443", incomingConnection.Target)
+
+	connectionStream, err := client.ProxyConnection(ctx)
+	require.NoError(t, err)
+	require.NoError(t, connectionStream.Send(&ProxyConnectionRequest{
+		Type: &ProxyConnectionRequest_Accept_{
+			Accept: &ProxyConnectionRequest_Accept{Id: incomingConnection.Id},
+		},
+	}))
+
+	httpReader := bufio.NewReader(httpConn)
+
+	httpResponse, err := http.ReadResponse(httpReader, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, httpResponse.StatusCode)
+
+	// Data is relayed in both directions
+	_, err = io.WriteString(httpConn, "ping")
+	require.NoError(t, err)
+
+	connectionResponse, err := connectionStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "ping", string(connectionResponse.GetData().GetData()))
+
+	require.NoError(t, connectionStream.Send(&ProxyConnectionRequest{
+		Type: &ProxyConnectionRequest_Data{
+			Data: &IOChunk{Data: []byte("pong")},
+		},
+	}))
+
+	pong := make([]byte, 4)
+	_, err = io.ReadFull(httpReader, pong)
+	require.NoError(t, err)
+	require.Equal(t, "pong", string(pong))
+
+	// SOCKS5 client whose connection is rejected by the host
+	socksConn, err := net.Dial("tcp", proxyAddress)
+	require.NoError(t, err)
+	defer socksConn.Close()
+
+	_, err = socksConn.Write([]byte{0x05, 0x01, 0x00})
+	require.NoError(t, err)
+
+	methodReply := make([]byte, 2)
+	_, err = io.ReadFull(socksConn, methodReply)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x05, 0x00}, methodReply)
+
+	_, err = socksConn.Write(append([]byte{0x05, 0x01, 0x00, 0x03, byte(len("example.org"))},
+		append([]byte("example.org"), 0x00, 0x50)...))
+	require.NoError(t, err)
+
+	response, err = proxyStream.Recv()
+	require.NoError(t, err)
+	incomingConnection = response.GetIncomingConnection()
+	require.NotNil(t, incomingConnection)
+	require.Equal(t, ProxyResponse_IncomingConnection_PROTOCOL_SOCKS5, incomingConnection.Protocol)
+	require.Equal(t, "example.org:80", incomingConnection.Target)
+
+	connectionStream, err = client.ProxyConnection(ctx)
+	require.NoError(t, err)
+	require.NoError(t, connectionStream.Send(&ProxyConnectionRequest{
+		Type: &ProxyConnectionRequest_Reject_{
+			Reject: &ProxyConnectionRequest_Reject{
+				Id:     incomingConnection.Id,
+				Reason: ProxyConnectionRequest_Reject_REASON_NOT_ALLOWED,
+			},
+		},
+	}))
+
+	_, err = connectionStream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+
+	connectReply := make([]byte, 2)
+	_, err = io.ReadFull(socksConn, connectReply)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x05, 0x02}, connectReply)
+}
+
+// serveBufconn serves the RPC over an in-memory
+// connection and returns the client connected to it.
+func serveBufconn(t *testing.T, ctx context.Context, rpc *RPC) AgentClient {
+	listener := bufconn.Listen(1024 * 1024)
+
+	rpc.listener = listener
+
+	go func() {
+		_ = rpc.Run(ctx)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return NewAgentClient(conn)
+}
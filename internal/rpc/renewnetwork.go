@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/netconfig"
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+	"github.com/cirruslabs/tart-guest-agent/internal/netwatch"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultRenewNetworkWaitTimeout = 30 * time.Second
+
+func (rpc *RPC) RenewNetwork(ctx context.Context, request *RenewNetworkRequest) (*RenewNetworkResponse, error) {
+	iface := request.Interface
+
+	if iface == "" {
+		interfaces, err := netinfo.Interfaces()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, candidate := range interfaces {
+			if candidate.DefaultRouteIPv4 {
+				iface = candidate.Name
+
+				break
+			}
+		}
+
+		if iface == "" {
+			return nil, status.Error(codes.FailedPrecondition, "no interface specified "+
+				"and none of the interfaces holds the IPv4 default route")
+		}
+	}
+
+	waitTimeout := defaultRenewNetworkWaitTimeout
+	if request.WaitTimeout != nil {
+		waitTimeout = request.WaitTimeout.AsDuration()
+	}
+
+	// Subscribe to the network changes before renewing the lease,
+	// otherwise an address re-acquired between checking the interface
+	// and waiting for the changes might go unnoticed
+	changesCtx, changesCancel := context.WithCancel(ctx)
+	defer changesCancel()
+
+	changes := netwatch.Changes(changesCtx)
+
+	if _, _, err := renewNetworkState(iface); err != nil {
+		return nil, err
+	}
+
+	zap.S().Infof("renewing DHCP lease for %s...", iface)
+
+	method, err := netconfig.Renew(ctx, iface)
+	if err != nil {
+		if errors.Is(err, netconfig.ErrNoRenewMethod) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	timer := time.NewTimer(waitTimeout)
+	defer timer.Stop()
+
+	// The renewal is complete once the renewal command has succeeded and
+	// the interface holds an IPv4 address, which is usually the same one,
+	// since the DHCP server hands out the same address to the same MAC,
+	// but might be missing for a while when the old lease was released
+	for {
+		renewedInterface, addresses, err := renewNetworkState(iface)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(addresses) != 0 {
+			zap.S().Infof("renewed DHCP lease for %s using %s, got %s", iface, method, addresses[0])
+
+			// Report all of the interface's addresses, not only IPv4
+			return &RenewNetworkResponse{
+				Method:    method,
+				Interface: interfacesToProto([]netinfo.Interface{*renewedInterface})[0],
+			}, nil
+		}
+
+		select {
+		case <-changes:
+		case <-timer.C:
+			return nil, status.Errorf(codes.DeadlineExceeded, "%s didn't get an IPv4 address "+
+				"within %v after the renewal", iface, waitTimeout)
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// renewNetworkState returns the interface along with its global
+// unicast IPv4 addresses, which is what DHCP hands out.
+func renewNetworkState(iface string) (*netinfo.Interface, []net.IP, error) {
+	interfaces, err := netinfo.Interfaces()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interfaces = netinfo.Filter{InterfaceNames: []string{iface}}.Apply(interfaces)
+	if len(interfaces) == 0 {
+		return nil, nil, status.Errorf(codes.NotFound, "interface %s not found", iface)
+	}
+
+	var addresses []net.IP
+
+	for _, address := range interfaces[0].Addresses {
+		if address.Family() == netinfo.FamilyIPv4 && address.IP.IsGlobalUnicast() {
+			addresses = append(addresses, address.IP)
+		}
+	}
+
+	return &interfaces[0], addresses, nil
+}
@@ -2,41 +2,124 @@ package rpc
 
 import (
 	"context"
-	"fmt"
-	"net"
+	"errors"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+	"github.com/cirruslabs/tart-guest-agent/internal/netwatch"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (rpc *RPC) ResolveIP(ctx context.Context, _ *ResolveIPRequest) (*ResolveIPResponse, error) {
-	ifaceAddrs, err := net.InterfaceAddrs()
+var errCannotResolveIP = errors.New("cannot resolve VM's IP address")
+
+func (rpc *RPC) ResolveIP(ctx context.Context, request *ResolveIPRequest) (*ResolveIPResponse, error) {
+	filter, err := filterFromRequest(request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve VM's network interface addresses: %w", err)
+		return nil, err
 	}
 
-	for _, ifaceAddr := range ifaceAddrs {
-		// Addresses returned by net.InterfaceAddrs()
-		// generally are of type *net.IPNet
-		ipNet, ok := ifaceAddr.(*net.IPNet)
-		if !ok {
-			continue
-		}
+	if !request.Wait {
+		return resolveIPOnce(filter)
+	}
+
+	if request.WaitTimeout != nil {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, request.WaitTimeout.AsDuration())
+		defer cancel()
+	}
 
-		// Only interested in IPv4 addresses
-		if ipNet.IP.To4() == nil {
-			continue
+	// Subscribe to the network changes before examining the addresses
+	// to avoid missing the changes that happen in-between
+	changes := netwatch.Changes(ctx)
+
+	for {
+		response, err := resolveIPOnce(filter)
+		if !errors.Is(err, errCannotResolveIP) {
+			return response, err
 		}
 
-		// Only interested in global unicast addresses
-		//
-		// Note that Golang's "net" package also includes
-		// IPv4 private address space in this definition.
-		if !ipNet.IP.IsGlobalUnicast() {
-			continue
+		// The channel is closed once the context is done, distinguish
+		// the expired timeout from the cancelled RPC in that case
+		if _, ok := <-changes; !ok {
+			return nil, status.FromContextError(ctx.Err()).Err()
 		}
+	}
+}
+
+func resolveIPOnce(filter netinfo.Filter) (*ResolveIPResponse, error) {
+	interfaces, err := netinfo.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	matchingInterfaces := filter.Apply(interfaces)
 
-		return &ResolveIPResponse{
-			Ip: ipNet.IP.String(),
-		}, nil
+	ip, ok := netinfo.Preferred(matchingInterfaces, filter.Family)
+	if !ok {
+		return nil, errCannotResolveIP
 	}
 
-	return nil, fmt.Errorf("cannot resolve VM's IP address")
+	return &ResolveIPResponse{
+		Ip:         ip.String(),
+		Interfaces: interfacesToProto(matchingInterfaces),
+	}, nil
+}
+
+func filterFromRequest(request *ResolveIPRequest) (netinfo.Filter, error) {
+	subnets, err := netinfo.ParseSubnets(request.Subnets)
+	if err != nil {
+		return netinfo.Filter{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return netinfo.Filter{
+		Family:           familyFromProto(request.Family),
+		InterfaceNames:   request.Interfaces,
+		Subnets:          subnets,
+		DefaultRouteOnly: request.DefaultRouteOnly,
+	}, nil
+}
+
+func familyFromProto(family AddressFamily) netinfo.Family {
+	switch family {
+	case AddressFamily_ADDRESS_FAMILY_IPV4:
+		return netinfo.FamilyIPv4
+	case AddressFamily_ADDRESS_FAMILY_IPV6:
+		return netinfo.FamilyIPv6
+	default:
+		return netinfo.FamilyAny
+	}
+}
+
+func familyToProto(family netinfo.Family) AddressFamily {
+	switch family {
+	case netinfo.FamilyIPv4:
+		return AddressFamily_ADDRESS_FAMILY_IPV4
+	case netinfo.FamilyIPv6:
+		return AddressFamily_ADDRESS_FAMILY_IPV6
+	default:
+		return AddressFamily_ADDRESS_FAMILY_UNSPECIFIED
+	}
+}
+
+func interfacesToProto(interfaces []netinfo.Interface) []*NetworkInterface {
+	return lo.Map(interfaces, func(iface netinfo.Interface, _ int) *NetworkInterface {
+		return &NetworkInterface{
+			Name:  iface.Name,
+			Index: uint32(iface.Index),
+			Mac:   iface.MAC,
+			Flags: iface.FlagNames(),
+			Mtu:   uint32(iface.MTU),
+			Addresses: lo.Map(iface.Addresses, func(address netinfo.Address, _ int) *NetworkInterface_Address {
+				return &NetworkInterface_Address{
+					Ip:           address.IP.String(),
+					PrefixLength: uint32(address.PrefixLength),
+					Family:       familyToProto(address.Family()),
+				}
+			}),
+			DefaultRouteIpv4: iface.DefaultRouteIPv4,
+			DefaultRouteIpv6: iface.DefaultRouteIPv6,
+		}
+	})
 }
@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/forward"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) ReverseForward(
+	request *ReverseForwardRequest,
+	stream grpc.ServerStreamingServer[ReverseForwardResponse],
+) error {
+	var listener net.Listener
+	var err error
+
+	switch target := request.Target.(type) {
+	case *ReverseForwardRequest_Address:
+		listener, err = net.Listen("tcp", target.Address)
+	case *ReverseForwardRequest_UnixSocketPath:
+		listener, err = forward.ListenUnix(target.UnixSocketPath, fs.FileMode(request.UnixSocketMode).Perm())
+	default:
+		return status.Error(codes.InvalidArgument, "no address or unix socket path to listen on specified")
+	}
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	go func() {
+		<-stream.Context().Done()
+
+		// Unblocks the pending accept below
+		_ = listener.Close()
+	}()
+
+	zap.S().Infof("reverse forwarding connections from %s", listener.Addr().String())
+
+	if err := stream.Send(&ReverseForwardResponse{
+		Type: &ReverseForwardResponse_Listening_{
+			Listening: &ReverseForwardResponse_Listening{
+				Address: listener.Addr().String(),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return stream.Context().Err()
+			}
+
+			return err
+		}
+
+		id := rpc.pendingConnections.Add(conn)
+
+		if err := stream.Send(&ReverseForwardResponse{
+			Type: &ReverseForwardResponse_IncomingConnection_{
+				IncomingConnection: &ReverseForwardResponse_IncomingConnection{
+					Id:            id,
+					RemoteAddress: conn.RemoteAddr().String(),
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (rpc *RPC) ReverseForwardConnection(
+	stream grpc.BidiStreamingServer[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse],
+) error {
+	// Read the first request, it should specify the connection to pick up
+	firstRequest, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	attach := firstRequest.GetAttach()
+	if attach == nil {
+		return status.Error(codes.InvalidArgument, "first reverse forward connection request "+
+			"should specify the connection to attach to")
+	}
+
+	conn, ok := rpc.pendingConnections.Take(attach.Id)
+	if !ok {
+		return status.Errorf(codes.NotFound, "connection %q does not exist or has already been "+
+			"picked up", attach.Id)
+	}
+
+	return forward.Pipe(stream.Context(), conn, &reverseForwardConnectionStream{stream: stream})
+}
+
+type reverseForwardConnectionStream struct {
+	stream grpc.BidiStreamingServer[ReverseForwardConnectionRequest, ReverseForwardConnectionResponse]
+}
+
+func (s *reverseForwardConnectionStream) Recv() ([]byte, error) {
+	request, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	data := request.GetData()
+	if data == nil {
+		return nil, fmt.Errorf("expected data, got %T", request.Type)
+	}
+
+	return data.Data, nil
+}
+
+func (s *reverseForwardConnectionStream) Send(data []byte) error {
+	return s.stream.Send(&ReverseForwardConnectionResponse{
+		Type: &ReverseForwardConnectionResponse_Data{
+			Data: &IOChunk{Data: data},
+		},
+	})
+}
@@ -2,24 +2,84 @@ package rpc
 
 import (
 	"context"
+	"github.com/cirruslabs/tart-guest-agent/internal/forward"
+	"github.com/cirruslabs/tart-guest-agent/internal/health"
+	"github.com/cirruslabs/tart-guest-agent/internal/identity"
+	"github.com/cirruslabs/tart-guest-agent/internal/proxy"
+	"github.com/cirruslabs/tart-guest-agent/internal/transfer"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"net"
+	"sync/atomic"
+)
+
+const (
+	// DefaultMaxMessageSize matches gRPC's default, but is set
+	// explicitly so that it can be reported to the clients.
+	DefaultMaxMessageSize = 4 * 1024 * 1024
+
+	// DefaultMaxConcurrentStreams limits the number of concurrent
+	// RPCs per connection, the ones above the limit are queued
+	// by the clients until the earlier ones finish.
+	DefaultMaxConcurrentStreams = 256
+
+	// DefaultMaxSessions limits the number of concurrent
+	// streaming RPCs across all connections.
+	DefaultMaxSessions = 1024
 )
 
 type RPC struct {
 	grpcServer *grpc.Server
 	listener   net.Listener
 
+	transferManager         *transfer.Manager
+	pendingConnections      *forward.Pending[net.Conn]
+	pendingProxyConnections *forward.Pending[*proxy.Conn]
+	components              []string
+	healthReporter          *health.Reporter
+	shutdownHooksDir        string
+	identityStatePath       string
+	hostnameTemplate        string
+	maxSessions             int64
+	sessions                atomic.Int64
+
 	UnimplementedAgentServer
 }
 
-func New(listener net.Listener) (*RPC, error) {
+func New(listener net.Listener, opts ...Option) (*RPC, error) {
 	rpc := &RPC{
-		grpcServer: grpc.NewServer(),
-		listener:   listener,
+		listener:                listener,
+		pendingConnections:      forward.NewPending[net.Conn](forward.DefaultPendingTimeout),
+		pendingProxyConnections: forward.NewPending[*proxy.Conn](forward.DefaultPendingTimeout),
+		maxSessions:             DefaultMaxSessions,
+	}
+
+	rpc.grpcServer = grpc.NewServer(
+		grpc.MaxRecvMsgSize(DefaultMaxMessageSize),
+		grpc.MaxConcurrentStreams(DefaultMaxConcurrentStreams),
+		grpc.StreamInterceptor(rpc.limitSessions),
+	)
+
+	// Apply options
+	for _, opt := range opts {
+		opt(rpc)
+	}
+
+	// Apply defaults
+	if rpc.identityStatePath == "" {
+		rpc.identityStatePath = identity.DefaultStatePath()
+	}
+
+	if rpc.healthReporter == nil {
+		rpc.healthReporter = health.NewReporter()
 	}
 
 	RegisterAgentServer(rpc.grpcServer, rpc)
+	healthpb.RegisterHealthServer(rpc.grpcServer, rpc.healthReporter.Server())
+
+	// Allow tools like grpcurl to discover the service
+	reflection.Register(rpc.grpcServer)
 
 	return rpc, nil
 }
@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// limitSessions rejects the Agent's streaming RPCs once there are
+// maxSessions of them in progress, the health checking and reflection
+// services are not limited since their streams are cheap.
+func (rpc *RPC) limitSessions(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if !strings.HasPrefix(info.FullMethod, "/"+Agent_ServiceDesc.ServiceName+"/") {
+		return handler(srv, stream)
+	}
+
+	defer rpc.sessions.Add(-1)
+
+	if rpc.sessions.Add(1) > rpc.maxSessions {
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent sessions, the limit is %d",
+			rpc.maxSessions)
+	}
+
+	return handler(srv, stream)
+}
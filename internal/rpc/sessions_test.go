@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimitSessions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rpc, err := New(nil)
+	require.NoError(t, err)
+	rpc.maxSessions = 1
+
+	client := serveBufconn(t, ctx, rpc)
+
+	capabilities, err := client.GetCapabilities(ctx, &GetCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, DefaultMaxConcurrentStreams, capabilities.Limits.MaxConcurrentStreams)
+	require.EqualValues(t, 1, capabilities.Limits.MaxSessions)
+
+	firstCtx, firstCancel := context.WithCancel(ctx)
+	defer firstCancel()
+
+	first, err := client.WatchNetwork(firstCtx, &WatchNetworkRequest{})
+	require.NoError(t, err)
+	_, err = first.Recv()
+	require.NoError(t, err)
+
+	second, err := client.WatchNetwork(ctx, &WatchNetworkRequest{})
+	require.NoError(t, err)
+	_, err = second.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Unary RPCs are not limited
+	_, err = client.GetCapabilities(ctx, &GetCapabilitiesRequest{})
+	require.NoError(t, err)
+
+	// The session slot is released once the stream is done
+	firstCancel()
+
+	require.Eventually(t, func() bool {
+		return rpc.sessions.Load() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	third, err := client.WatchNetwork(ctx, &WatchNetworkRequest{})
+	require.NoError(t, err)
+	_, err = third.Recv()
+	require.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/power"
+	"github.com/cirruslabs/tart-guest-agent/internal/sysinfo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (rpc *RPC) Shutdown(_ context.Context, request *ShutdownRequest) (*ShutdownResponse, error) {
+	var mode power.Mode
+
+	switch request.Mode {
+	case ShutdownMode_SHUTDOWN_MODE_UNSPECIFIED, ShutdownMode_SHUTDOWN_MODE_POWEROFF:
+		mode = power.ModePowerOff
+	case ShutdownMode_SHUTDOWN_MODE_REBOOT:
+		mode = power.ModeReboot
+	case ShutdownMode_SHUTDOWN_MODE_HALT:
+		mode = power.ModeHalt
+	case ShutdownMode_SHUTDOWN_MODE_LOGOUT_CONSOLE_USER:
+		mode = power.ModeLogoutConsoleUser
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported mode %s", request.Mode)
+	}
+
+	// Fail early instead of acknowledging a shutdown that cannot happen,
+	// only the console user logout is available to the other users
+	if mode != power.ModeLogoutConsoleUser && os.Geteuid() != 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "%s requires the agent to run as root", mode)
+	}
+
+	var delay time.Duration
+
+	if request.Delay != nil {
+		delay = request.Delay.AsDuration()
+
+		if delay < 0 {
+			return nil, status.Error(codes.InvalidArgument, "negative delay")
+		}
+	}
+
+	// Fail early instead of acknowledging a logout that cannot happen
+	if mode == power.ModeLogoutConsoleUser {
+		consoleUser, err := sysinfo.ConsoleUser()
+		if err != nil {
+			return nil, err
+		}
+
+		if consoleUser == "" {
+			return nil, status.Error(codes.FailedPrecondition, power.ErrNoConsoleUser.Error())
+		}
+	}
+
+	options := power.Options{
+		Mode:    mode,
+		Delay:   delay,
+		Message: request.Message,
+	}
+
+	if !request.SkipHooks {
+		options.HooksDir = rpc.shutdownHooksDir
+	}
+
+	// Shut down in the background after at least a grace
+	// period, so that the response is sent beforehand
+	scheduledAt, err := power.Schedule(options)
+	if err != nil {
+		if errors.Is(err, power.ErrAlreadyScheduled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	zap.S().Infof("scheduled %s at %s", mode, scheduledAt.Format(time.RFC3339))
+
+	return &ShutdownResponse{
+		ScheduledAt: timestamppb.New(scheduledAt),
+	}, nil
+}
+
+func (rpc *RPC) CancelShutdown(_ context.Context, _ *CancelShutdownRequest) (*CancelShutdownResponse, error) {
+	if err := power.Cancel(); err != nil {
+		if errors.Is(err, power.ErrNotScheduled) || errors.Is(err, power.ErrTooLate) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	zap.S().Infof("cancelled the scheduled shutdown")
+
+	return &CancelShutdownResponse{}, nil
+}
@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/delta"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const signaturesPerMessage = 1024
+
+func (rpc *RPC) SyncFile(stream grpc.BidiStreamingServer[SyncFileRequest, SyncFileResponse]) error {
+	// Read the first sync request, it should describe a file to synchronize
+	firstSyncRequest, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	begin, ok := firstSyncRequest.Type.(*SyncFileRequest_Begin_)
+	if !ok {
+		return fmt.Errorf("first sync request should describe a file to synchronize")
+	}
+
+	blockSize := int(begin.Begin.BlockSize)
+	if blockSize == 0 {
+		blockSize = delta.DefaultBlockSize
+	}
+
+	zap.S().Infof("synchronizing %s using %d byte blocks", begin.Begin.Path, blockSize)
+
+	patcher, err := delta.NewPatcher(begin.Begin.Path, blockSize, fs.FileMode(begin.Begin.Mode).Perm())
+	if err != nil {
+		return err
+	}
+	defer patcher.Close()
+
+	// Send the existing file's signatures in batches
+	var blocks []*SyncFileResponse_Signatures_Block
+
+	flushBlocks := func() error {
+		if len(blocks) == 0 {
+			return nil
+		}
+
+		response := &SyncFileResponse{
+			Type: &SyncFileResponse_Signatures_{
+				Signatures: &SyncFileResponse_Signatures{
+					Blocks: blocks,
+				},
+			},
+		}
+		blocks = nil
+
+		return stream.Send(response)
+	}
+
+	if err := patcher.Signatures(func(signature delta.BlockSignature) error {
+		blocks = append(blocks, &SyncFileResponse_Signatures_Block{
+			Weak:   signature.Weak,
+			Strong: signature.Strong[:],
+		})
+
+		if len(blocks) < signaturesPerMessage {
+			return nil
+		}
+
+		return flushBlocks()
+	}); err != nil {
+		return err
+	}
+
+	if err := flushBlocks(); err != nil {
+		return err
+	}
+
+	if err := stream.Send(&SyncFileResponse{
+		Type: &SyncFileResponse_SignaturesEnd_{
+			SignaturesEnd: &SyncFileResponse_SignaturesEnd{
+				BlockSize: uint32(blockSize),
+				Size:      uint64(patcher.BaseSize()),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	// Reconstruct the file
+	for {
+		request, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch typedRequest := request.Type.(type) {
+		case *SyncFileRequest_CopyBlocks_:
+			if err := patcher.Apply(delta.Op{
+				BlockIndex: typedRequest.CopyBlocks.Index,
+				BlockCount: typedRequest.CopyBlocks.Count,
+			}); err != nil {
+				if errors.Is(err, delta.ErrInvalidOp) {
+					return status.Error(codes.InvalidArgument, err.Error())
+				}
+
+				return err
+			}
+		case *SyncFileRequest_Literal:
+			if err := patcher.Apply(delta.Op{
+				Literal: typedRequest.Literal.Data,
+			}); err != nil {
+				return err
+			}
+		case *SyncFileRequest_Commit_:
+			size, err := patcher.Commit(typedRequest.Commit.Sha256)
+			if err != nil {
+				return err
+			}
+
+			zap.S().Infof("synchronized %s (%d bytes)", begin.Begin.Path, size)
+
+			return stream.Send(&SyncFileResponse{
+				Type: &SyncFileResponse_Committed_{
+					Committed: &SyncFileResponse_Committed{
+						Size: size,
+					},
+				},
+			})
+		default:
+			return fmt.Errorf("unexpected sync request")
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/sysinfo"
+	"github.com/cirruslabs/tart-guest-agent/internal/tart"
+	"github.com/cirruslabs/tart-guest-agent/internal/version"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (rpc *RPC) GetSystemInfo(_ context.Context, _ *GetSystemInfoRequest) (*GetSystemInfoResponse, error) {
+	info, err := sysinfo.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSystemInfoResponse{
+		Os: &GetSystemInfoResponse_OS{
+			Name:    info.OSName,
+			Version: info.OSVersion,
+			Build:   info.OSBuild,
+		},
+		KernelVersion: info.KernelVersion,
+		Architecture:  info.Architecture,
+		Hostname:      info.Hostname,
+		Cpu: &GetSystemInfoResponse_CPU{
+			Count: uint32(info.CPUCount),
+			Model: info.CPUModel,
+		},
+		Memory: &GetSystemInfoResponse_Memory{
+			TotalBytes:     info.MemoryTotal,
+			AvailableBytes: info.MemoryAvailable,
+		},
+		ConsoleUser: info.ConsoleUser,
+		Agent: &GetSystemInfoResponse_Agent{
+			Version: version.Version,
+			Commit:  version.Commit,
+		},
+	}
+
+	if !info.BootTime.IsZero() {
+		response.BootTime = timestamppb.New(info.BootTime)
+		response.Uptime = durationpb.New(info.Uptime())
+	}
+
+	if tartVersion, ok := tart.Version(); ok {
+		response.TartVersion = tartVersion.String()
+	}
+
+	return response, nil
+}
@@ -0,0 +1,208 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/fileattr"
+	"github.com/cirruslabs/tart-guest-agent/internal/transfer"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadChunkSize = 64 * 1024
+
+var errResumableUploadsDisabled = status.Error(codes.FailedPrecondition,
+	"resumable uploads are disabled because the transfer staging directory is unavailable")
+
+func (rpc *RPC) Upload(stream grpc.ClientStreamingServer[UploadRequest, UploadResponse]) error {
+	// Read the first upload request, it should describe a file to upload
+	firstUploadRequest, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	begin, ok := firstUploadRequest.Type.(*UploadRequest_Begin_)
+	if !ok {
+		return fmt.Errorf("first upload request should describe a file to upload")
+	}
+
+	metadata := transfer.Metadata{
+		Path: begin.Begin.Path,
+		Size: begin.Begin.Size,
+		Mode: begin.Begin.Mode,
+	}
+
+	// Uploads without a transfer ID are not resumable,
+	// so their partial data is discarded on failure
+	var xfer *transfer.Transfer
+
+	if transferID := begin.Begin.TransferId; transferID != "" {
+		if rpc.transferManager == nil {
+			return errResumableUploadsDisabled
+		}
+
+		zap.S().Infof("uploading %s (%d bytes) from offset %d, transfer ID %s", begin.Begin.Path,
+			begin.Begin.Size, begin.Begin.Offset, transferID)
+
+		xfer, err = rpc.transferManager.Open(transferID, metadata, begin.Begin.Offset)
+	} else {
+		if begin.Begin.Offset != 0 {
+			return status.Error(codes.InvalidArgument, "uploads without a transfer ID "+
+				"cannot be resumed from a non-zero offset")
+		}
+
+		zap.S().Infof("uploading %s (%d bytes)", begin.Begin.Path, begin.Begin.Size)
+
+		xfer, err = transfer.OpenTemporary(metadata)
+	}
+	if err != nil {
+		return transferError(err)
+	}
+
+	defer func() {
+		if err := xfer.Close(); err != nil {
+			zap.S().Warnf("failed to close the transfer of %s: %v", begin.Begin.Path, err)
+		}
+	}()
+
+	for {
+		request, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return fmt.Errorf("upload of %s was not committed", begin.Begin.Path)
+			}
+
+			return err
+		}
+
+		switch typedRequest := request.Type.(type) {
+		case *UploadRequest_Data:
+			if err := xfer.Write(typedRequest.Data.Data); err != nil {
+				return err
+			}
+		case *UploadRequest_Commit_:
+			if err := xfer.Commit(typedRequest.Commit.Sha256, xattrsFromProto(begin.Begin.Xattrs)); err != nil {
+				return err
+			}
+
+			zap.S().Infof("uploaded %s (%d bytes)", begin.Begin.Path, xfer.Offset())
+
+			return stream.SendAndClose(&UploadResponse{
+				Size: xfer.Offset(),
+			})
+		default:
+			return fmt.Errorf("unexpected upload request")
+		}
+	}
+}
+
+func (rpc *RPC) Download(request *DownloadRequest, stream grpc.ServerStreamingServer[DownloadResponse]) error {
+	zap.S().Infof("downloading %s from offset %d", request.Path, request.Offset)
+
+	file, err := os.Open(request.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", request.Path)
+	}
+
+	if request.Offset > uint64(fileInfo.Size()) {
+		return fmt.Errorf("offset %d is past the end of %s (%d bytes)", request.Offset,
+			request.Path, fileInfo.Size())
+	}
+
+	var xattrs []fileattr.Xattr
+
+	if request.IncludeXattrs {
+		xattrs, err = fileattr.GetXattrs(request.Path, false)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve extended attributes of %s: %w", request.Path, err)
+		}
+	}
+
+	if err := stream.Send(&DownloadResponse{
+		Type: &DownloadResponse_Metadata_{
+			Metadata: &DownloadResponse_Metadata{
+				Size:   uint64(fileInfo.Size()),
+				Mode:   uint32(fileInfo.Mode().Perm()),
+				Xattrs: xattrsToProto(xattrs),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	return sendChunks(io.NewSectionReader(file, int64(request.Offset), fileInfo.Size()-int64(request.Offset)),
+		func(chunk []byte) error {
+			return stream.Send(&DownloadResponse{
+				Type: &DownloadResponse_Data{
+					Data: &IOChunk{
+						Data: chunk,
+					},
+				},
+			})
+		})
+}
+
+func (rpc *RPC) QueryTransfer(_ context.Context, request *QueryTransferRequest) (*QueryTransferResponse, error) {
+	if rpc.transferManager == nil {
+		return nil, errResumableUploadsDisabled
+	}
+
+	metadata, committedOffset, err := rpc.transferManager.Query(request.TransferId)
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	return &QueryTransferResponse{
+		Path:            metadata.Path,
+		Size:            metadata.Size,
+		CommittedOffset: committedOffset,
+	}, nil
+}
+
+func sendChunks(r io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, downloadChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := send(slices.Clone(buf[:n])); err != nil {
+				return err
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+func transferError(err error) error {
+	switch {
+	case errors.Is(err, transfer.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, transfer.ErrBusy):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
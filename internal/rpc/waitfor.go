@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+	"github.com/cirruslabs/tart-guest-agent/internal/waitfor"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) WaitFor(ctx context.Context, request *WaitForRequest) (*WaitForResponse, error) {
+	if len(request.Conditions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no conditions specified")
+	}
+
+	var conditions []waitfor.Condition
+
+	for _, condition := range request.Conditions {
+		converted, err := conditionFromProto(condition)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		conditions = append(conditions, converted)
+	}
+
+	if request.Timeout != nil {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, request.Timeout.AsDuration())
+		defer cancel()
+	}
+
+	interval := waitfor.DefaultInterval
+	if request.PollInterval != nil && request.PollInterval.AsDuration() > 0 {
+		interval = max(request.PollInterval.AsDuration(), waitfor.MinInterval)
+	}
+
+	zap.S().Infof("waiting for %d condition(s)...", len(conditions))
+
+	statuses, satisfied := waitfor.Wait(ctx, conditions, interval)
+
+	// Distinguish the expired timeout from the cancelled RPC
+	if !satisfied && errors.Is(ctx.Err(), context.Canceled) {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	response := &WaitForResponse{
+		Satisfied: satisfied,
+	}
+
+	for i, conditionStatus := range statuses {
+		protoStatus := &WaitForResponse_ConditionStatus{
+			Condition: request.Conditions[i],
+			Satisfied: conditionStatus.Satisfied,
+		}
+
+		if conditionStatus.Err != nil {
+			protoStatus.Error = conditionStatus.Err.Error()
+		}
+
+		response.Conditions = append(response.Conditions, protoStatus)
+	}
+
+	return response, nil
+}
+
+func conditionFromProto(condition *WaitForCondition) (waitfor.Condition, error) {
+	switch typedCondition := condition.Type.(type) {
+	case *WaitForCondition_PathExists:
+		return waitfor.PathExists{Path: typedCondition.PathExists}, nil
+	case *WaitForCondition_PortListening:
+		if typedCondition.PortListening == 0 || typedCondition.PortListening > math.MaxUint16 {
+			return nil, errors.New("invalid port")
+		}
+
+		return waitfor.PortListening{Port: uint16(typedCondition.PortListening)}, nil
+	case *WaitForCondition_ProcessRunning:
+		return waitfor.ProcessRunning{Name: typedCondition.ProcessRunning}, nil
+	case *WaitForCondition_AddressAssigned_:
+		subnets, err := netinfo.ParseSubnets(typedCondition.AddressAssigned.Subnets)
+		if err != nil {
+			return nil, err
+		}
+
+		return waitfor.AddressAssigned{
+			Filter: netinfo.Filter{
+				Family:           familyFromProto(typedCondition.AddressAssigned.Family),
+				InterfaceNames:   typedCondition.AddressAssigned.Interfaces,
+				Subnets:          subnets,
+				DefaultRouteOnly: typedCondition.AddressAssigned.DefaultRouteOnly,
+			},
+		}, nil
+	case *WaitForCondition_UserLoggedIn:
+		return waitfor.UserLoggedIn{User: typedCondition.UserLoggedIn}, nil
+	case *WaitForCondition_ServiceActive:
+		if typedCondition.ServiceActive == "" {
+			return nil, errors.New("no service name specified")
+		}
+
+		return waitfor.ServiceActive{Name: typedCondition.ServiceActive}, nil
+	default:
+		return nil, errors.New("empty condition")
+	}
+}
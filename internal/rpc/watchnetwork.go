@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+	"github.com/cirruslabs/tart-guest-agent/internal/netwatch"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func (rpc *RPC) WatchNetwork(request *WatchNetworkRequest, stream grpc.ServerStreamingServer[WatchNetworkResponse]) error {
+	// Addresses are filtered by family separately to avoid
+	// reporting the interfaces as added and removed when they
+	// gain or lose their last address of that family
+	interfaceFilter := netinfo.Filter{
+		InterfaceNames: request.Interfaces,
+	}
+	family := familyFromProto(request.Family)
+
+	// Subscribe to the network changes before taking the
+	// snapshot to avoid missing the changes that happen in-between
+	changes := netwatch.Changes(stream.Context())
+
+	interfaces, err := netinfo.Interfaces()
+	if err != nil {
+		return err
+	}
+	interfaces = interfaceFilter.Apply(interfaces)
+
+	if err := stream.Send(&WatchNetworkResponse{
+		Type: &WatchNetworkResponse_Snapshot_{
+			Snapshot: &WatchNetworkResponse_Snapshot{
+				Interfaces: interfacesToProto(interfaces),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-changes:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		newInterfaces, err := netinfo.Interfaces()
+		if err != nil {
+			return err
+		}
+		newInterfaces = interfaceFilter.Apply(newInterfaces)
+
+		for _, change := range netinfo.Diff(interfaces, newInterfaces) {
+			event := changeToProto(change, family)
+			if event == nil {
+				continue
+			}
+
+			zap.S().Debugf("network change: %s", event.String())
+
+			if err := stream.Send(&WatchNetworkResponse{
+				Type: &WatchNetworkResponse_Event_{
+					Event: event,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		interfaces = newInterfaces
+	}
+}
+
+func changeToProto(change netinfo.Change, family netinfo.Family) *WatchNetworkResponse_Event {
+	event := &WatchNetworkResponse_Event{}
+
+	switch change.Type {
+	case netinfo.ChangeInterfaceAdded:
+		event.Type = WatchNetworkResponse_Event_TYPE_INTERFACE_ADDED
+	case netinfo.ChangeInterfaceRemoved:
+		event.Type = WatchNetworkResponse_Event_TYPE_INTERFACE_REMOVED
+	case netinfo.ChangeInterfaceUp:
+		event.Type = WatchNetworkResponse_Event_TYPE_INTERFACE_UP
+	case netinfo.ChangeInterfaceDown:
+		event.Type = WatchNetworkResponse_Event_TYPE_INTERFACE_DOWN
+	case netinfo.ChangeAddressAdded:
+		event.Type = WatchNetworkResponse_Event_TYPE_ADDRESS_ADDED
+	case netinfo.ChangeAddressRemoved:
+		event.Type = WatchNetworkResponse_Event_TYPE_ADDRESS_REMOVED
+	case netinfo.ChangeDefaultRoute:
+		event.Type = WatchNetworkResponse_Event_TYPE_DEFAULT_ROUTE_CHANGED
+		event.Family = familyToProto(change.Family)
+	default:
+		return nil
+	}
+
+	if family != netinfo.FamilyAny {
+		if change.Address != nil && change.Address.Family() != family {
+			return nil
+		}
+
+		if change.Type == netinfo.ChangeDefaultRoute && change.Family != family {
+			return nil
+		}
+	}
+
+	if change.Interface != nil {
+		event.Interface = interfacesToProto([]netinfo.Interface{*change.Interface})[0]
+	}
+
+	if change.Address != nil {
+		event.Address = &NetworkInterface_Address{
+			Ip:           change.Address.IP.String(),
+			PrefixLength: uint32(change.Address.PrefixLength),
+			Family:       familyToProto(change.Address.Family()),
+		}
+	}
+
+	return event
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"github.com/cirruslabs/tart-guest-agent/internal/pathwatcher"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func (rpc *RPC) WatchPath(request *WatchPathRequest, stream grpc.ServerStreamingServer[WatchPathResponse]) error {
+	zap.S().Infof("watching %v for changes", request.Paths)
+
+	pathWatcher, err := pathwatcher.New(request.Paths, request.Recursive, request.Include, request.Exclude)
+	if err != nil {
+		return err
+	}
+	defer pathWatcher.Close()
+
+	if err := stream.Send(&WatchPathResponse{
+		Type: &WatchPathResponse_Ready_{
+			Ready: &WatchPathResponse_Ready{},
+		},
+	}); err != nil {
+		return err
+	}
+
+	return pathWatcher.Run(stream.Context(), func(event pathwatcher.Event) error {
+		var eventType WatchPathResponse_Event_Type
+
+		switch event.Op {
+		case pathwatcher.OpCreate:
+			eventType = WatchPathResponse_Event_TYPE_CREATE
+		case pathwatcher.OpModify:
+			eventType = WatchPathResponse_Event_TYPE_MODIFY
+		case pathwatcher.OpDelete:
+			eventType = WatchPathResponse_Event_TYPE_DELETE
+		case pathwatcher.OpRename:
+			eventType = WatchPathResponse_Event_TYPE_RENAME
+		default:
+			eventType = WatchPathResponse_Event_TYPE_UNSPECIFIED
+		}
+
+		return stream.Send(&WatchPathResponse{
+			Type: &WatchPathResponse_Event_{
+				Event: &WatchPathResponse_Event{
+					Type:  eventType,
+					Path:  event.Path,
+					IsDir: event.IsDir,
+				},
+			},
+		})
+	})
+}
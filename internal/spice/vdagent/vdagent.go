@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 	"golang.design/x/clipboard"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -36,12 +37,17 @@ func New() (*VDAgent, error) {
 	}, nil
 }
 
-func (agent *VDAgent) Run(ctx context.Context) error {
+// Run processes the clipboard changes and the SPICE messages until
+// the context is cancelled or an error occurs. The ready function is
+// called once, after the first read from the serial port succeeds.
+func (agent *VDAgent) Run(ctx context.Context, ready func()) error {
 	subCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	clipboardCh := clipboard.Watch(subCtx, clipboard.FmtText)
 
+	readyOnce := sync.OnceFunc(ready)
+
 	for {
 		// Check for cancellation and clipboard changes
 		select {
@@ -63,12 +69,17 @@ func (agent *VDAgent) Run(ctx context.Context) error {
 		vdiAgentMessage, err := vd.ReadVDAgentMessage(agent.vdi)
 		if err != nil {
 			if errors.Is(err, os.ErrDeadlineExceeded) {
+				// The port works, the host just has nothing to say
+				readyOnce()
+
 				continue
 			}
 
 			return err
 		}
 
+		readyOnce()
+
 		switch vdiAgentMessage.Type {
 		case vd.VD_AGENT_ANNOUNCE_CAPABILITIES:
 			vdAgentAnnounceCapabilities, err := vd.ReadVDAgentAnnounceCapabilities(vdiAgentMessage.Data)
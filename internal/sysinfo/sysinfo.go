@@ -0,0 +1,121 @@
+package sysinfo
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+// Info describes the guest system.
+type Info struct {
+	OSName    string
+	OSVersion string
+	OSBuild   string
+
+	KernelVersion string
+	Architecture  string
+	Hostname      string
+
+	BootTime time.Time
+
+	CPUCount int
+	CPUModel string
+
+	MemoryTotal     uint64
+	MemoryAvailable uint64
+
+	// Empty when nobody is logged in on the console
+	ConsoleUser string
+}
+
+// Uptime returns the time elapsed since the boot.
+func (info *Info) Uptime() time.Duration {
+	return time.Since(info.BootTime).Truncate(time.Second)
+}
+
+// Get collects the information about the guest system.
+//
+// Information that is not available on a given system
+// is left empty rather than failing the whole call.
+func Get() (*Info, error) {
+	info := &Info{
+		Architecture: runtime.GOARCH,
+		CPUCount:     runtime.NumCPU(),
+	}
+
+	var utsname unix.Utsname
+
+	if err := unix.Uname(&utsname); err != nil {
+		return nil, err
+	}
+
+	info.KernelVersion = unix.ByteSliceToString(utsname.Release[:])
+
+	// E.g. "aarch64" on Linux and "arm64" on macOS
+	if machine := unix.ByteSliceToString(utsname.Machine[:]); machine != "" {
+		info.Architecture = machine
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	info.Hostname = hostname
+
+	if err := fill(info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// fillConsoleUser leaves the console user empty when it cannot be
+// determined (e.g. when the console's owner has no passwd entry).
+func fillConsoleUser(info *Info) {
+	consoleUser, err := ConsoleUser()
+	if err != nil {
+		zap.S().Warnf("failed to determine the console user: %v", err)
+
+		return
+	}
+
+	info.ConsoleUser = consoleUser
+}
+
+// parseKeyValue parses the "KEY=value" lines found in os-release(5)
+// and systemd's session files, unquoting the values if necessary.
+func parseKeyValue(content []byte) map[string]string {
+	result := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else {
+			value = strings.Trim(value, "'")
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
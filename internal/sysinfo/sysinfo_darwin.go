@@ -0,0 +1,92 @@
+package sysinfo
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"howett.net/plist"
+)
+
+const systemVersionPath = "/System/Library/CoreServices/SystemVersion.plist"
+
+type systemVersion struct {
+	ProductName         string `plist:"ProductName"`
+	ProductVersion      string `plist:"ProductVersion"`
+	ProductBuildVersion string `plist:"ProductBuildVersion"`
+}
+
+func fill(info *Info) error {
+	systemVersionRaw, err := os.ReadFile(systemVersionPath)
+	if err != nil {
+		return err
+	}
+
+	var systemVersion systemVersion
+
+	if _, err := plist.Unmarshal(systemVersionRaw, &systemVersion); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", systemVersionPath, err)
+	}
+
+	info.OSName = systemVersion.ProductName
+	info.OSVersion = systemVersion.ProductVersion
+	info.OSBuild = systemVersion.ProductBuildVersion
+
+	info.BootTime, err = BootTime()
+	if err != nil {
+		return err
+	}
+
+	// Purely informational, so do not fail if unavailable
+	info.CPUModel, _ = unix.Sysctl("machdep.cpu.brand_string")
+
+	info.MemoryTotal, err = unix.SysctlUint64("hw.memsize")
+	if err != nil {
+		return err
+	}
+
+	// Percentage of the memory available before the
+	// system starts to experience the memory pressure
+	if memoryStatusLevel, err := unix.SysctlUint32("kern.memorystatus_level"); err == nil {
+		info.MemoryAvailable = info.MemoryTotal / 100 * uint64(memoryStatusLevel)
+	}
+
+	fillConsoleUser(info)
+
+	return nil
+}
+
+// BootTime returns the time the system was booted at.
+func BootTime() (time.Time, error) {
+	bootTime, err := unix.SysctlTimeval("kern.boottime")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(bootTime.Unix()), nil
+}
+
+// ConsoleUser returns the user logged in via the login window,
+// which is the owner of the /dev/console device.
+func ConsoleUser() (string, error) {
+	fileInfo, err := os.Stat("/dev/console")
+	if err != nil {
+		return "", err
+	}
+
+	stat, ok := fileInfo.Sys().(*syscall.Stat_t)
+	if !ok || stat.Uid == 0 {
+		return "", nil
+	}
+
+	consoleUser, err := user.LookupId(strconv.FormatUint(uint64(stat.Uid), 10))
+	if err != nil {
+		return "", err
+	}
+
+	return consoleUser.Username, nil
+}
@@ -0,0 +1,116 @@
+package sysinfo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const systemdSessionsDir = "/run/systemd/sessions"
+
+func fill(info *Info) error {
+	osRelease, err := readOSRelease()
+	if err != nil {
+		return err
+	}
+
+	info.OSName = osRelease["NAME"]
+	info.OSVersion = osRelease["VERSION_ID"]
+	info.OSBuild = osRelease["BUILD_ID"]
+
+	info.BootTime, err = BootTime()
+	if err != nil {
+		return err
+	}
+
+	// Purely informational, so do not fail if unavailable
+	if cpuinfo, err := os.ReadFile("/proc/cpuinfo"); err == nil {
+		info.CPUModel = parseCPUModel(cpuinfo)
+	} else {
+		zap.S().Warnf("failed to determine the CPU model: %v", err)
+	}
+
+	meminfo, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return err
+	}
+
+	info.MemoryTotal, info.MemoryAvailable = parseMeminfo(meminfo)
+
+	fillConsoleUser(info)
+
+	return nil
+}
+
+// BootTime returns the time the system was booted at.
+func BootTime() (time.Time, error) {
+	stat, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	bootTime, ok := parseBootTime(stat)
+	if !ok {
+		return time.Time{}, errors.New("failed to find the boot time in /proc/stat")
+	}
+
+	return bootTime, nil
+}
+
+func readOSRelease() (map[string]string, error) {
+	// See os-release(5) for the lookup order
+	for _, path := range []string{"/etc/os-release", "/usr/lib/os-release"} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		return parseKeyValue(content), nil
+	}
+
+	return map[string]string{}, nil
+}
+
+// ConsoleUser returns the user of the active systemd-logind
+// session on the primary seat, if any.
+func ConsoleUser() (string, error) {
+	entries, err := os.ReadDir(systemdSessionsDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(systemdSessionsDir, entry.Name()))
+		if err != nil {
+			// The session might have ended in the meantime
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return "", err
+		}
+
+		session := parseKeyValue(content)
+
+		if session["SEAT"] == "seat0" && session["ACTIVE"] == "1" {
+			return session["USER"], nil
+		}
+	}
+
+	return "", nil
+}
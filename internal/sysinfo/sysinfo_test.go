@@ -0,0 +1,69 @@
+package sysinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyValue(t *testing.T) {
+	require.Equal(t, map[string]string{
+		"NAME":       "Ubuntu",
+		"VERSION_ID": "24.04",
+		"VERSION":    "24.04.1 LTS (Noble Numbat)",
+		"ID_LIKE":    "debian",
+	}, parseKeyValue([]byte(`# comment
+NAME="Ubuntu"
+VERSION_ID="24.04"
+VERSION='24.04.1 LTS (Noble Numbat)'
+ID_LIKE=debian
+
+garbage
+`)))
+}
+
+func TestParseMeminfo(t *testing.T) {
+	total, available := parseMeminfo([]byte(`MemTotal:        6147400 kB
+MemFree:         2610320 kB
+MemAvailable:    5447716 kB
+HugePages_Total:       0
+`))
+	require.EqualValues(t, 6147400*1024, total)
+	require.EqualValues(t, 5447716*1024, available)
+}
+
+func TestParseCPUModel(t *testing.T) {
+	require.Equal(t, "Intel(R) Xeon(R) Processor", parseCPUModel([]byte(`processor	: 0
+vendor_id	: GenuineIntel
+model name	: Intel(R) Xeon(R) Processor
+`)))
+
+	// arm64
+	require.Empty(t, parseCPUModel([]byte(`processor	: 0
+BogoMIPS	: 48.00
+CPU implementer	: 0x61
+`)))
+}
+
+func TestParseBootTime(t *testing.T) {
+	bootTime, ok := parseBootTime([]byte(`cpu  10 0 20 30 0 0 0 0 0 0
+btime 1792401914
+processes 1234
+`))
+	require.True(t, ok)
+	require.Equal(t, time.Unix(1792401914, 0), bootTime)
+
+	_, ok = parseBootTime([]byte("cpu  10 0 20 30 0 0 0 0 0 0\n"))
+	require.False(t, ok)
+}
+
+func TestGet(t *testing.T) {
+	info, err := Get()
+	require.NoError(t, err)
+	require.NotEmpty(t, info.KernelVersion)
+	require.NotEmpty(t, info.Hostname)
+	require.NotZero(t, info.CPUCount)
+	require.NotZero(t, info.MemoryTotal)
+	require.True(t, info.BootTime.Before(time.Now()))
+}
@@ -0,0 +1,172 @@
+package transfer
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/fileattr"
+)
+
+const defaultMode = 0o644
+
+type Transfer struct {
+	manager  *Manager
+	id       string
+	metadata Metadata
+	file     *os.File
+	offset   uint64
+}
+
+// OpenTemporary starts a transfer that cannot be resumed, whose data is
+// staged next to the destination instead of in the staging directory.
+func OpenTemporary(metadata Metadata) (*Transfer, error) {
+	file, err := os.CreateTemp(filepath.Dir(metadata.Path),
+		fmt.Sprintf(".%s.*.partial", filepath.Base(metadata.Path)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transfer{
+		id:       filepath.Base(file.Name()),
+		metadata: metadata,
+		file:     file,
+	}, nil
+}
+
+func (transfer *Transfer) Offset() uint64 {
+	return transfer.offset
+}
+
+func (transfer *Transfer) Write(data []byte) error {
+	if transfer.offset+uint64(len(data)) > transfer.metadata.Size {
+		return fmt.Errorf("transfer %s exceeds its declared size of %d bytes",
+			transfer.id, transfer.metadata.Size)
+	}
+
+	n, err := transfer.file.Write(data)
+	transfer.offset += uint64(n)
+
+	return err
+}
+
+// Commit verifies that all the data was received and moves the file
+// to its destination, optionally verifying its SHA-256 checksum. The
+// extended attributes are set before that, so that the destination
+// is only replaced when the file is complete.
+func (transfer *Transfer) Commit(expectedSHA256 []byte, xattrs []fileattr.Xattr) error {
+	if transfer.offset != transfer.metadata.Size {
+		return fmt.Errorf("cannot commit transfer %s: received %d bytes out of %d",
+			transfer.id, transfer.offset, transfer.metadata.Size)
+	}
+
+	if len(expectedSHA256) != 0 {
+		hash := sha256.New()
+
+		if _, err := io.Copy(hash, io.NewSectionReader(transfer.file, 0, int64(transfer.offset))); err != nil {
+			return err
+		}
+
+		if actualSHA256 := hash.Sum(nil); string(actualSHA256) != string(expectedSHA256) {
+			return fmt.Errorf("checksum mismatch for transfer %s: expected %x, got %x",
+				transfer.id, expectedSHA256, actualSHA256)
+		}
+	}
+
+	mode := fs.FileMode(transfer.metadata.Mode).Perm()
+	if mode == 0 {
+		mode = defaultMode
+	}
+
+	if err := transfer.file.Chmod(mode); err != nil {
+		return err
+	}
+
+	if err := fileattr.SetXattrs(transfer.file.Name(), xattrs, true); err != nil {
+		return fmt.Errorf("failed to set extended attributes: %w", err)
+	}
+
+	if err := transfer.file.Sync(); err != nil {
+		return err
+	}
+
+	if err := moveFile(transfer.file, transfer.metadata.Path, xattrs); err != nil {
+		return err
+	}
+
+	if transfer.manager == nil {
+		return nil
+	}
+
+	return transfer.manager.remove(transfer.id)
+}
+
+// Close persists the received data so that the transfer can be resumed
+// later, or discards it if the transfer cannot be resumed.
+func (transfer *Transfer) Close() error {
+	if transfer.manager == nil {
+		_ = transfer.file.Close()
+
+		// Does nothing if the transfer was committed
+		if err := os.Remove(transfer.file.Name()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	defer transfer.manager.release(transfer.id)
+
+	syncErr := transfer.file.Sync()
+
+	if err := transfer.file.Close(); err != nil {
+		return err
+	}
+
+	return syncErr
+}
+
+// moveFile renames the file to the destination path, falling back to copying
+// when the staging directory resides on a different file system.
+func moveFile(file *os.File, destinationPath string, xattrs []fileattr.Xattr) error {
+	err := os.Rename(file.Name(), destinationPath)
+	if err == nil || !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(destinationPath),
+		fmt.Sprintf(".%s.*.partial", filepath.Base(destinationPath)))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+
+	if _, err := io.Copy(temp, io.NewSectionReader(file, 0, fileInfo.Size())); err != nil {
+		return err
+	}
+
+	if err := temp.Chmod(fileInfo.Mode().Perm()); err != nil {
+		return err
+	}
+
+	if err := fileattr.SetXattrs(temp.Name(), xattrs, true); err != nil {
+		return fmt.Errorf("failed to set extended attributes: %w", err)
+	}
+
+	if err := temp.Sync(); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), destinationPath)
+}
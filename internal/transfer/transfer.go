@@ -0,0 +1,400 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultTTL = 24 * time.Hour
+
+	partialSuffix  = ".partial"
+	metadataSuffix = ".json"
+
+	maxTransferIDLength = 128
+
+	garbageCollectionInterval = 10 * time.Minute
+)
+
+var (
+	ErrNotFound = errors.New("transfer not found")
+	ErrBusy     = errors.New("transfer is already in progress")
+
+	transferIDRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+)
+
+// DefaultStagingDir returns the directory where the partial transfers
+// are kept unless configured otherwise: a root-owned directory when
+// running as root and the user's cache directory otherwise, because
+// a predictable path in a world-writable directory could be hijacked.
+func DefaultStagingDir() string {
+	if os.Geteuid() == 0 {
+		return rootStagingDir
+	}
+
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(cacheDir, "tart-guest-agent", "transfers")
+	}
+
+	return rootStagingDir
+}
+
+type Metadata struct {
+	Path string `json:"path"`
+	Size uint64 `json:"size"`
+	Mode uint32 `json:"mode"`
+}
+
+// Manager keeps the partial data of the uploads identified by a client-chosen
+// transfer ID in a staging directory, so that the interrupted uploads can be
+// resumed from the last committed offset instead of starting from zero.
+type Manager struct {
+	stagingDir string
+	ttl        time.Duration
+
+	active     map[string]struct{}
+	activeLock sync.Mutex
+}
+
+func NewManager(stagingDir string, ttl time.Duration) (*Manager, error) {
+	if err := os.MkdirAll(stagingDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create transfer staging directory %s: %w", stagingDir, err)
+	}
+
+	if err := checkStagingDir(stagingDir); err != nil {
+		return nil, fmt.Errorf("refusing to use transfer staging directory %s: %w", stagingDir, err)
+	}
+
+	return &Manager{
+		stagingDir: stagingDir,
+		ttl:        ttl,
+		active:     map[string]struct{}{},
+	}, nil
+}
+
+// Query returns the metadata of the transfer and
+// the offset up to which its data was committed.
+func (manager *Manager) Query(id string) (*Metadata, uint64, error) {
+	if err := validateID(id); err != nil {
+		return nil, 0, err
+	}
+
+	metadata, err := manager.readMetadata(id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fileInfo, err := os.Stat(manager.partialPath(id))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, 0, ErrNotFound
+		}
+
+		return nil, 0, err
+	}
+
+	return metadata, uint64(fileInfo.Size()), nil
+}
+
+// Open starts a new transfer or resumes an existing one from the specified offset,
+// which should not exceed the committed offset returned by Query().
+func (manager *Manager) Open(id string, metadata Metadata, offset uint64) (*Transfer, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+
+	if !manager.acquire(id) {
+		return nil, ErrBusy
+	}
+
+	transfer, err := manager.open(id, metadata, offset)
+	if err != nil {
+		manager.release(id)
+
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// Remove discards the transfer's partial data.
+func (manager *Manager) Remove(id string) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+
+	if !manager.acquire(id) {
+		return ErrBusy
+	}
+	defer manager.release(id)
+
+	return manager.remove(id)
+}
+
+// Run periodically garbage-collects stale partial transfers.
+func (manager *Manager) Run(ctx context.Context) error {
+	for {
+		manager.GarbageCollect()
+
+		select {
+		case <-time.After(garbageCollectionInterval):
+			continue
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// GarbageCollect removes the partial transfers that
+// weren't written to for longer than the TTL.
+func (manager *Manager) GarbageCollect() {
+	entries, err := os.ReadDir(manager.stagingDir)
+	if err != nil {
+		zap.S().Warnf("failed to list transfer staging directory %s: %v", manager.stagingDir, err)
+
+		return
+	}
+
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), metadataSuffix)
+		if !ok {
+			id, ok = strings.CutSuffix(entry.Name(), partialSuffix)
+		}
+		if !ok {
+			continue
+		}
+
+		fileInfo, err := entry.Info()
+		if err != nil || time.Since(fileInfo.ModTime()) < manager.ttl {
+			continue
+		}
+
+		// Skip transfers that are in progress
+		if !manager.acquire(id) {
+			continue
+		}
+
+		// Make sure that the other file of the transfer is stale too
+		stale := true
+
+		for _, path := range []string{manager.partialPath(id), manager.metadataPath(id)} {
+			if fileInfo, err := os.Stat(path); err == nil && time.Since(fileInfo.ModTime()) < manager.ttl {
+				stale = false
+			}
+		}
+
+		if stale {
+			zap.S().Infof("removing stale partial transfer %s", id)
+
+			if err := manager.remove(id); err != nil {
+				zap.S().Warnf("failed to remove stale partial transfer %s: %v", id, err)
+			}
+		}
+
+		manager.release(id)
+	}
+}
+
+func (manager *Manager) open(id string, metadata Metadata, offset uint64) (*Transfer, error) {
+	existingMetadata, err := manager.readMetadata(id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if existingMetadata != nil && (existingMetadata.Path != metadata.Path || existingMetadata.Size != metadata.Size) {
+		return nil, fmt.Errorf("transfer %s was started for %s (%d bytes), refusing to resume it "+
+			"for %s (%d bytes)", id, existingMetadata.Path, existingMetadata.Size, metadata.Path, metadata.Size)
+	}
+
+	file, err := os.OpenFile(manager.partialPath(id), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	if offset > uint64(fileInfo.Size()) {
+		_ = file.Close()
+
+		return nil, fmt.Errorf("cannot resume transfer %s from offset %d as only %d bytes were committed",
+			id, offset, fileInfo.Size())
+	}
+
+	// Discard everything past the offset
+	if err := file.Truncate(int64(offset)); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	if _, err := file.Seek(int64(offset), io.SeekStart); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	if err := manager.writeMetadata(id, metadata); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	return &Transfer{
+		manager:  manager,
+		id:       id,
+		metadata: metadata,
+		file:     file,
+		offset:   offset,
+	}, nil
+}
+
+func (manager *Manager) readMetadata(id string) (*Metadata, error) {
+	metadataBytes, err := os.ReadFile(manager.metadataPath(id))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	var metadata Metadata
+
+	if err := json.Unmarshal(metadataBytes, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse transfer %s metadata: %w", id, err)
+	}
+
+	return &metadata, nil
+}
+
+func (manager *Manager) writeMetadata(id string, metadata Metadata) error {
+	metadataBytes, err := json.Marshal(&metadata)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(manager.metadataPath(id), metadataBytes, 0o600)
+}
+
+func (manager *Manager) remove(id string) error {
+	var result error
+
+	for _, path := range []string{manager.partialPath(id), manager.metadataPath(id)} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			result = errors.Join(result, err)
+		}
+	}
+
+	return result
+}
+
+func (manager *Manager) acquire(id string) bool {
+	manager.activeLock.Lock()
+	defer manager.activeLock.Unlock()
+
+	if _, ok := manager.active[id]; ok {
+		return false
+	}
+
+	manager.active[id] = struct{}{}
+
+	return true
+}
+
+func (manager *Manager) release(id string) {
+	manager.activeLock.Lock()
+	defer manager.activeLock.Unlock()
+
+	delete(manager.active, id)
+}
+
+func (manager *Manager) partialPath(id string) string {
+	return filepath.Join(manager.stagingDir, id+partialSuffix)
+}
+
+func (manager *Manager) metadataPath(id string) string {
+	return filepath.Join(manager.stagingDir, id+metadataSuffix)
+}
+
+// checkStagingDir makes sure that neither the staging directory
+// nor its parent could have been tampered with by other users.
+func checkStagingDir(stagingDir string) error {
+	fileInfo, err := os.Lstat(stagingDir)
+	if err != nil {
+		return err
+	}
+
+	if !fileInfo.IsDir() {
+		return errors.New("not a directory")
+	}
+
+	if stat, ok := fileInfo.Sys().(*syscall.Stat_t); ok && int(stat.Uid) != os.Geteuid() {
+		return fmt.Errorf("owned by UID %d", stat.Uid)
+	}
+
+	// Tighten the permissions of a pre-existing directory
+	if fileInfo.Mode().Perm()&0o077 != 0 {
+		if err := os.Chmod(stagingDir, 0o700); err != nil {
+			return err
+		}
+	}
+
+	// Other users should not be able to replace the staging directory,
+	// which is fine for the directories like /tmp with a sticky bit
+	parentInfo, err := os.Stat(filepath.Dir(stagingDir))
+	if err != nil {
+		return err
+	}
+
+	if err := checkOwner(parentInfo); err != nil {
+		return fmt.Errorf("parent directory is %w", err)
+	}
+
+	if parentInfo.Mode().Perm()&0o022 != 0 && parentInfo.Mode()&fs.ModeSticky == 0 {
+		return fmt.Errorf("parent directory is writable by other users (mode %s)",
+			parentInfo.Mode().Perm())
+	}
+
+	return nil
+}
+
+// checkOwner accepts the files owned by the current user or root.
+func checkOwner(fileInfo fs.FileInfo) error {
+	stat, ok := fileInfo.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+
+	if int(stat.Uid) != os.Geteuid() && stat.Uid != 0 {
+		return fmt.Errorf("owned by UID %d", stat.Uid)
+	}
+
+	return nil
+}
+
+func validateID(id string) error {
+	if len(id) > maxTransferIDLength || !transferIDRegex.MatchString(id) || id == "." || id == ".." {
+		return fmt.Errorf("invalid transfer ID %q: should consist of up to %d letters, digits, "+
+			"dots, underscores and dashes", id, maxTransferIDLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,3 @@
+package transfer
+
+const rootStagingDir = "/var/db/tart-guest-agent/transfers"
@@ -0,0 +1,3 @@
+package transfer
+
+const rootStagingDir = "/var/lib/tart-guest-agent/transfers"
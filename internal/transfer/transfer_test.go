@@ -0,0 +1,188 @@
+package transfer
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/fileattr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResume(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), DefaultTTL)
+	require.NoError(t, err)
+
+	destinationPath := filepath.Join(t.TempDir(), "runtime.dmg")
+	data := []byte("Hello, World!")
+	metadata := Metadata{Path: destinationPath, Size: uint64(len(data))}
+
+	// Unknown transfer
+	_, _, err = manager.Query("runtime")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Transfer that got interrupted
+	transfer, err := manager.Open("runtime", metadata, 0)
+	require.NoError(t, err)
+	require.NoError(t, transfer.Write(data[:5]))
+
+	_, err = manager.Open("runtime", metadata, 0)
+	require.ErrorIs(t, err, ErrBusy)
+
+	require.NoError(t, transfer.Close())
+
+	_, committedOffset, err := manager.Query("runtime")
+	require.NoError(t, err)
+	require.EqualValues(t, 5, committedOffset)
+
+	// Resuming past the committed offset is not possible
+	_, err = manager.Open("runtime", metadata, 6)
+	require.Error(t, err)
+
+	// Resuming for a different file is not possible
+	_, err = manager.Open("runtime", Metadata{Path: destinationPath + ".other", Size: metadata.Size}, 5)
+	require.Error(t, err)
+
+	// Resumed transfer
+	transfer, err = manager.Open("runtime", metadata, 5)
+	require.NoError(t, err)
+	require.NoError(t, transfer.Write(data[5:]))
+
+	checksum := sha256.Sum256(data)
+	require.NoError(t, transfer.Commit(checksum[:], nil))
+	require.NoError(t, transfer.Close())
+
+	actual, err := os.ReadFile(destinationPath)
+	require.NoError(t, err)
+	require.Equal(t, data, actual)
+
+	// Committed transfer is forgotten
+	_, _, err = manager.Query("runtime")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGarbageCollect(t *testing.T) {
+	stagingDir := t.TempDir()
+
+	manager, err := NewManager(stagingDir, time.Hour)
+	require.NoError(t, err)
+
+	for _, id := range []string{"fresh", "stale"} {
+		transfer, err := manager.Open(id, Metadata{Path: filepath.Join(t.TempDir(), id), Size: 10}, 0)
+		require.NoError(t, err)
+		require.NoError(t, transfer.Write([]byte("data")))
+		require.NoError(t, transfer.Close())
+	}
+
+	staleTime := time.Now().Add(-2 * time.Hour)
+
+	for _, path := range []string{manager.partialPath("stale"), manager.metadataPath("stale")} {
+		require.NoError(t, os.Chtimes(path, staleTime, staleTime))
+	}
+
+	manager.GarbageCollect()
+
+	_, _, err = manager.Query("fresh")
+	require.NoError(t, err)
+
+	_, _, err = manager.Query("stale")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	entries, err := os.ReadDir(stagingDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestInvalidID(t *testing.T) {
+	manager, err := NewManager(t.TempDir(), DefaultTTL)
+	require.NoError(t, err)
+
+	_, err = manager.Open("../etc/passwd", Metadata{}, 0)
+	require.Error(t, err)
+}
+
+func TestStagingDirChecks(t *testing.T) {
+	dir := t.TempDir()
+
+	// Permissions of a pre-existing directory are tightened
+	stagingDir := filepath.Join(dir, "transfers")
+	require.NoError(t, os.Mkdir(stagingDir, 0o755))
+
+	_, err := NewManager(stagingDir, DefaultTTL)
+	require.NoError(t, err)
+
+	fileInfo, err := os.Stat(stagingDir)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o700), fileInfo.Mode().Perm())
+
+	// Symbolic links are refused
+	link := filepath.Join(dir, "link")
+	require.NoError(t, os.Symlink(stagingDir, link))
+
+	_, err = NewManager(link, DefaultTTL)
+	require.ErrorContains(t, err, "not a directory")
+
+	// Parent directories writable by other users are refused
+	openDir := filepath.Join(dir, "open")
+	require.NoError(t, os.Mkdir(openDir, 0o700))
+	require.NoError(t, os.Chmod(openDir, 0o777))
+
+	_, err = NewManager(filepath.Join(openDir, "transfers"), DefaultTTL)
+	require.ErrorContains(t, err, "writable by other users")
+}
+
+func TestTemporary(t *testing.T) {
+	dir := t.TempDir()
+	destinationPath := filepath.Join(dir, "file.txt")
+	data := []byte("Hello, World!")
+
+	// Uncommitted data is discarded
+	transfer, err := OpenTemporary(Metadata{Path: destinationPath, Size: uint64(len(data))})
+	require.NoError(t, err)
+	require.NoError(t, transfer.Write(data[:5]))
+	require.NoError(t, transfer.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	transfer, err = OpenTemporary(Metadata{Path: destinationPath, Size: uint64(len(data))})
+	require.NoError(t, err)
+	require.NoError(t, transfer.Write(data))
+	require.NoError(t, transfer.Commit(nil, nil))
+	require.NoError(t, transfer.Close())
+
+	actualData, err := os.ReadFile(destinationPath)
+	require.NoError(t, err)
+	require.Equal(t, data, actualData)
+}
+
+func TestCommitXattrs(t *testing.T) {
+	destinationPath := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(destinationPath, []byte("old"), 0o600))
+
+	data := []byte("new")
+
+	// The destination is left intact when the extended attributes cannot be set
+	transfer, err := OpenTemporary(Metadata{Path: destinationPath, Size: uint64(len(data))})
+	require.NoError(t, err)
+	require.NoError(t, transfer.Write(data))
+	require.Error(t, transfer.Commit(nil, []fileattr.Xattr{{Name: "", Value: []byte("value")}}))
+	require.NoError(t, transfer.Close())
+
+	actualData, err := os.ReadFile(destinationPath)
+	require.NoError(t, err)
+	require.Equal(t, "old", string(actualData))
+
+	transfer, err = OpenTemporary(Metadata{Path: destinationPath, Size: uint64(len(data))})
+	require.NoError(t, err)
+	require.NoError(t, transfer.Write(data))
+	require.NoError(t, transfer.Commit(nil, []fileattr.Xattr{{Name: "user.tart", Value: []byte("value")}}))
+	require.NoError(t, transfer.Close())
+
+	value, err := fileattr.GetXattr(destinationPath, "user.tart", true)
+	require.NoError(t, err)
+	require.Equal(t, "value", string(value))
+}
@@ -0,0 +1,38 @@
+package waitfor
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// launchdTarget returns the service target for launchctl(1),
+// assuming the system domain when none is specified.
+func launchdTarget(name string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+
+	return "system/" + name
+}
+
+// parseLaunchctlState returns the job's state (e.g. "running"
+// or "not running") from the output of "launchctl print".
+func parseLaunchctlState(output []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// Skip the nested sections (e.g. endpoints), which have states of their own
+		if !strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "\t\t") {
+			continue
+		}
+
+		if state, ok := strings.CutPrefix(strings.TrimSpace(line), "state = "); ok {
+			return state
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,7 @@
+package waitfor
+
+// executableName is empty on macOS, where the process name
+// already falls back to the executable's basename.
+func executableName(_ int) string {
+	return ""
+}
@@ -0,0 +1,18 @@
+package waitfor
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// executableName returns the basename of the process' executable, which
+// is empty when the agent has no permission to inspect the process.
+func executableName(pid int) string {
+	path, err := os.Readlink(filepath.Join("/proc", strconv.Itoa(pid), "exe"))
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Base(path)
+}
@@ -0,0 +1,23 @@
+package waitfor
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+)
+
+func serviceActive(ctx context.Context, name string) (bool, error) {
+	// Exits with a non-zero code when the job is not loaded
+	output, err := exec.CommandContext(ctx, "launchctl", "print", launchdTarget(name)).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+
+		if errors.As(err, &exitErr) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return parseLaunchctlState(output) == "running", nil
+}
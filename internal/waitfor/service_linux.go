@@ -0,0 +1,22 @@
+package waitfor
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+)
+
+func serviceActive(ctx context.Context, name string) (bool, error) {
+	// Exits with a non-zero code when the unit is not active
+	if err := exec.CommandContext(ctx, "systemctl", "is-active", "--quiet", name).Run(); err != nil {
+		var exitErr *exec.ExitError
+
+		if errors.As(err, &exitErr) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
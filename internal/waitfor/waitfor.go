@@ -0,0 +1,198 @@
+package waitfor
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/cirruslabs/tart-guest-agent/internal/netinfo"
+	"github.com/cirruslabs/tart-guest-agent/internal/ports"
+	"github.com/cirruslabs/tart-guest-agent/internal/process"
+	"github.com/cirruslabs/tart-guest-agent/internal/sysinfo"
+)
+
+const (
+	DefaultInterval = time.Second
+
+	// Some of the conditions are checked by running commands
+	// (e.g. ps(1) and lsof(8) on macOS), so don't poll too often
+	MinInterval = 250 * time.Millisecond
+)
+
+// Condition is a guest readiness condition.
+type Condition interface {
+	// Holds reports whether the condition currently holds.
+	Holds(ctx context.Context) (bool, error)
+}
+
+// Status is the outcome of the last check of a condition.
+type Status struct {
+	Satisfied bool
+	Err       error
+}
+
+// Wait checks the conditions every interval until all of them hold
+// at the same time or until the context is done, returning the
+// status of each condition and whether all of them hold.
+func Wait(ctx context.Context, conditions []Condition, interval time.Duration) ([]Status, bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		statuses, satisfied := check(ctx, conditions)
+		if satisfied {
+			return statuses, true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return statuses, false
+		}
+	}
+}
+
+func check(ctx context.Context, conditions []Condition) ([]Status, bool) {
+	statuses := make([]Status, len(conditions))
+	satisfied := true
+
+	for i, condition := range conditions {
+		holds, err := condition.Holds(ctx)
+
+		// Commands killed due to the context expiring mid-check
+		// fail with unrelated errors, report the real cause
+		if err != nil && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+
+		statuses[i] = Status{
+			Satisfied: holds && err == nil,
+			Err:       err,
+		}
+
+		if !statuses[i].Satisfied {
+			satisfied = false
+		}
+	}
+
+	return statuses, satisfied
+}
+
+// PathExists holds when a file, a directory or a socket exists at the path.
+type PathExists struct {
+	Path string
+}
+
+func (condition PathExists) Holds(_ context.Context) (bool, error) {
+	if _, err := os.Stat(condition.Path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PortListening holds when a TCP port is listened on any of the addresses.
+//
+// On macOS, the ports listened on by other users' processes
+// are only visible when the agent runs as root, see ports.List.
+type PortListening struct {
+	Port uint16
+}
+
+func (condition PortListening) Holds(_ context.Context) (bool, error) {
+	listeningPorts, err := ports.List()
+	if err != nil {
+		return false, err
+	}
+
+	for _, port := range listeningPorts {
+		if port.Port == condition.Port {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ProcessRunning holds when a process with the name is running,
+// which is matched against the same name as reported by the
+// process.List, i.e. the basename of the argv[0], and, as a
+// fallback, the basename of the executable on Linux, since
+// the kernel's process name is truncated to 15 characters.
+type ProcessRunning struct {
+	Name string
+}
+
+func (condition ProcessRunning) Holds(_ context.Context) (bool, error) {
+	processes, err := process.List()
+	if err != nil {
+		return false, err
+	}
+
+	for _, process := range processes {
+		if process.State == "zombie" {
+			continue
+		}
+
+		if process.Name == condition.Name || executableName(process.PID) == condition.Name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// AddressAssigned holds when a global unicast address
+// matching the filter is assigned to any of the interfaces.
+type AddressAssigned struct {
+	Filter netinfo.Filter
+}
+
+func (condition AddressAssigned) Holds(_ context.Context) (bool, error) {
+	interfaces, err := netinfo.Interfaces()
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := netinfo.Preferred(condition.Filter.Apply(interfaces), condition.Filter.Family)
+
+	return ok, nil
+}
+
+// UserLoggedIn holds when the user is logged in to the
+// GUI session, or when any user is logged in if not specified.
+type UserLoggedIn struct {
+	User string
+}
+
+func (condition UserLoggedIn) Holds(_ context.Context) (bool, error) {
+	consoleUser, err := sysinfo.ConsoleUser()
+	if err != nil {
+		return false, err
+	}
+
+	if condition.User == "" {
+		return consoleUser != "", nil
+	}
+
+	return consoleUser == condition.User, nil
+}
+
+// ServiceActive holds when a systemd unit (Linux)
+// or a launchd job (macOS) is active.
+type ServiceActive struct {
+	Name string
+}
+
+func (condition ServiceActive) Holds(ctx context.Context) (bool, error) {
+	if condition.Name == "" {
+		return false, errors.New("no service name specified")
+	}
+
+	return serviceActive(ctx, condition.Name)
+}
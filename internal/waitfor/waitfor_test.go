@@ -0,0 +1,116 @@
+package waitfor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWait(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ready")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	conditions := []Condition{
+		PathExists{Path: path},
+		PortListening{Port: uint16(listener.Addr().(*net.TCPAddr).Port)},
+		ProcessRunning{Name: filepath.Base(os.Args[0])},
+	}
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+
+		_ = os.WriteFile(path, nil, 0600)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	statuses, satisfied := Wait(ctx, conditions, 50*time.Millisecond)
+	require.True(t, satisfied)
+	require.Equal(t, []Status{{Satisfied: true}, {Satisfied: true}, {Satisfied: true}}, statuses)
+}
+
+func TestProcessRunningLongName(t *testing.T) {
+	sleepPath, err := exec.LookPath("sleep")
+	require.NoError(t, err)
+
+	sleepBinary, err := os.ReadFile(sleepPath)
+	require.NoError(t, err)
+
+	// Longer than the kernel's process name on both Linux and macOS
+	longName := "tart-guest-agent-test-sleep"
+
+	longPath := filepath.Join(t.TempDir(), longName)
+	require.NoError(t, os.WriteFile(longPath, sleepBinary, 0700))
+
+	cmd := exec.Command(longPath, "30")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, satisfied := Wait(ctx, []Condition{ProcessRunning{Name: longName}}, 50*time.Millisecond)
+	require.True(t, satisfied)
+}
+
+type failingCondition struct{}
+
+func (failingCondition) Holds(ctx context.Context) (bool, error) {
+	<-ctx.Done()
+
+	return false, errors.New("signal: killed")
+}
+
+func TestWaitReportsContextError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	statuses, satisfied := Wait(ctx, []Condition{failingCondition{}}, 50*time.Millisecond)
+	require.False(t, satisfied)
+	require.ErrorIs(t, statuses[0].Err, context.DeadlineExceeded)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	statuses, satisfied := Wait(ctx, []Condition{
+		PathExists{Path: t.TempDir()},
+		PathExists{Path: filepath.Join(t.TempDir(), "never")},
+	}, 50*time.Millisecond)
+	require.False(t, satisfied)
+	require.Equal(t, []Status{{Satisfied: true}, {Satisfied: false}}, statuses)
+}
+
+func TestParseLaunchctlState(t *testing.T) {
+	require.Equal(t, "running", parseLaunchctlState([]byte(`system/com.openssh.sshd = {
+	active count = 1
+	path = /System/Library/LaunchDaemons/ssh.plist
+	endpoints = {
+		"com.openssh.sshd.ssh" = {
+			state = active
+		}
+	}
+	state = running
+}
+`)))
+
+	require.Equal(t, "not running", parseLaunchctlState([]byte("gui/501/com.example = {\n\tstate = not running\n}\n")))
+
+	require.Equal(t, "system/com.example", launchdTarget("com.example"))
+	require.Equal(t, "gui/501/com.example", launchdTarget("gui/501/com.example"))
+}